@@ -5,8 +5,9 @@ import (
 	"context"
 	"io/ioutil"
 	"regexp"
+	"time"
 
-	utilerrors "github.com/forbearing/k8s/util/errors"
+	"github.com/forbearing/k8s/dynamic"
 	"github.com/sirupsen/logrus"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 )
@@ -14,6 +15,15 @@ import (
 // ApplyF work like "kubectl apply -f filename.yaml -n test",
 // The namespace defined in yaml have higher precedence than namespace specified here.
 func ApplyF(ctx context.Context, kubeconfig, filename string, namespace string, opts ...Options) error {
+	return ApplyFWithRetry(ctx, kubeconfig, filename, namespace, 0, opts...)
+}
+
+// ApplyFWithRetry work like ApplyF, but if a k8s resource depends on another
+// k8s resource that is not present yet(the namespace or the CRD is defined
+// later in the yaml file, see dynamic.IsDependencyMissing), it retries applying
+// the k8s resource with backoff until the retry window elapses, instead of
+// failing immediately.
+func ApplyFWithRetry(ctx context.Context, kubeconfig, filename string, namespace string, retryWindow time.Duration, opts ...Options) error {
 	handler, err := New(ctx, kubeconfig, namespace)
 	if err != nil {
 		return err
@@ -29,6 +39,8 @@ func ApplyF(ctx context.Context, kubeconfig, filename string, namespace string,
 	// Split yaml documents into multiple single yaml document base on the delimiter("---")
 	yamlList := bytes.Split(yamlData, []byte("---"))
 
+	// The k8s resources whose dependencies are not present yet.
+	var pending [][]byte
 	for _, item := range yamlList {
 		// If the yaml document is empty, skip create it.
 		if len(bytes.TrimSpace(item)) == 0 {
@@ -39,31 +51,43 @@ func ApplyF(ctx context.Context, kubeconfig, filename string, namespace string,
 		// dynaimc hanler will create the k8s resource is the namespace specified in dynamic.New().
 		// (namespace defined in yaml file have higher precedence than specified in dynamic.New())
 		_, err = handler.Apply(item)
-		for _, opt := range opts {
-			switch opt {
-			case IgnoreAlreadyExists:
-				err = utilerrors.IgnoreAlreadyExists(err)
-			case IgnoreNotFound:
-				err = utilerrors.IgnoreNotFound(err)
-			case IgnoreInvalid:
-				err = utilerrors.IgnoreInvalid(err)
-			}
-		}
-
-		// If the error returned by dynamic handler is "AlreadyExists" or "Invalid",
-		// just output the error message continue handle the next itmes.
-		// You can call ApplyF() with IgnoreInvalid or/and IgnoreInvalid options to
-		// ignore these errors.
-		// A "Invalid" error will occurrs when you update the pod/job/persistentvolume resource.
-		if err != nil && (apierrors.IsAlreadyExists(err) || apierrors.IsInvalid(err)) {
-			logrus.Error(err)
+		// The dependency may be defined later in the yaml file, retry it after
+		// the other k8s resources are applied.
+		if retryWindow > 0 && dynamic.IsDependencyMissing(err) {
+			pending = append(pending, item)
 			continue
 		}
-		// Unexpected error, return it.
-		if err != nil {
+		if err = checkApplyErr(err, opts...); err != nil {
+			return err
+		}
+	}
+
+	// Every pending k8s resource waits for its dependency in the rest of the retry window.
+	deadline := time.Now().Add(retryWindow)
+	for _, item := range pending {
+		_, err = handler.ApplyWithRetry(item, time.Until(deadline))
+		if err = checkApplyErr(err, opts...); err != nil {
 			return err
 		}
 	}
 
 	return nil
 }
+
+// checkApplyErr ignores the errors specified by opts, and logs the "AlreadyExists"
+// and "Invalid" errors, the other errors are returned.
+func checkApplyErr(err error, opts ...Options) error {
+	err = ignoreErrors(err, opts...)
+
+	// If the error returned by dynamic handler is "AlreadyExists" or "Invalid",
+	// just output the error message continue handle the next itmes.
+	// You can call ApplyF() with IgnoreInvalid or/and IgnoreInvalid options to
+	// ignore these errors.
+	// A "Invalid" error will occurrs when you update the pod/job/persistentvolume resource.
+	if err != nil && (apierrors.IsAlreadyExists(err) || apierrors.IsInvalid(err)) {
+		logrus.Error(err)
+		return nil
+	}
+	// Unexpected error, return it.
+	return err
+}
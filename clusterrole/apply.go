@@ -1,8 +1,6 @@
 package clusterrole
 
 import (
-	"fmt"
-
 	rbacv1 "k8s.io/api/rbac/v1"
 	k8serrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -58,7 +56,7 @@ func (h *Handler) ApplyFromBytes(data []byte) (cr *rbacv1.ClusterRole, err error
 func (h *Handler) ApplyFromObject(obj interface{}) (*rbacv1.ClusterRole, error) {
 	cr, ok := obj.(*rbacv1.ClusterRole)
 	if !ok {
-		return nil, fmt.Errorf("object type is not *rbacv1.ClusterRole")
+		return nil, ErrInvalidObjectType
 	}
 	return h.applyCR(cr)
 }
@@ -8,6 +8,7 @@ import (
 
 	"github.com/forbearing/k8s/types"
 	"github.com/forbearing/k8s/util/client"
+	utildiscovery "github.com/forbearing/k8s/util/discovery"
 	rbacv1 "k8s.io/api/rbac/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime/schema"
@@ -37,6 +38,7 @@ type Handler struct {
 	informerFactory  informers.SharedInformerFactory
 
 	Options *types.HandlerOptions
+	logger  types.Logger
 
 	l sync.RWMutex
 }
@@ -134,6 +136,10 @@ func (in *Handler) DeepCopy() *Handler {
 	if in == nil {
 		return nil
 	}
+	// hold the read lock, so the Set* methods can't modify the handler while copying it.
+	in.l.RLock()
+	defer in.l.RUnlock()
+
 	return &Handler{
 		ctx:              in.ctx,
 		kubeconfig:       in.kubeconfig,
@@ -147,6 +153,7 @@ func (in *Handler) DeepCopy() *Handler {
 		resyncPeriod:     in.resyncPeriod,
 		informerScope:    in.informerScope,
 		tweakListOptions: in.tweakListOptions,
+		logger:           in.logger,
 		Options: &types.HandlerOptions{
 			CreateOptions: *in.Options.CreateOptions.DeepCopy(),
 			UpdateOptions: *in.Options.UpdateOptions.DeepCopy(),
@@ -177,6 +184,24 @@ func (h *Handler) SetForceDelete(force bool) {
 	}
 }
 
+// SetLogger sets the logger reporting the watch and informer events,
+// types.DiscardLogger silences them. The logrus standard logger is used by default.
+func (h *Handler) SetLogger(logger types.Logger) {
+	h.l.Lock()
+	defer h.l.Unlock()
+	h.logger = logger
+}
+
+// log returns the logger set by SetLogger, or the default logger.
+func (h *Handler) log() types.Logger {
+	h.l.RLock()
+	defer h.l.RUnlock()
+	if h.logger == nil {
+		return types.DefaultLogger()
+	}
+	return h.logger
+}
+
 // RESTConfig returns underlying rest config.
 func (h *Handler) RESTConfig() *rest.Config {
 	return h.config
@@ -202,6 +227,12 @@ func (h *Handler) DiscoveryClient() *discovery.DiscoveryClient {
 	return h.discoveryClient
 }
 
+// APIVerbs returns the verbs supported by the kubernetes apiserver for clusterrole,
+// such as "get", "list", "watch", "create", "update", "patch" and "delete".
+func (h *Handler) APIVerbs() ([]string, error) {
+	return utildiscovery.APIVerbs(h.discoveryClient, GVR)
+}
+
 // GVK contains the Group, Version, Kind name of clusterrole.
 var GVK = schema.GroupVersionKind{
 	Group:   rbacv1.SchemeGroupVersion.Group,
@@ -2,7 +2,6 @@ package clusterrole
 
 import (
 	"encoding/json"
-	"fmt"
 	"io/ioutil"
 
 	rbacv1 "k8s.io/api/rbac/v1"
@@ -73,7 +72,7 @@ func (h *Handler) GetFromBytes(data []byte) (*rbacv1.ClusterRole, error) {
 func (h *Handler) GetFromObject(obj interface{}) (*rbacv1.ClusterRole, error) {
 	cr, ok := obj.(*rbacv1.ClusterRole)
 	if !ok {
-		return nil, fmt.Errorf("object type is not *rbacv1.ClusterRole")
+		return nil, ErrInvalidObjectType
 	}
 	return h.getCR(cr)
 }
@@ -2,9 +2,9 @@ package clusterrole
 
 import (
 	"encoding/json"
-	"errors"
 	"os"
 
+	jsonpatch "github.com/evanphx/json-patch"
 	rbacv1 "k8s.io/api/rbac/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
@@ -87,7 +87,7 @@ func (h *Handler) Patch(original *rbacv1.ClusterRole, patch interface{}, patchOp
 	case metav1.Object, runtime.Object:
 		modified, ok := patch.(*rbacv1.ClusterRole)
 		if !ok {
-			return nil, errors.New("patch data type is not *rbacv1.ClusterRole")
+			return nil, ErrInvalidObjectType
 		}
 		return h.diffMergePatch(original, modified, patchOptions...)
 
@@ -164,7 +164,15 @@ func (h *Handler) diffMergePatch(original, modified *rbacv1.ClusterRole, patchOp
 	if modifiedJson, err = json.Marshal(modified); err != nil {
 		return nil, err
 	}
-	if patchData, err = strategicpatch.CreateTwoWayMergePatch(originalJson, modifiedJson, rbacv1.ClusterRole{}); err != nil {
+	// the patch data of "JSON Merge Patch" must contain the entire new list,
+	// the patch data created by strategic merge patch only contains the
+	// changed list items, which would drop the other list items.
+	if len(patchOptions) != 0 && patchOptions[0] == types.MergePatchType {
+		patchData, err = jsonpatch.CreateMergePatch(originalJson, modifiedJson)
+	} else {
+		patchData, err = strategicpatch.CreateTwoWayMergePatch(originalJson, modifiedJson, rbacv1.ClusterRole{})
+	}
+	if err != nil {
 		return nil, err
 	}
 	if len(patchData) == 0 || string(patchData) == "{}" {
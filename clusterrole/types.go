@@ -12,4 +12,5 @@ var (
 	ErrInvalidDeleteType = ErrInvalidCreateType
 	ErrInvalidGetType    = ErrInvalidCreateType
 	ErrInvalidPatchType  = errors.New("patch data type must be string, []byte, *rbacv1.ClusterRole, rbacv1.ClusterRole, metav1.Object, runtime.Object, *unstructured.Unstructured, unstructured.Unstructured or map[string]interface{}")
+	ErrInvalidObjectType = errors.New("object type is not *rbacv1.ClusterRole")
 )
@@ -1,8 +1,6 @@
 package clusterrolebinding
 
 import (
-	"fmt"
-
 	rbacv1 "k8s.io/api/rbac/v1"
 	k8serrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -58,7 +56,7 @@ func (h *Handler) ApplyFromBytes(data []byte) (crb *rbacv1.ClusterRoleBinding, e
 func (h *Handler) ApplyFromObject(obj interface{}) (*rbacv1.ClusterRoleBinding, error) {
 	crb, ok := obj.(*rbacv1.ClusterRoleBinding)
 	if !ok {
-		return nil, fmt.Errorf("object type is not *rbacv1.ClusterRoleBinding")
+		return nil, ErrInvalidObjectType
 	}
 	return h.applyCRB(crb)
 }
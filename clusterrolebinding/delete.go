@@ -2,7 +2,6 @@ package clusterrolebinding
 
 import (
 	"encoding/json"
-	"fmt"
 	"io/ioutil"
 
 	rbacv1 "k8s.io/api/rbac/v1"
@@ -73,7 +72,7 @@ func (h *Handler) DeleteFromBytes(data []byte) error {
 func (h *Handler) DeleteFromObject(obj interface{}) error {
 	crb, ok := obj.(*rbacv1.ClusterRoleBinding)
 	if !ok {
-		return fmt.Errorf("object type is not *rbacv1.ClusterRoleBinding")
+		return ErrInvalidObjectType
 	}
 	return h.deleteCRB(crb)
 }
@@ -0,0 +1,13 @@
+package clusterrolebinding
+
+import (
+	"github.com/forbearing/k8s/types"
+	rbacv1 "k8s.io/api/rbac/v1"
+)
+
+// Generic returns the handler as a types.Handler, whose methods return
+// runtime.Object instead of *rbacv1.ClusterRoleBinding, so it can be used together with
+// the handlers of other resources.
+func (h *Handler) Generic() types.Handler {
+	return types.NewGeneric[*rbacv1.ClusterRoleBinding](h, GVK)
+}
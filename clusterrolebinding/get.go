@@ -2,7 +2,6 @@ package clusterrolebinding
 
 import (
 	"encoding/json"
-	"fmt"
 	"io/ioutil"
 
 	rbacv1 "k8s.io/api/rbac/v1"
@@ -73,7 +72,7 @@ func (h *Handler) GetFromBytes(data []byte) (*rbacv1.ClusterRoleBinding, error)
 func (h *Handler) GetFromObject(obj interface{}) (*rbacv1.ClusterRoleBinding, error) {
 	crb, ok := obj.(*rbacv1.ClusterRoleBinding)
 	if !ok {
-		return nil, fmt.Errorf("object type is not *rbacv1.ClusterRoleBinding")
+		return nil, ErrInvalidObjectType
 	}
 	return h.getCRB(crb)
 }
@@ -3,7 +3,6 @@ package clusterrolebinding
 import (
 	"time"
 
-	"github.com/sirupsen/logrus"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/client-go/informers"
 	"k8s.io/client-go/informers/internalinterfaces"
@@ -98,18 +97,18 @@ func (h *Handler) RunInformer(
 
 	// method 1, recommended
 	h.InformerFactory().Start(stopCh)
-	logrus.Info("Waiting for informer caches to sync")
+	h.log().Info("Waiting for informer caches to sync")
 	if ok := cache.WaitForCacheSync(stopCh, h.Informer().HasSynced); !ok {
-		logrus.Error("failed to wait for caches to sync")
+		h.log().Error("failed to wait for caches to sync")
 	}
 
 	//// method 2
 	//h.InformerFactory().Start(stopCh)
-	//logrus.Info("Waiting for informer caches to sync")
+	//h.log().Info("Waiting for informer caches to sync")
 	//h.InformerFactory().WaitForCacheSync(stopCh)
 
 	//// method 3
-	//logrus.Info("Waiting for informer caches to sync")
+	//h.log().Info("Waiting for informer caches to sync")
 	//h.informerFactory.WaitForCacheSync(stopCh)
 	//h.Informer().Run(stopCh)
 }
@@ -10,4 +10,5 @@ var (
 	ErrInvalidDeleteType = ErrInvalidCreateType
 	ErrInvalidGetType    = ErrInvalidCreateType
 	ErrInvalidPatchType  = errors.New("patch data type must be string, []byte, *rbacv1.ClusterRoleBinding, rbacv1.ClusterRoleBinding, metav1.Object, runtime.Object, *unstructured.Unstructured, unstructured.Unstructured or map[string]interface{}")
+	ErrInvalidObjectType = errors.New("object type is not *rbacv1.ClusterRoleBinding")
 )
@@ -2,7 +2,10 @@ package configmap
 
 import (
 	"fmt"
+	"io"
+	"net/http"
 
+	"github.com/forbearing/k8s/util/remote"
 	corev1 "k8s.io/api/core/v1"
 	k8serrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -12,7 +15,7 @@ import (
 
 // Apply applies configmap from type string, []byte, *corev1.ConfigMap,
 // corev1.ConfigMap, metav1.Object, runtime.Object, *unstructured.Unstructured,
-// unstructured.Unstructured or map[string]interface{}.
+// unstructured.Unstructured, map[string]interface{} or io.Reader.
 func (h *Handler) Apply(obj interface{}) (*corev1.ConfigMap, error) {
 	switch val := obj.(type) {
 	case string:
@@ -29,6 +32,8 @@ func (h *Handler) Apply(obj interface{}) (*corev1.ConfigMap, error) {
 		return h.ApplyFromUnstructured(&val)
 	case map[string]interface{}:
 		return h.ApplyFromMap(val)
+	case io.Reader:
+		return h.ApplyFromReader(val)
 	case metav1.Object, runtime.Object:
 		return h.ApplyFromObject(val)
 	default:
@@ -54,11 +59,31 @@ func (h *Handler) ApplyFromBytes(data []byte) (cm *corev1.ConfigMap, err error)
 	return
 }
 
+// ApplyFromURL applies configmap from the yaml or json manifest fetched from the
+// url, the optional http headers are sent with the request, eg: the
+// "Authorization" header carrying the auth token.
+func (h *Handler) ApplyFromURL(url string, header ...http.Header) (*corev1.ConfigMap, error) {
+	data, err := remote.Fetch(h.ctx, url, remote.DefaultTimeout, header...)
+	if err != nil {
+		return nil, err
+	}
+	return h.ApplyFromBytes(data)
+}
+
+// ApplyFromReader applies configmap from io.Reader, the reader is read until EOF.
+func (h *Handler) ApplyFromReader(r io.Reader) (*corev1.ConfigMap, error) {
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return nil, fmt.Errorf("read configmap data: %w", err)
+	}
+	return h.ApplyFromBytes(data)
+}
+
 // ApplyFromObject applies configmap from metav1.Object or runtime.Object.
 func (h *Handler) ApplyFromObject(obj interface{}) (*corev1.ConfigMap, error) {
 	cm, ok := obj.(*corev1.ConfigMap)
 	if !ok {
-		return nil, fmt.Errorf("object type is not *corev1.ConfigMap")
+		return nil, ErrInvalidObjectType
 	}
 	return h.applyConfigmap(cm)
 }
@@ -8,6 +8,7 @@ import (
 
 	"github.com/forbearing/k8s/types"
 	"github.com/forbearing/k8s/util/client"
+	utildiscovery "github.com/forbearing/k8s/util/discovery"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime/schema"
@@ -38,6 +39,10 @@ type Handler struct {
 	informerFactory  informers.SharedInformerFactory
 
 	Options *types.HandlerOptions
+	logger  types.Logger
+
+	concurrency int
+	paginateAll bool
 
 	l sync.RWMutex
 }
@@ -132,6 +137,15 @@ func (h *Handler) WithNamespace(namespace string) *Handler {
 	return cm
 }
 
+// WithContext deep copies a new handler, but set the handler.ctx to the
+// provided context, the new handler shares the clients with the original one,
+// but all its operations are bound to ctx, eg: a per-call deadline.
+func (h *Handler) WithContext(ctx context.Context) *Handler {
+	handler := h.DeepCopy()
+	handler.ctx = ctx
+	return handler
+}
+
 // WithDryRun deep copies a new handler and prints the create/update/apply/delete
 // operations, without sending it to apiserver.
 func (h *Handler) WithDryRun() *Handler {
@@ -147,6 +161,10 @@ func (in *Handler) DeepCopy() *Handler {
 	if in == nil {
 		return nil
 	}
+	// hold the read lock, so the Set* methods can't modify the handler while copying it.
+	in.l.RLock()
+	defer in.l.RUnlock()
+
 	return &Handler{
 		ctx:              in.ctx,
 		kubeconfig:       in.kubeconfig,
@@ -161,6 +179,9 @@ func (in *Handler) DeepCopy() *Handler {
 		resyncPeriod:     in.resyncPeriod,
 		informerScope:    in.informerScope,
 		tweakListOptions: in.tweakListOptions,
+		logger:           in.logger,
+		concurrency:      in.concurrency,
+		paginateAll:      in.paginateAll,
 		Options: &types.HandlerOptions{
 			CreateOptions: *in.Options.CreateOptions.DeepCopy(),
 			UpdateOptions: *in.Options.UpdateOptions.DeepCopy(),
@@ -183,11 +204,27 @@ func (h *Handler) SetTimeout(timeout int64) {
 	defer h.l.Unlock()
 	h.Options.ListOptions.TimeoutSeconds = &timeout
 }
+
+// SetLimit sets the limit of list.
+// If paginateAll is false(default), the limit caps the total number of
+// configmaps returned by ListByLabel, ListByField and ListByNamespace. If
+// paginateAll is true, the limit is the page size and they follow the continue
+// token to return all configmaps. List and ListAll always follow the continue
+// token, the limit is their page size no matter whether paginateAll is set.
 func (h *Handler) SetLimit(limit int64) {
 	h.l.Lock()
 	defer h.l.Unlock()
 	h.Options.ListOptions.Limit = limit
 }
+
+// SetPaginateAll sets whether ListByLabel, ListByField and ListByNamespace
+// follow the continue token to list all pages of configmaps, the limit set by
+// SetLimit is the page size. List and ListAll always list all pages.
+func (h *Handler) SetPaginateAll(paginateAll bool) {
+	h.l.Lock()
+	defer h.l.Unlock()
+	h.paginateAll = paginateAll
+}
 func (h *Handler) SetForceDelete(force bool) {
 	h.l.Lock()
 	defer h.l.Unlock()
@@ -196,6 +233,32 @@ func (h *Handler) SetForceDelete(force bool) {
 	}
 }
 
+// SetConcurrency sets the max number of concurrent requests sent to the
+// kubernetes API server by GetMany, default to 10.
+func (h *Handler) SetConcurrency(concurrency int) {
+	h.l.Lock()
+	defer h.l.Unlock()
+	h.concurrency = concurrency
+}
+
+// SetLogger sets the logger reporting the watch and informer events,
+// types.DiscardLogger silences them. The logrus standard logger is used by default.
+func (h *Handler) SetLogger(logger types.Logger) {
+	h.l.Lock()
+	defer h.l.Unlock()
+	h.logger = logger
+}
+
+// log returns the logger set by SetLogger, or the default logger.
+func (h *Handler) log() types.Logger {
+	h.l.RLock()
+	defer h.l.RUnlock()
+	if h.logger == nil {
+		return types.DefaultLogger()
+	}
+	return h.logger
+}
+
 // RESTConfig returns underlying rest config.
 func (h *Handler) RESTConfig() *rest.Config {
 	return h.config
@@ -221,6 +284,12 @@ func (h *Handler) DiscoveryClient() *discovery.DiscoveryClient {
 	return h.discoveryClient
 }
 
+// APIVerbs returns the verbs supported by the kubernetes apiserver for configmap,
+// such as "get", "list", "watch", "create", "update", "patch" and "delete".
+func (h *Handler) APIVerbs() ([]string, error) {
+	return utildiscovery.APIVerbs(h.discoveryClient, GVR)
+}
+
 // GVK contains the Group, Version, Kind name of configmap.
 var GVK = schema.GroupVersionKind{
 	Group:   corev1.SchemeGroupVersion.Group,
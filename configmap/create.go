@@ -1,8 +1,10 @@
 package configmap
 
 import (
+	"bytes"
 	"encoding/json"
 	"fmt"
+	"io"
 	"io/ioutil"
 
 	corev1 "k8s.io/api/core/v1"
@@ -14,7 +16,7 @@ import (
 
 // Create creates configmap from type string, []byte, *corev1.ConfigMap,
 // corev1.ConfigMap, metav1.Object, runtime.Object, *unstructured.Unstructured,
-// unstructured.Unstructured or map[string]interface{}.
+// unstructured.Unstructured, map[string]interface{} or io.Reader.
 func (h *Handler) Create(obj interface{}) (*corev1.ConfigMap, error) {
 	switch val := obj.(type) {
 	case string:
@@ -31,6 +33,8 @@ func (h *Handler) Create(obj interface{}) (*corev1.ConfigMap, error) {
 		return h.CreateFromUnstructured(&val)
 	case map[string]interface{}:
 		return h.CreateFromMap(val)
+	case io.Reader:
+		return h.CreateFromReader(val)
 	case metav1.Object, runtime.Object:
 		return h.CreateFromObject(val)
 	default:
@@ -48,6 +52,8 @@ func (h *Handler) CreateFromFile(filename string) (*corev1.ConfigMap, error) {
 }
 
 // CreateFromBytes creates configmap from bytes data.
+// Only the first yaml document is used, call CreateAllFromBytes to create
+// all configmaps defined in the multi-document yaml data.
 func (h *Handler) CreateFromBytes(data []byte) (*corev1.ConfigMap, error) {
 	cmJson, err := yaml.ToJSON(data)
 	if err != nil {
@@ -61,11 +67,52 @@ func (h *Handler) CreateFromBytes(data []byte) (*corev1.ConfigMap, error) {
 	return h.createConfigmap(cm)
 }
 
+// CreateFromReader creates configmap from io.Reader, the reader is read until EOF.
+func (h *Handler) CreateFromReader(r io.Reader) (*corev1.ConfigMap, error) {
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return nil, fmt.Errorf("read configmap data: %w", err)
+	}
+	return h.CreateFromBytes(data)
+}
+
+// CreateAllFromFile creates every configmap defined in the multi-document
+// yaml or json file, see CreateAllFromBytes.
+func (h *Handler) CreateAllFromFile(filename string, skipOtherKinds bool) ([]*corev1.ConfigMap, error) {
+	data, err := ioutil.ReadFile(filename)
+	if err != nil {
+		return nil, err
+	}
+	return h.CreateAllFromBytes(data, skipOtherKinds)
+}
+
+// CreateAllFromBytes creates every configmap defined in the multi-document
+// yaml or json data, the documents are separated by "---".
+//
+// If a document is not a configmap, it's skipped if skipOtherKinds is true,
+// otherwise ErrKindMismatch is returned and nothing is created.
+// If failed to create a configmap, the created configmaps and the error are returned.
+func (h *Handler) CreateAllFromBytes(data []byte, skipOtherKinds bool) ([]*corev1.ConfigMap, error) {
+	objs, err := decodeAll(data, skipOtherKinds)
+	if err != nil {
+		return nil, err
+	}
+	var cms []*corev1.ConfigMap
+	for _, obj := range objs {
+		created, err := h.createConfigmap(obj)
+		if err != nil {
+			return cms, err
+		}
+		cms = append(cms, created)
+	}
+	return cms, nil
+}
+
 // CreateFromObject creates configmap from metav1.Object or runtime.Object.
 func (h *Handler) CreateFromObject(obj interface{}) (*corev1.ConfigMap, error) {
 	cm, ok := obj.(*corev1.ConfigMap)
 	if !ok {
-		return nil, fmt.Errorf("object type is not *corev1.ConfigMap")
+		return nil, ErrInvalidObjectType
 	}
 	return h.createConfigmap(cm)
 }
@@ -100,3 +147,33 @@ func (h *Handler) createConfigmap(cm *corev1.ConfigMap) (*corev1.ConfigMap, erro
 	cm.UID = ""
 	return h.clientset.CoreV1().ConfigMaps(namespace).Create(h.ctx, cm, h.Options.CreateOptions)
 }
+
+// decodeAll decodes every configmap in the multi-document yaml or json data.
+func decodeAll(data []byte, skipOtherKinds bool) ([]*corev1.ConfigMap, error) {
+	var objs []*corev1.ConfigMap
+	decoder := yaml.NewYAMLOrJSONDecoder(bytes.NewReader(data), 4096)
+	for {
+		doc := make(map[string]interface{})
+		if err := decoder.Decode(&doc); err == io.EOF {
+			return objs, nil
+		} else if err != nil {
+			return nil, err
+		}
+		// empty document.
+		if len(doc) == 0 {
+			continue
+		}
+		u := &unstructured.Unstructured{Object: doc}
+		if u.GroupVersionKind() != GVK {
+			if skipOtherKinds {
+				continue
+			}
+			return nil, fmt.Errorf("%w: %s %s", ErrKindMismatch, u.GroupVersionKind(), u.GetName())
+		}
+		obj := &corev1.ConfigMap{}
+		if err := runtime.DefaultUnstructuredConverter.FromUnstructured(doc, obj); err != nil {
+			return nil, err
+		}
+		objs = append(objs, obj)
+	}
+}
@@ -0,0 +1,55 @@
+package configmap
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func TestDecodeAll(t *testing.T) {
+	twoDocs := `apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: cm1
+---
+# comments only
+---
+apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: cm2
+data:
+  key: value
+`
+	mixedKinds := twoDocs + `---
+apiVersion: v1
+kind: Secret
+metadata:
+  name: secret1
+`
+	tests := []struct {
+		name           string
+		data           string
+		skipOtherKinds bool
+		want           []string
+		wantErr        error
+	}{
+		{name: "two documents", data: twoDocs, want: []string{"cm1", "cm2"}},
+		{name: "mixed kinds skipped", data: mixedKinds, skipOtherKinds: true, want: []string{"cm1", "cm2"}},
+		{name: "mixed kinds rejected", data: mixedKinds, wantErr: ErrKindMismatch},
+	}
+	for _, tt := range tests {
+		cms, err := decodeAll([]byte(tt.data), tt.skipOtherKinds)
+		if !errors.Is(err, tt.wantErr) {
+			t.Errorf("%s: error = %v, want %v", tt.name, err, tt.wantErr)
+			continue
+		}
+		var names []string
+		for _, cm := range cms {
+			names = append(names, cm.Name)
+		}
+		if !reflect.DeepEqual(names, tt.want) {
+			t.Errorf("%s: got %v, want %v", tt.name, names, tt.want)
+		}
+	}
+}
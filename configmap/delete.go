@@ -3,6 +3,7 @@ package configmap
 import (
 	"encoding/json"
 	"fmt"
+	"io"
 	"io/ioutil"
 
 	corev1 "k8s.io/api/core/v1"
@@ -14,7 +15,7 @@ import (
 
 // Delete deletes configmap from type string, []byte, *corev1.ConfigMap,
 // corev1.ConfigMap, metav1.Object, runtime.Object, *unstructured.Unstructured,
-// unstructured.Unstructured or map[string]interface{}.
+// unstructured.Unstructured, map[string]interface{} or io.Reader.
 //
 // If passed parameter type is string, it will simply call DeleteByName instead of DeleteFromFile.
 // You should always explicitly call DeleteFromFile to delete a configmap from file path.
@@ -34,6 +35,8 @@ func (h *Handler) Delete(obj interface{}) error {
 		return h.DeleteFromUnstructured(&val)
 	case map[string]interface{}:
 		return h.DeleteFromMap(val)
+	case io.Reader:
+		return h.DeleteFromReader(val)
 	case metav1.Object, runtime.Object:
 		return h.DeleteFromObject(val)
 	default:
@@ -46,6 +49,21 @@ func (h *Handler) DeleteByName(name string) error {
 	return h.clientset.CoreV1().ConfigMaps(h.namespace).Delete(h.ctx, name, h.Options.DeleteOptions)
 }
 
+// DeleteWithOptions deletes the configmap by name with the delete options, such
+// as the propagation policy and the grace period, instead of the delete options
+// of the handler. It doesn't modify the handler, so it's safe to be called
+// concurrently with the different options.
+func (h *Handler) DeleteWithOptions(name string, opts metav1.DeleteOptions) error {
+	return h.clientset.CoreV1().ConfigMaps(h.namespace).Delete(h.ctx, name, opts)
+}
+
+// DeleteCollection deletes all the configmaps selected by the listOptions in the
+// handler namespace, such as the configmaps matching the label selector.
+// The delete options of the handler, such as the propagation policy, are used.
+func (h *Handler) DeleteCollection(listOptions metav1.ListOptions) error {
+	return h.clientset.CoreV1().ConfigMaps(h.namespace).DeleteCollection(h.ctx, h.Options.DeleteOptions, listOptions)
+}
+
 // DeleteFromFile deletes configmap from yaml or json file.
 func (h *Handler) DeleteFromFile(filename string) error {
 	data, err := ioutil.ReadFile(filename)
@@ -69,11 +87,20 @@ func (h *Handler) DeleteFromBytes(data []byte) error {
 	return h.deleteConfigmap(cm)
 }
 
+// DeleteFromReader deletes configmap from io.Reader, the reader is read until EOF.
+func (h *Handler) DeleteFromReader(r io.Reader) error {
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return fmt.Errorf("read configmap data: %w", err)
+	}
+	return h.DeleteFromBytes(data)
+}
+
 // DeleteFromObject deletes configmap from metav1.Object or runtime.Object.
 func (h *Handler) DeleteFromObject(obj interface{}) error {
 	cm, ok := obj.(*corev1.ConfigMap)
 	if !ok {
-		return fmt.Errorf("object type is not *corev1.ConfigMap")
+		return ErrInvalidObjectType
 	}
 	return h.deleteConfigmap(cm)
 }
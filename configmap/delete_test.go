@@ -0,0 +1,104 @@
+package configmap
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"sort"
+	"sync"
+	"testing"
+
+	"github.com/forbearing/k8s/types"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/rest"
+)
+
+func TestDeleteCollection(t *testing.T) {
+	var (
+		mu         sync.Mutex
+		configmaps = map[string]labels.Set{
+			"nginx-conf":  {"app": "nginx"},
+			"nginx-html":  {"app": "nginx"},
+			"redis-conf":  {"app": "redis"},
+			"shared-conf": {},
+		}
+	)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+		if r.Method != http.MethodDelete || r.URL.Path != "/api/v1/namespaces/test/configmaps" {
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+		selector, err := labels.Parse(r.URL.Query().Get("labelSelector"))
+		if err != nil {
+			t.Error(err)
+		}
+		for name, set := range configmaps {
+			if selector.Matches(set) {
+				delete(configmaps, name)
+			}
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"kind":"Status","apiVersion":"v1","status":"Success"}`))
+	}))
+	defer srv.Close()
+	clientset, err := kubernetes.NewForConfig(&rest.Config{Host: srv.URL})
+	if err != nil {
+		t.Fatal(err)
+	}
+	h := &Handler{ctx: context.Background(), namespace: "test", clientset: clientset, Options: &types.HandlerOptions{}}
+
+	if err := h.DeleteCollection(metav1.ListOptions{LabelSelector: "app in (nginx)"}); err != nil {
+		t.Fatal(err)
+	}
+	var remained []string
+	for name := range configmaps {
+		remained = append(remained, name)
+	}
+	sort.Strings(remained)
+	if want := []string{"redis-conf", "shared-conf"}; !reflect.DeepEqual(remained, want) {
+		t.Errorf("remained configmaps = %v, want %v", remained, want)
+	}
+}
+
+func TestDeleteWithOptions(t *testing.T) {
+	var deleteOptions metav1.DeleteOptions
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodDelete || r.URL.Path != "/api/v1/namespaces/test/configmaps/nginx-conf" {
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+		if err := json.NewDecoder(r.Body).Decode(&deleteOptions); err != nil {
+			t.Error(err)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"kind":"Status","apiVersion":"v1","status":"Success"}`))
+	}))
+	defer srv.Close()
+	clientset, err := kubernetes.NewForConfig(&rest.Config{Host: srv.URL})
+	if err != nil {
+		t.Fatal(err)
+	}
+	h := &Handler{ctx: context.Background(), namespace: "test", clientset: clientset, Options: &types.HandlerOptions{}}
+
+	background := metav1.DeletePropagationBackground
+	h.Options.DeleteOptions.PropagationPolicy = &background
+
+	foreground := metav1.DeletePropagationForeground
+	gracePeriodSeconds := int64(0)
+	opts := metav1.DeleteOptions{PropagationPolicy: &foreground, GracePeriodSeconds: &gracePeriodSeconds}
+	if err := h.DeleteWithOptions("nginx-conf", opts); err != nil {
+		t.Fatal(err)
+	}
+	if deleteOptions.PropagationPolicy == nil || *deleteOptions.PropagationPolicy != foreground ||
+		deleteOptions.GracePeriodSeconds == nil || *deleteOptions.GracePeriodSeconds != 0 {
+		t.Errorf("delete options = %+v, want %+v", deleteOptions, opts)
+	}
+	// the handler delete options are untouched.
+	if *h.Options.DeleteOptions.PropagationPolicy != background || h.Options.DeleteOptions.GracePeriodSeconds != nil {
+		t.Errorf("handler delete options are modified: %+v", h.Options.DeleteOptions)
+	}
+}
@@ -0,0 +1,20 @@
+package configmap
+
+import (
+	"github.com/forbearing/k8s/util/export"
+)
+
+// ExportForGit gets the configmap and serializes it to a clean yaml which is
+// suitable for committing to a git repository.
+//
+// The server-managed fields (managedFields, resourceVersion, uid, creationTimestamp,
+// generation, status, etc.) are removed, you can provide the field paths to
+// override the default strip list export.DefaultStripFields, eg:
+//     ExportForGit("mycm", []string{"metadata", "managedFields"}, []string{"status"})
+func (h *Handler) ExportForGit(name string, stripFields ...[]string) ([]byte, error) {
+	cm, err := h.Get(name)
+	if err != nil {
+		return nil, err
+	}
+	return export.ToYAML(cm, GVK, stripFields...)
+}
@@ -3,9 +3,12 @@ package configmap
 import (
 	"encoding/json"
 	"fmt"
+	"io"
 	"io/ioutil"
+	"sync"
 
 	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime"
@@ -14,7 +17,7 @@ import (
 
 // Get gets configmap from type string, []byte, *corev1.ConfigMap,
 // corev1.ConfigMap, metav1.Object, runtime.Object, *unstructured.Unstructured,
-// unstructured.Unstructured or map[string]interface{}.
+// unstructured.Unstructured, map[string]interface{} or io.Reader.
 //
 // If passed parameter type is string, it will simply call GetByName instead of GetFromFile.
 // You should always explicitly call GetFromFile to get a configmap from file path.
@@ -34,6 +37,8 @@ func (h *Handler) Get(obj interface{}) (*corev1.ConfigMap, error) {
 		return h.GetFromUnstructured(&val)
 	case map[string]interface{}:
 		return h.GetFromMap(val)
+	case io.Reader:
+		return h.GetFromReader(val)
 	case metav1.Object, runtime.Object:
 		return h.GetFromObject(val)
 	default:
@@ -46,6 +51,20 @@ func (h *Handler) GetByName(name string) (*corev1.ConfigMap, error) {
 	return h.clientset.CoreV1().ConfigMaps(h.namespace).Get(h.ctx, name, h.Options.GetOptions)
 }
 
+// Exists returns true if the configmap exists. It returns false and nil error if
+// the configmap is not found, the other errors, such as forbidden, are returned.
+func (h *Handler) Exists(name string) (bool, error) {
+	_, err := h.GetByName(name)
+	switch {
+	case err == nil:
+		return true, nil
+	case k8serrors.IsNotFound(err):
+		return false, nil
+	default:
+		return false, err
+	}
+}
+
 // GetFromFile gets configmap from yaml or json file.
 func (h *Handler) GetFromFile(filename string) (*corev1.ConfigMap, error) {
 	data, err := ioutil.ReadFile(filename)
@@ -69,11 +88,20 @@ func (h *Handler) GetFromBytes(data []byte) (*corev1.ConfigMap, error) {
 	return h.getConfigmap(cm)
 }
 
+// GetFromReader gets configmap from io.Reader, the reader is read until EOF.
+func (h *Handler) GetFromReader(r io.Reader) (*corev1.ConfigMap, error) {
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return nil, fmt.Errorf("read configmap data: %w", err)
+	}
+	return h.GetFromBytes(data)
+}
+
 // GetFromObject gets configmap from metav1.Object or runtime.Object.
 func (h *Handler) GetFromObject(obj interface{}) (*corev1.ConfigMap, error) {
 	cm, ok := obj.(*corev1.ConfigMap)
 	if !ok {
-		return nil, fmt.Errorf("object type is not *corev1.ConfigMap")
+		return nil, ErrInvalidObjectType
 	}
 	return h.getConfigmap(cm)
 }
@@ -108,3 +136,64 @@ func (h *Handler) getConfigmap(cm *corev1.ConfigMap) (*corev1.ConfigMap, error)
 	}
 	return h.clientset.CoreV1().ConfigMaps(namespace).Get(h.ctx, cm.Name, h.Options.GetOptions)
 }
+
+// defaultConcurrency is the default max number of concurrent requests sent
+// by GetMany.
+const defaultConcurrency = 10
+
+// GetMany gets multiple configmaps by names concurrently, the number of concurrent
+// requests is limited by SetConcurrency(default to 10).
+//
+// It returns a map keyed by configmap name and the errors of the configmaps
+// that failed to get. The remaining configmaps will not be fetched if the handler
+// context is done.
+func (h *Handler) GetMany(names []string) (map[string]*corev1.ConfigMap, []error) {
+	h.l.RLock()
+	concurrency := h.concurrency
+	h.l.RUnlock()
+	if concurrency <= 0 {
+		concurrency = defaultConcurrency
+	}
+
+	var (
+		mu    sync.Mutex
+		wg    sync.WaitGroup
+		errs  []error
+		objs  = make(map[string]*corev1.ConfigMap, len(names))
+		limit = make(chan struct{}, concurrency)
+	)
+	for _, name := range names {
+		select {
+		case <-h.ctx.Done():
+			mu.Lock()
+			errs = append(errs, fmt.Errorf("configmap/%s: %w", name, h.ctx.Err()))
+			mu.Unlock()
+			continue
+		case limit <- struct{}{}:
+		}
+		// select chooses randomly if the context is done while the semaphore
+		// is available, check the context again after acquiring it.
+		if err := h.ctx.Err(); err != nil {
+			<-limit
+			mu.Lock()
+			errs = append(errs, fmt.Errorf("configmap/%s: %w", name, err))
+			mu.Unlock()
+			continue
+		}
+		wg.Add(1)
+		go func(name string) {
+			defer wg.Done()
+			defer func() { <-limit }()
+			cm, err := h.GetByName(name)
+			mu.Lock()
+			defer mu.Unlock()
+			if err != nil {
+				errs = append(errs, fmt.Errorf("configmap/%s: %w", name, err))
+				return
+			}
+			objs[name] = cm
+		}(name)
+	}
+	wg.Wait()
+	return objs, errs
+}
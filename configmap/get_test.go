@@ -0,0 +1,149 @@
+package configmap
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"path"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/forbearing/k8s/types"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/rest"
+)
+
+func TestExists(t *testing.T) {
+	// kube-root-ca.crt is published to every namespace, app-config only
+	// exists in namespace "other".
+	configmaps := map[string]bool{
+		"/api/v1/namespaces/test/configmaps/kube-root-ca.crt":  true,
+		"/api/v1/namespaces/other/configmaps/kube-root-ca.crt": true,
+		"/api/v1/namespaces/other/configmaps/app-config":       true,
+	}
+	tests := []struct {
+		name       string
+		configmap  string
+		status     int
+		wantExists bool
+		wantErr    func(error) bool
+	}{
+		{"exists", "kube-root-ca.crt", http.StatusOK, true, nil},
+		{"exists in other namespace", "app-config", http.StatusOK, false, nil},
+		{"not found", "missing", http.StatusOK, false, nil},
+		{"server error is not not found", "kube-root-ca.crt", http.StatusInternalServerError, false, k8serrors.IsInternalError},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.Header().Set("Content-Type", "application/json")
+				switch {
+				case test.status != http.StatusOK:
+					w.WriteHeader(test.status)
+					fmt.Fprintf(w, `{"kind":"Status","apiVersion":"v1","status":"Failure","reason":"InternalError","code":%d}`+"\n", test.status)
+				case configmaps[r.URL.Path]:
+					fmt.Fprintln(w, `{"kind":"ConfigMap","apiVersion":"v1","metadata":{"name":"kube-root-ca.crt","namespace":"test"}}`)
+				default:
+					w.WriteHeader(http.StatusNotFound)
+					fmt.Fprintln(w, `{"kind":"Status","apiVersion":"v1","status":"Failure","reason":"NotFound","code":404}`)
+				}
+			}))
+			defer srv.Close()
+			clientset, err := kubernetes.NewForConfig(&rest.Config{Host: srv.URL})
+			if err != nil {
+				t.Fatal(err)
+			}
+			h := &Handler{ctx: context.Background(), namespace: "test", clientset: clientset, Options: &types.HandlerOptions{}}
+
+			exists, err := h.Exists(test.configmap)
+			if exists != test.wantExists {
+				t.Errorf("Exists(%s) = %v, want %v", test.configmap, exists, test.wantExists)
+			}
+			if test.wantErr == nil && err != nil {
+				t.Errorf("Exists(%s) error = %v, want nil", test.configmap, err)
+			}
+			if test.wantErr != nil && !test.wantErr(err) {
+				t.Errorf("Exists(%s) error = %v, want the server error", test.configmap, err)
+			}
+		})
+	}
+}
+
+func TestGetMany(t *testing.T) {
+	names := []string{"a", "b", "missing", "c"}
+	var requests, inflight, maxInflight int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&requests, 1)
+		n := atomic.AddInt32(&inflight, 1)
+		defer atomic.AddInt32(&inflight, -1)
+		for {
+			max := atomic.LoadInt32(&maxInflight)
+			if n <= max || atomic.CompareAndSwapInt32(&maxInflight, max, n) {
+				break
+			}
+		}
+		name := path.Base(r.URL.Path)
+		// the former configmaps are returned later.
+		switch name {
+		case "a":
+			time.Sleep(30 * time.Millisecond)
+		case "b":
+			time.Sleep(10 * time.Millisecond)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		if name == "missing" {
+			w.WriteHeader(http.StatusNotFound)
+			fmt.Fprintln(w, `{"kind":"Status","apiVersion":"v1","status":"Failure","reason":"NotFound","code":404}`)
+			return
+		}
+		fmt.Fprintf(w, `{"kind":"ConfigMap","apiVersion":"v1","metadata":{"name":%q,"namespace":"test"}}`+"\n", name)
+	}))
+	defer srv.Close()
+	clientset, err := kubernetes.NewForConfig(&rest.Config{Host: srv.URL})
+	if err != nil {
+		t.Fatal(err)
+	}
+	h := &Handler{ctx: context.Background(), namespace: "test", clientset: clientset, Options: &types.HandlerOptions{}}
+	h.SetConcurrency(2)
+
+	objs, errs := h.GetMany(names)
+	if len(objs) != 3 {
+		t.Errorf("GetMany() got %d configmaps, want 3", len(objs))
+	}
+	for _, name := range []string{"a", "b", "c"} {
+		if cm, ok := objs[name]; !ok || cm.Name != name {
+			t.Errorf("GetMany()[%s] = %v, want configmap %s", name, cm, name)
+		}
+	}
+	if len(errs) != 1 || !k8serrors.IsNotFound(errs[0]) || !strings.Contains(errs[0].Error(), "configmap/missing") {
+		t.Errorf("GetMany() errors = %v, want configmap/missing not found", errs)
+	}
+	if max := atomic.LoadInt32(&maxInflight); max > 2 {
+		t.Errorf("%d concurrent requests, want at most 2", max)
+	}
+
+	// the configmaps are not fetched after the handler context is done.
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	h.ctx = ctx
+	atomic.StoreInt32(&requests, 0)
+	for i := 0; i < 10; i++ {
+		objs, errs = h.GetMany(names)
+		if len(objs) != 0 || len(errs) != len(names) {
+			t.Fatalf("GetMany() = %d configmaps, %d errors, want 0 and %d", len(objs), len(errs), len(names))
+		}
+		for _, err := range errs {
+			if !errors.Is(err, context.Canceled) {
+				t.Errorf("GetMany() error = %v, want context.Canceled", err)
+			}
+		}
+	}
+	if n := atomic.LoadInt32(&requests); n != 0 {
+		t.Errorf("%d requests sent after the context is done, want 0", n)
+	}
+}
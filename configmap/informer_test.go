@@ -0,0 +1,76 @@
+package configmap
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"sync"
+	"testing"
+
+	"github.com/forbearing/k8s/types"
+	"k8s.io/client-go/informers"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/rest"
+)
+
+// infoLogger is a types.Logger recording the info and error messages.
+type infoLogger struct {
+	mu   sync.Mutex
+	msgs []string
+}
+
+func (l *infoLogger) Debug(args ...interface{}) {}
+func (l *infoLogger) Info(args ...interface{})  { l.record("info: " + fmt.Sprint(args...)) }
+func (l *infoLogger) Error(args ...interface{}) { l.record("error: " + fmt.Sprint(args...)) }
+
+func (l *infoLogger) record(msg string) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.msgs = append(l.msgs, msg)
+}
+
+func TestRunInformerLogger(t *testing.T) {
+	// done releases the watch requests of the informer before closing the server.
+	done := make(chan struct{})
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if r.URL.Query().Get("watch") == "true" {
+			select {
+			case <-r.Context().Done():
+			case <-done:
+			}
+			return
+		}
+		fmt.Fprintln(w, `{"kind":"ConfigMapList","apiVersion":"v1","metadata":{"resourceVersion":"1"},"items":[]}`)
+	}))
+	defer srv.Close()
+	defer close(done)
+	clientset, err := kubernetes.NewForConfig(&rest.Config{Host: srv.URL})
+	if err != nil {
+		t.Fatal(err)
+	}
+	h := &Handler{
+		ctx:             context.Background(),
+		clientset:       clientset,
+		informerFactory: informers.NewSharedInformerFactory(clientset, 0),
+		Options:         &types.HandlerOptions{},
+	}
+	logger := &infoLogger{}
+	h.SetLogger(logger)
+
+	stopCh := make(chan struct{})
+	defer close(stopCh)
+	noop := func(obj interface{}) {}
+	h.RunInformer(stopCh, noop, func(oldObj, newObj interface{}) {}, noop)
+
+	logger.mu.Lock()
+	defer logger.mu.Unlock()
+	if want := []string{"info: Waiting for informer caches to sync"}; !reflect.DeepEqual(logger.msgs, want) {
+		t.Errorf("messages = %q, want %q", logger.msgs, want)
+	}
+	if h.DeepCopy().log() != logger {
+		t.Error("DeepCopy() doesn't keep the logger")
+	}
+}
@@ -1,9 +1,21 @@
 package configmap
 
 import (
+	"context"
+	"time"
+
 	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/watch"
+)
+
+const (
+	// listSinceWindow is the max duration ListSince collects the changes.
+	listSinceWindow = 10 * time.Second
+	// listSinceIdle is the duration ListSince waits for the next change before returning.
+	listSinceIdle = 500 * time.Millisecond
 )
 
 // List list all configmaps in the k8s cluster, it simply call `ListAll`.
@@ -17,7 +29,7 @@ func (h *Handler) List() ([]*corev1.ConfigMap, error) {
 func (h *Handler) ListByLabel(labels string) ([]*corev1.ConfigMap, error) {
 	listOptions := h.Options.ListOptions.DeepCopy()
 	listOptions.LabelSelector = labels
-	cmList, err := h.clientset.CoreV1().ConfigMaps(h.namespace).List(h.ctx, *listOptions)
+	cmList, err := h.list(*listOptions)
 	if err != nil {
 		return nil, err
 	}
@@ -33,7 +45,7 @@ func (h *Handler) ListByField(field string) ([]*corev1.ConfigMap, error) {
 	listOptions := h.Options.ListOptions.DeepCopy()
 	listOptions.FieldSelector = fieldSelector.String()
 
-	cmList, err := h.clientset.CoreV1().ConfigMaps(h.namespace).List(h.ctx, *listOptions)
+	cmList, err := h.list(*listOptions)
 	if err != nil {
 		return nil, err
 	}
@@ -46,8 +58,149 @@ func (h *Handler) ListByNamespace(namespace string) ([]*corev1.ConfigMap, error)
 }
 
 // ListAll list all configmaps in the k8s cluster.
+// It always follows the continue token to list all pages no matter whether
+// paginateAll is set, the limit set by SetLimit is the page size, so listing
+// a large cluster doesn't time out or load everything in a single response.
 func (h *Handler) ListAll() ([]*corev1.ConfigMap, error) {
-	return h.WithNamespace(metav1.NamespaceAll).ListByLabel("")
+	listOptions := h.Options.ListOptions.DeepCopy()
+	objList, err := paginate(*listOptions, true, func(listOptions metav1.ListOptions) (*corev1.ConfigMapList, error) {
+		return h.clientset.CoreV1().ConfigMaps(metav1.NamespaceAll).List(h.ctx, listOptions)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return extractList(objList), nil
+}
+
+// ListSince list the configmaps added or modified since the resourceVersion,
+// the keys("namespace/name") of the configmaps deleted since the resourceVersion,
+// and returns the new resourceVersion as the cursor of the next ListSince.
+// It's useful for incremental sync that doesn't want a full informer.
+//
+// The changes are fetched by watching from the resourceVersion, the watch
+// stops when there is no more event in listSinceIdle or listSinceWindow elapses.
+// A configmap deleted and added again is only returned as changed, a configmap
+// changed and then deleted is only returned as deleted. If the resourceVersion
+// is too old(410 Gone), a "Expired" error is returned and the caller should
+// list all configmaps again.
+func (h *Handler) ListSince(resourceVersion string) (changed []*corev1.ConfigMap, deleted []string, newResourceVersion string, err error) {
+	ctx, cancel := context.WithTimeout(h.ctx, listSinceWindow)
+	defer cancel()
+
+	listOptions := h.Options.ListOptions.DeepCopy()
+	listOptions.ResourceVersion = resourceVersion
+	listOptions.AllowWatchBookmarks = true
+	watcher, err := h.clientset.CoreV1().ConfigMaps(h.namespace).Watch(ctx, *listOptions)
+	if err != nil {
+		return nil, nil, "", err
+	}
+	defer watcher.Stop()
+
+	// latest holds the latest state of every configmap changed in the window,
+	// a nil value means the configmap is deleted.
+	var keys []string
+	latest := make(map[string]*corev1.ConfigMap)
+	idle := time.NewTimer(listSinceIdle)
+	defer idle.Stop()
+	for {
+		select {
+		case event, ok := <-watcher.ResultChan():
+			if !ok {
+				changed, deleted = splitChanges(keys, latest)
+				return changed, deleted, resourceVersion, nil
+			}
+			if event.Type == watch.Error {
+				return nil, nil, "", k8serrors.FromObject(event.Object)
+			}
+			cm, ok := event.Object.(*corev1.ConfigMap)
+			if !ok {
+				continue
+			}
+			resourceVersion = cm.ResourceVersion
+			if event.Type != watch.Bookmark {
+				key := cm.Namespace + "/" + cm.Name
+				if _, ok := latest[key]; !ok {
+					keys = append(keys, key)
+				}
+				switch event.Type {
+				case watch.Added, watch.Modified:
+					latest[key] = cm
+				case watch.Deleted:
+					latest[key] = nil
+				}
+			}
+			if !idle.Stop() {
+				<-idle.C
+			}
+			idle.Reset(listSinceIdle)
+		case <-idle.C:
+			changed, deleted = splitChanges(keys, latest)
+			return changed, deleted, resourceVersion, nil
+		case <-ctx.Done():
+			if h.ctx.Err() != nil {
+				return nil, nil, "", h.ctx.Err()
+			}
+			changed, deleted = splitChanges(keys, latest)
+			return changed, deleted, resourceVersion, nil
+		}
+	}
+}
+
+// splitChanges splits the latest state of the configmaps into the changed
+// configmaps and the deleted keys, in the order they are first changed.
+func splitChanges(keys []string, latest map[string]*corev1.ConfigMap) (changed []*corev1.ConfigMap, deleted []string) {
+	for _, key := range keys {
+		if cm := latest[key]; cm != nil {
+			changed = append(changed, cm)
+		} else {
+			deleted = append(deleted, key)
+		}
+	}
+	return changed, deleted
+}
+
+// list lists configmaps with the listOptions, if paginateAll is true, it
+// follows the continue token to list all pages.
+func (h *Handler) list(listOptions metav1.ListOptions) (*corev1.ConfigMapList, error) {
+	h.l.RLock()
+	paginateAll := h.paginateAll
+	h.l.RUnlock()
+	return paginate(listOptions, paginateAll, func(listOptions metav1.ListOptions) (*corev1.ConfigMapList, error) {
+		return h.clientset.CoreV1().ConfigMaps(h.namespace).List(h.ctx, listOptions)
+	})
+}
+
+// maxPaginateRestarts is the max times the listing restarts from the first
+// page after the continue token expired.
+const maxPaginateRestarts = 3
+
+// paginate calls listFunc to list the first page, if paginateAll is true,
+// it calls listFunc with the continue token until all pages are listed.
+// If the continue token expired(410 Gone), the listing restarts from the first
+// page, at most maxPaginateRestarts times, then the Expired error is returned.
+func paginate(listOptions metav1.ListOptions, paginateAll bool,
+	listFunc func(metav1.ListOptions) (*corev1.ConfigMapList, error)) (*corev1.ConfigMapList, error) {
+restart:
+	for restarts := 0; ; restarts++ {
+		listOptions.Continue = ""
+		objList, err := listFunc(listOptions)
+		if err != nil || !paginateAll {
+			return objList, err
+		}
+		for len(objList.Continue) != 0 {
+			listOptions.Continue = objList.Continue
+			next, err := listFunc(listOptions)
+			if k8serrors.IsResourceExpired(err) && restarts < maxPaginateRestarts {
+				continue restart
+			}
+			if err != nil {
+				return nil, err
+			}
+			objList.Items = append(objList.Items, next.Items...)
+			objList.Continue = next.Continue
+		}
+		return objList, nil
+	}
 }
 
 // extractList
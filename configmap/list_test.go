@@ -0,0 +1,235 @@
+package configmap
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strconv"
+	"testing"
+
+	"github.com/forbearing/k8s/types"
+	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/rest"
+)
+
+func TestPaginate(t *testing.T) {
+	// fakeList returns 5 configmaps in pages of listOptions.Limit, with a continue token.
+	var calls int
+	fakeList := func(listOptions metav1.ListOptions) (*corev1.ConfigMapList, error) {
+		calls++
+		start, _ := strconv.Atoi(listOptions.Continue)
+		end := start + int(listOptions.Limit)
+		objList := &corev1.ConfigMapList{}
+		for i := start; i < end && i < 5; i++ {
+			objList.Items = append(objList.Items, corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: strconv.Itoa(i)}})
+		}
+		if end < 5 {
+			objList.Continue = strconv.Itoa(end)
+		}
+		return objList, nil
+	}
+
+	tests := []struct {
+		name        string
+		paginateAll bool
+		wantItems   int
+		wantCalls   int
+	}{
+		{"limit caps the total", false, 2, 1},
+		{"limit is the page size", true, 5, 3},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			calls = 0
+			objList, err := paginate(metav1.ListOptions{Limit: 2}, test.paginateAll, fakeList)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(objList.Items) != test.wantItems || calls != test.wantCalls {
+				t.Errorf("got %d items in %d calls, want %d items in %d calls",
+					len(objList.Items), calls, test.wantItems, test.wantCalls)
+			}
+			for i, cm := range objList.Items {
+				if cm.Name != strconv.Itoa(i) {
+					t.Errorf("items[%d].Name = %s, want %d", i, cm.Name, i)
+				}
+			}
+		})
+	}
+}
+
+func TestListPaginateModes(t *testing.T) {
+	// 3 configmaps are listed in pages of listOptions.Limit with a continue token.
+	serve := func(w http.ResponseWriter, r *http.Request) {
+		query := r.URL.Query()
+		if query.Get("limit") != "1" {
+			t.Errorf("limit = %q, want 1", query.Get("limit"))
+		}
+		start, _ := strconv.Atoi(query.Get("continue"))
+		next := ""
+		if start+1 < 3 {
+			next = strconv.Itoa(start + 1)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprintf(w, `{"kind":"ConfigMapList","apiVersion":"v1","metadata":{"continue":%q},"items":[{"metadata":{"name":"%d","namespace":"test"}}]}`, next, start)
+	}
+	tests := []struct {
+		name        string
+		paginateAll bool
+		list        func(h *Handler) ([]*corev1.ConfigMap, error)
+		wantItems   int
+	}{
+		{"List caps nothing", false, (*Handler).List, 3},
+		{"ListAll caps nothing", false, (*Handler).ListAll, 3},
+		{"ListByLabel capped by limit", false, func(h *Handler) ([]*corev1.ConfigMap, error) { return h.ListByLabel("") }, 1},
+		{"List paginate all", true, (*Handler).List, 3},
+		{"ListAll paginate all", true, (*Handler).ListAll, 3},
+		{"ListByLabel paginate all", true, func(h *Handler) ([]*corev1.ConfigMap, error) { return h.ListByLabel("") }, 3},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			srv := httptest.NewServer(http.HandlerFunc(serve))
+			defer srv.Close()
+			clientset, err := kubernetes.NewForConfig(&rest.Config{Host: srv.URL})
+			if err != nil {
+				t.Fatal(err)
+			}
+			h := &Handler{ctx: context.Background(), namespace: "test", clientset: clientset, Options: &types.HandlerOptions{}}
+			h.SetLimit(1)
+			h.SetPaginateAll(test.paginateAll)
+			objs, err := test.list(h)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(objs) != test.wantItems {
+				t.Errorf("got %d configmaps, want %d", len(objs), test.wantItems)
+			}
+		})
+	}
+}
+
+func TestPaginateExpired(t *testing.T) {
+	// the continue token of the first page always expires.
+	var calls int
+	fakeList := func(listOptions metav1.ListOptions) (*corev1.ConfigMapList, error) {
+		calls++
+		if len(listOptions.Continue) != 0 {
+			return nil, k8serrors.NewResourceExpired("the continue token is expired")
+		}
+		return &corev1.ConfigMapList{ListMeta: metav1.ListMeta{Continue: "page2"}, Items: []corev1.ConfigMap{{}}}, nil
+	}
+
+	objList, err := paginate(metav1.ListOptions{Limit: 1}, true, fakeList)
+	if !k8serrors.IsResourceExpired(err) {
+		t.Fatalf("paginate() = %v, %v, want Expired error", objList, err)
+	}
+	// the first listing and maxPaginateRestarts restarts, two calls each.
+	if want := 2 * (maxPaginateRestarts + 1); calls != want {
+		t.Errorf("got %d calls, want %d", calls, want)
+	}
+}
+func TestListSince(t *testing.T) {
+	newEvent := func(eventType, name, resourceVersion string) metav1.WatchEvent {
+		data, _ := json.Marshal(&corev1.ConfigMap{
+			TypeMeta:   metav1.TypeMeta{Kind: "ConfigMap", APIVersion: "v1"},
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "test", ResourceVersion: resourceVersion},
+		})
+		return metav1.WatchEvent{Type: eventType, Object: runtime.RawExtension{Raw: data}}
+	}
+	expired := metav1.WatchEvent{Type: "ERROR", Object: runtime.RawExtension{
+		Raw: []byte(`{"kind":"Status","apiVersion":"v1","status":"Failure","reason":"Expired","code":410}`),
+	}}
+
+	tests := []struct {
+		name        string
+		events      []metav1.WatchEvent
+		wantChanged []string
+		wantDeleted []string
+		wantRV      string
+		wantExpired bool
+	}{
+		{
+			name:        "modify",
+			events:      []metav1.WatchEvent{newEvent("MODIFIED", "nginx", "11"), newEvent("MODIFIED", "nginx", "12")},
+			wantChanged: []string{"nginx@12"},
+			wantRV:      "12",
+		},
+		{
+			name:        "delete",
+			events:      []metav1.WatchEvent{newEvent("MODIFIED", "nginx", "11"), newEvent("DELETED", "nginx", "12"), newEvent("ADDED", "redis", "13")},
+			wantChanged: []string{"redis@13"},
+			wantDeleted: []string{"test/nginx"},
+			wantRV:      "13",
+		},
+		{
+			name:        "delete then re-add",
+			events:      []metav1.WatchEvent{newEvent("ADDED", "nginx", "11"), newEvent("DELETED", "nginx", "12"), newEvent("ADDED", "nginx", "13")},
+			wantChanged: []string{"nginx@13"},
+			wantRV:      "13",
+		},
+		{
+			name:   "idle window",
+			wantRV: "10",
+		},
+		{
+			name:        "expired resourceVersion",
+			events:      []metav1.WatchEvent{expired},
+			wantExpired: true,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if r.URL.Path != "/api/v1/namespaces/test/configmaps" || r.URL.Query().Get("watch") != "true" {
+					t.Errorf("unexpected request %s %s", r.Method, r.URL)
+				}
+				if rv := r.URL.Query().Get("resourceVersion"); rv != "10" {
+					t.Errorf("resourceVersion = %q, want 10", rv)
+				}
+				w.Header().Set("Content-Type", "application/json")
+				for _, event := range test.events {
+					json.NewEncoder(w).Encode(event)
+				}
+				w.(http.Flusher).Flush()
+				<-r.Context().Done()
+			}))
+			defer srv.Close()
+			clientset, err := kubernetes.NewForConfig(&rest.Config{Host: srv.URL})
+			if err != nil {
+				t.Fatal(err)
+			}
+			h := &Handler{ctx: context.Background(), namespace: "test", clientset: clientset, Options: &types.HandlerOptions{}}
+
+			changed, deleted, rv, err := h.ListSince("10")
+			if test.wantExpired {
+				if !k8serrors.IsResourceExpired(err) {
+					t.Fatalf("got error %v, want Expired", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			var gotChanged []string
+			for _, cm := range changed {
+				gotChanged = append(gotChanged, cm.Name+"@"+cm.ResourceVersion)
+			}
+			if !reflect.DeepEqual(gotChanged, test.wantChanged) {
+				t.Errorf("changed = %v, want %v", gotChanged, test.wantChanged)
+			}
+			if !reflect.DeepEqual(deleted, test.wantDeleted) {
+				t.Errorf("deleted = %v, want %v", deleted, test.wantDeleted)
+			}
+			if rv != test.wantRV {
+				t.Errorf("resourceVersion = %s, want %s", rv, test.wantRV)
+			}
+		})
+	}
+}
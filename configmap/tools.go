@@ -24,6 +24,35 @@ func (h *Handler) GetData(object interface{}) (map[string]string, error) {
 	}
 }
 
+// GetBinaryData get configmap binary data.
+func (h *Handler) GetBinaryData(object interface{}) (map[string][]byte, error) {
+	switch val := object.(type) {
+	case string:
+		cm, err := h.Get(val)
+		if err != nil {
+			return nil, err
+		}
+		return cm.BinaryData, nil
+	case *corev1.ConfigMap:
+		return val.BinaryData, nil
+	case corev1.ConfigMap:
+		return val.BinaryData, nil
+	default:
+		return nil, ErrInvalidToolsType
+	}
+}
+
+// GetValue get the value of the key in the configmap data, the bool reports
+// whether the key exists.
+func (h *Handler) GetValue(name, key string) (string, bool, error) {
+	data, err := h.GetData(name)
+	if err != nil {
+		return "", false, err
+	}
+	value, ok := data[key]
+	return value, ok, nil
+}
+
 // NumData get the number of configmap data.
 func (h *Handler) NumData(object interface{}) (int, error) {
 	switch val := object.(type) {
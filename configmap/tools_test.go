@@ -0,0 +1,72 @@
+package configmap
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+
+	"github.com/forbearing/k8s/types"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/rest"
+)
+
+func TestGetData(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if r.URL.Path != "/api/v1/namespaces/test/configmaps/mycm" {
+			w.WriteHeader(http.StatusNotFound)
+			fmt.Fprintln(w, `{"kind":"Status","apiVersion":"v1","status":"Failure","reason":"NotFound","code":404}`)
+			return
+		}
+		fmt.Fprintln(w, `{"kind":"ConfigMap","apiVersion":"v1","metadata":{"name":"mycm"},"data":{"key1":"value1","empty":""},"binaryData":{"bin":"AAE="}}`)
+	}))
+	defer srv.Close()
+	clientset, err := kubernetes.NewForConfig(&rest.Config{Host: srv.URL})
+	if err != nil {
+		t.Fatal(err)
+	}
+	h := &Handler{ctx: context.Background(), namespace: "test", clientset: clientset, Options: &types.HandlerOptions{}}
+
+	data, err := h.GetData("mycm")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := map[string]string{"key1": "value1", "empty": ""}; !reflect.DeepEqual(data, want) {
+		t.Errorf("GetData() = %v, want %v", data, want)
+	}
+	binaryData, err := h.GetBinaryData("mycm")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := map[string][]byte{"bin": {0, 1}}; !reflect.DeepEqual(binaryData, want) {
+		t.Errorf("GetBinaryData() = %v, want %v", binaryData, want)
+	}
+
+	tests := []struct {
+		name, key string
+		wantValue string
+		wantOK    bool
+		wantErr   func(error) bool
+	}{
+		{"mycm", "key1", "value1", true, nil},
+		{"mycm", "empty", "", true, nil},
+		{"mycm", "absent", "", false, nil},
+		{"notfound", "key1", "", false, k8serrors.IsNotFound},
+	}
+	for _, test := range tests {
+		value, ok, err := h.GetValue(test.name, test.key)
+		if test.wantErr != nil && !test.wantErr(err) || test.wantErr == nil && err != nil {
+			t.Errorf("GetValue(%s, %s) error = %v", test.name, test.key, err)
+		}
+		if value != test.wantValue || ok != test.wantOK {
+			t.Errorf("GetValue(%s, %s) = %q, %v, want %q, %v", test.name, test.key, value, ok, test.wantValue, test.wantOK)
+		}
+	}
+	if _, err := h.GetData("notfound"); !k8serrors.IsNotFound(err) {
+		t.Errorf("GetData(notfound) = %v, want NotFound", err)
+	}
+}
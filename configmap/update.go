@@ -3,6 +3,7 @@ package configmap
 import (
 	"encoding/json"
 	"fmt"
+	"io"
 	"io/ioutil"
 
 	corev1 "k8s.io/api/core/v1"
@@ -14,7 +15,7 @@ import (
 
 // Update updates configmap from type string, []byte, *corev1.ConfigMap,
 // corev1.ConfigMap, metav1.Object, runtime.Object, *unstructured.Unstructured,
-// unstructured.Unstructured or map[string]interface{}.
+// unstructured.Unstructured, map[string]interface{} or io.Reader.
 func (h *Handler) Update(obj interface{}) (*corev1.ConfigMap, error) {
 	switch val := obj.(type) {
 	case string:
@@ -31,6 +32,8 @@ func (h *Handler) Update(obj interface{}) (*corev1.ConfigMap, error) {
 		return h.UpdateFromUnstructured(&val)
 	case map[string]interface{}:
 		return h.UpdateFromMap(val)
+	case io.Reader:
+		return h.UpdateFromReader(val)
 	case metav1.Object, runtime.Object:
 		return h.UpdateFromObject(val)
 	default:
@@ -61,11 +64,20 @@ func (h *Handler) UpdateFromBytes(data []byte) (*corev1.ConfigMap, error) {
 	return h.updateConfigmap(cm)
 }
 
+// UpdateFromReader updates configmap from io.Reader, the reader is read until EOF.
+func (h *Handler) UpdateFromReader(r io.Reader) (*corev1.ConfigMap, error) {
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return nil, fmt.Errorf("read configmap data: %w", err)
+	}
+	return h.UpdateFromBytes(data)
+}
+
 // UpdateFromObject updates configmap from metav1.Object or runtime.Object.
 func (h *Handler) UpdateFromObject(obj interface{}) (*corev1.ConfigMap, error) {
 	cm, ok := obj.(*corev1.ConfigMap)
 	if !ok {
-		return nil, fmt.Errorf("object type is not *corev1.ConfigMap")
+		return nil, ErrInvalidObjectType
 	}
 	return h.updateConfigmap(cm)
 }
@@ -1,8 +1,6 @@
 package cronjob
 
 import (
-	"fmt"
-
 	batchv1 "k8s.io/api/batch/v1"
 	k8serrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -58,7 +56,7 @@ func (h *Handler) ApplyFromBytes(data []byte) (cj *batchv1.CronJob, err error) {
 func (h *Handler) ApplyFromObject(obj interface{}) (*batchv1.CronJob, error) {
 	cj, ok := obj.(*batchv1.CronJob)
 	if !ok {
-		return nil, fmt.Errorf("object type is not *batchv1.CronJob")
+		return nil, ErrInvalidObjectType
 	}
 	return h.applyCronjob(cj)
 }
@@ -9,6 +9,7 @@ import (
 
 	"github.com/forbearing/k8s/types"
 	"github.com/forbearing/k8s/util/client"
+	utildiscovery "github.com/forbearing/k8s/util/discovery"
 	batchv1 "k8s.io/api/batch/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime/schema"
@@ -39,6 +40,7 @@ type Handler struct {
 	informerFactory  informers.SharedInformerFactory
 
 	Options *types.HandlerOptions
+	logger  types.Logger
 
 	l sync.RWMutex
 }
@@ -50,8 +52,8 @@ type Handler struct {
 // * KUBECONFIG environment variable pointing at a file.
 // * $HOME/.kube/config if exists.
 // * In-cluster config if running in cluster.
-func NewOrDie(ctx context.Context, kubeconfig, namespace string) *Handler {
-	handler, err := New(ctx, kubeconfig, namespace)
+func NewOrDie(ctx context.Context, kubeconfig, namespace string, opts ...Option) *Handler {
+	handler, err := New(ctx, kubeconfig, namespace, opts...)
 	if err != nil {
 		panic(err)
 	}
@@ -64,7 +66,9 @@ func NewOrDie(ctx context.Context, kubeconfig, namespace string) *Handler {
 // * KUBECONFIG environment variable pointing at a file.
 // * $HOME/.kube/config if exists.
 // * In-cluster config if running in cluster.
-func New(ctx context.Context, kubeconfig, namespace string) (*Handler, error) {
+//
+// The opts, such as WithQPS and WithRateLimiter, customize the clients of the handler.
+func New(ctx context.Context, kubeconfig, namespace string, opts ...Option) (*Handler, error) {
 	var (
 		err             error
 		config          *rest.Config
@@ -74,6 +78,7 @@ func New(ctx context.Context, kubeconfig, namespace string) (*Handler, error) {
 		dynamicClient   dynamic.Interface
 		discoveryClient *discovery.DiscoveryClient
 		informerFactory informers.SharedInformerFactory
+		o               = newOptions(opts...)
 	)
 
 	// create rest config, and config precedence.
@@ -88,6 +93,7 @@ func New(ctx context.Context, kubeconfig, namespace string) (*Handler, error) {
 	config.APIPath = "api"
 	config.GroupVersion = &batchv1.SchemeGroupVersion
 	config.NegotiatedSerializer = scheme.Codecs
+	o.applyRateLimit(config)
 
 	// create a http client for the given config.
 	if httpClient, err = rest.HTTPClientFor(config); err != nil {
@@ -157,6 +163,10 @@ func (in *Handler) DeepCopy() *Handler {
 	if in == nil {
 		return nil
 	}
+	// hold the read lock, so the Set* methods can't modify the handler while copying it.
+	in.l.RLock()
+	defer in.l.RUnlock()
+
 	handler := &Handler{
 		ctx:              in.ctx,
 		kubeconfig:       in.kubeconfig,
@@ -171,6 +181,7 @@ func (in *Handler) DeepCopy() *Handler {
 		resyncPeriod:     in.resyncPeriod,
 		informerScope:    in.informerScope,
 		tweakListOptions: in.tweakListOptions,
+		logger:           in.logger,
 		Options: &types.HandlerOptions{
 			CreateOptions: *in.Options.CreateOptions.DeepCopy(),
 			UpdateOptions: *in.Options.UpdateOptions.DeepCopy(),
@@ -202,7 +213,7 @@ func (h *Handler) SetLimit(limit int64) {
 }
 func (h *Handler) SetForceDelete(force bool) {
 	h.l.Lock()
-	defer h.l.Lock()
+	defer h.l.Unlock()
 	if force {
 		h.Options.DeleteOptions.GracePeriodSeconds = new(int64)
 	}
@@ -229,6 +240,24 @@ func (h *Handler) SetPropagationPolicy(policy string) {
 	}
 }
 
+// SetLogger sets the logger reporting the watch and informer events,
+// types.DiscardLogger silences them. The logrus standard logger is used by default.
+func (h *Handler) SetLogger(logger types.Logger) {
+	h.l.Lock()
+	defer h.l.Unlock()
+	h.logger = logger
+}
+
+// log returns the logger set by SetLogger, or the default logger.
+func (h *Handler) log() types.Logger {
+	h.l.RLock()
+	defer h.l.RUnlock()
+	if h.logger == nil {
+		return types.DefaultLogger()
+	}
+	return h.logger
+}
+
 // RESTConfig returns underlying rest config.
 func (h *Handler) RESTConfig() *rest.Config {
 	return h.config
@@ -254,6 +283,12 @@ func (h *Handler) DiscoveryClient() *discovery.DiscoveryClient {
 	return h.discoveryClient
 }
 
+// APIVerbs returns the verbs supported by the kubernetes apiserver for cronjob,
+// such as "get", "list", "watch", "create", "update", "patch" and "delete".
+func (h *Handler) APIVerbs() ([]string, error) {
+	return utildiscovery.APIVerbs(h.discoveryClient, GVR)
+}
+
 // GVK contains the Group, Version, Kind name of cronjob.
 var GVK = schema.GroupVersionKind{
 	Group:   batchv1.SchemeGroupVersion.Group,
@@ -2,7 +2,6 @@ package cronjob
 
 import (
 	"encoding/json"
-	"fmt"
 	"io/ioutil"
 
 	batchv1 "k8s.io/api/batch/v1"
@@ -73,7 +72,7 @@ func (h *Handler) DeleteFromBytes(data []byte) error {
 func (h *Handler) DeleteFromObject(obj interface{}) error {
 	cj, ok := obj.(*batchv1.CronJob)
 	if !ok {
-		return fmt.Errorf("object type is not *batchv1.CronJob")
+		return ErrInvalidObjectType
 	}
 	return h.deleteCronjob(cj)
 }
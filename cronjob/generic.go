@@ -0,0 +1,13 @@
+package cronjob
+
+import (
+	"github.com/forbearing/k8s/types"
+	batchv1 "k8s.io/api/batch/v1"
+)
+
+// Generic returns the handler as a types.Handler, whose methods return
+// runtime.Object instead of *batchv1.CronJob, so it can be used together with
+// the handlers of other resources.
+func (h *Handler) Generic() types.Handler {
+	return types.NewGeneric[*batchv1.CronJob](h, GVK)
+}
@@ -2,7 +2,6 @@ package cronjob
 
 import (
 	"encoding/json"
-	"fmt"
 	"io/ioutil"
 
 	batchv1 "k8s.io/api/batch/v1"
@@ -73,7 +72,7 @@ func (h *Handler) GetFromBytes(data []byte) (*batchv1.CronJob, error) {
 func (h *Handler) GetFromObject(obj interface{}) (*batchv1.CronJob, error) {
 	cj, ok := obj.(*batchv1.CronJob)
 	if !ok {
-		return nil, fmt.Errorf("object type is not *batchv1.CronJob")
+		return nil, ErrInvalidObjectType
 	}
 	return h.getCronjob(cj)
 }
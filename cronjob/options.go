@@ -0,0 +1,57 @@
+package cronjob
+
+import (
+	"k8s.io/client-go/rest"
+	"k8s.io/client-go/util/flowcontrol"
+)
+
+// Option configures the cronjob handler created by New.
+type Option func(*options)
+
+// options is the configuration of New, the options are applied before
+// building the clients.
+type options struct {
+	qps         float32
+	burst       int
+	rateLimiter flowcontrol.RateLimiter
+}
+
+// newOptions returns the default options with the opts applied.
+func newOptions(opts ...Option) *options {
+	o := &options{}
+	for _, opt := range opts {
+		opt(o)
+	}
+	return o
+}
+
+// applyRateLimit sets the client-side rate limit of the config, the client-go
+// defaults are kept if neither WithQPS nor WithRateLimiter is set.
+func (o *options) applyRateLimit(config *rest.Config) {
+	if o.qps > 0 {
+		config.QPS = o.qps
+		config.Burst = o.burst
+	}
+	if o.rateLimiter != nil {
+		config.RateLimiter = o.rateLimiter
+	}
+}
+
+// WithQPS sets the maximum QPS and the maximum burst of the requests sent to
+// kubernetes API server, client-go defaults to 5 QPS and 10 burst.
+// It's ignored if WithRateLimiter is set.
+func WithQPS(qps float32, burst int) Option {
+	return func(o *options) {
+		o.qps = qps
+		o.burst = burst
+	}
+}
+
+// WithRateLimiter sets the rate limiter of the requests sent to kubernetes API
+// server, such as flowcontrol.NewTokenBucketRateLimiter, it takes precedence
+// over WithQPS.
+func WithRateLimiter(rateLimiter flowcontrol.RateLimiter) Option {
+	return func(o *options) {
+		o.rateLimiter = rateLimiter
+	}
+}
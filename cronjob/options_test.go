@@ -0,0 +1,47 @@
+package cronjob
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"k8s.io/client-go/util/flowcontrol"
+)
+
+func TestNewWithRateLimit(t *testing.T) {
+	kubeconfig := filepath.Join(t.TempDir(), "kubeconfig")
+	data := `apiVersion: v1
+kind: Config
+clusters:
+- name: test
+  cluster:
+    server: https://127.0.0.1:6443
+contexts:
+- name: test
+  context:
+    cluster: test
+current-context: test
+`
+	if err := os.WriteFile(kubeconfig, []byte(data), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	h, err := New(context.Background(), kubeconfig, "default", WithQPS(50, 100))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if h.config.QPS != 50 || h.config.Burst != 100 {
+		t.Errorf("rest.Config QPS = %v, Burst = %d, want 50 and 100", h.config.QPS, h.config.Burst)
+	}
+
+	// the rate limiter takes precedence over the QPS.
+	rateLimiter := flowcontrol.NewTokenBucketRateLimiter(20, 40)
+	h, err = New(context.Background(), kubeconfig, "default", WithQPS(50, 100), WithRateLimiter(rateLimiter))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if h.config.RateLimiter != rateLimiter {
+		t.Error("rest.Config doesn't carry the rate limiter")
+	}
+}
@@ -0,0 +1,43 @@
+package cronjob
+
+import (
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/types"
+)
+
+// Suspend suspends the cronjob, the cronjob controller doesn't create new
+// jobs for the suspended cronjob, the running jobs are not affected.
+// It's a no-op if the cronjob is already suspended.
+func (h *Handler) Suspend(name string) error {
+	return h.setSuspend(name, true)
+}
+
+// Resume resumes the suspended cronjob, the cronjob controller creates jobs
+// on its schedule again. It's a no-op if the cronjob is not suspended.
+func (h *Handler) Resume(name string) error {
+	return h.setSuspend(name, false)
+}
+
+// IsSuspended checks whether the cronjob is suspended, the cronjob without
+// spec.suspend is not suspended. It's the counterpart of Suspend and Resume
+// taking the cronjob name, IsSuspend also accepts the cronjob object.
+func (h *Handler) IsSuspended(name string) (bool, error) {
+	return h.IsSuspend(name)
+}
+
+// setSuspend strategic-merge-patches the cronjob .spec.suspend, if it's not
+// already set to suspend.
+func (h *Handler) setSuspend(name string, suspend bool) error {
+	suspended, err := h.IsSuspend(name)
+	if err != nil {
+		return err
+	}
+	if suspended == suspend {
+		return nil
+	}
+	patchData := fmt.Sprintf(`{"spec":{"suspend":%t}}`, suspend)
+	_, err = h.clientset.BatchV1().CronJobs(h.namespace).
+		Patch(h.ctx, name, types.StrategicMergePatchType, []byte(patchData), h.Options.PatchOptions)
+	return err
+}
@@ -0,0 +1,86 @@
+package cronjob
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+
+	"github.com/forbearing/k8s/types"
+	batchv1 "k8s.io/api/batch/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	k8stypes "k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/rest"
+)
+
+func TestSuspendResume(t *testing.T) {
+	var (
+		// suspend is nil until the cronjob is patched.
+		suspend *bool
+		patches []string
+	)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/apis/batch/v1/namespaces/test/cronjobs/backup" {
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+		if r.Method == http.MethodPatch {
+			if contentType := r.Header.Get("Content-Type"); contentType != string(k8stypes.StrategicMergePatchType) {
+				t.Errorf("Content-Type = %q, want strategic merge patch", contentType)
+			}
+			data, _ := io.ReadAll(r.Body)
+			patches = append(patches, string(data))
+			patch := &batchv1.CronJob{}
+			if err := json.Unmarshal(data, patch); err != nil {
+				t.Error(err)
+			}
+			suspend = patch.Spec.Suspend
+		}
+		cj := &batchv1.CronJob{
+			TypeMeta:   metav1.TypeMeta{Kind: "CronJob", APIVersion: "batch/v1"},
+			ObjectMeta: metav1.ObjectMeta{Name: "backup", Namespace: "test"},
+			Spec:       batchv1.CronJobSpec{Schedule: "0 * * * *", Suspend: suspend},
+		}
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(cj)
+	}))
+	defer srv.Close()
+	clientset, err := kubernetes.NewForConfig(&rest.Config{Host: srv.URL})
+	if err != nil {
+		t.Fatal(err)
+	}
+	h := &Handler{ctx: context.Background(), namespace: "test", clientset: clientset, Options: &types.HandlerOptions{}}
+
+	steps := []struct {
+		name   string
+		toggle func(name string) error
+		want   bool
+	}{
+		{"resume a running cronjob", h.Resume, false},
+		{"suspend", h.Suspend, true},
+		{"suspend again", h.Suspend, true},
+		{"resume", h.Resume, false},
+		{"resume again", h.Resume, false},
+	}
+	for _, step := range steps {
+		if err := step.toggle("backup"); err != nil {
+			t.Fatalf("%s: %v", step.name, err)
+		}
+		suspended, err := h.IsSuspended("backup")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if suspended != step.want {
+			t.Errorf("%s: IsSuspended() = %v, want %v", step.name, suspended, step.want)
+		}
+		if suspended, err = h.IsSuspend("backup"); err != nil || suspended != step.want {
+			t.Errorf("%s: IsSuspend() = %v, %v, want %v", step.name, suspended, err, step.want)
+		}
+	}
+	if want := []string{`{"spec":{"suspend":true}}`, `{"spec":{"suspend":false}}`}; !reflect.DeepEqual(patches, want) {
+		t.Errorf("patches = %q, want %q", patches, want)
+	}
+}
@@ -134,7 +134,10 @@ func (h *Handler) GetSchedule(object interface{}) (string, error) {
 	}
 }
 
-// IsSuspend check whether the controller will suspend subsequent executions.
+// IsSuspend check whether the controller will suspend subsequent executions,
+// that is the cronjob is suspended by Suspend and not resumed by Resume.
+// The object is the cronjob name or the cronjob, the cronjob without
+// spec.suspend is not suspended.
 func (h *Handler) IsSuspend(object interface{}) (bool, error) {
 	// TODO: 当 suspend 没有设置时,是设置成默认值还是返回 "not set" 类型的错误
 	switch val := object.(type) {
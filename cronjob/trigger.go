@@ -0,0 +1,54 @@
+package cronjob
+
+import (
+	"fmt"
+	"time"
+
+	batchv1 "k8s.io/api/batch/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// instantiateAnnotation is the annotation stamped on the job created from
+// a cronjob manually by `kubectl create job --from=cronjob/name`.
+const instantiateAnnotation = "cronjob.kubernetes.io/instantiate"
+
+// Trigger creates a job from the cronjob immediately, regardless of its
+// schedule and whether it's suspended, it works like
+// `kubectl create job jobName --from=cronjob/cronjobName`.
+//
+// The job is created from the cronjob spec.jobTemplate, owned by the cronjob,
+// and annotated with "cronjob.kubernetes.io/instantiate=manual". If jobName
+// is empty, it defaults to "<cronjobName>-<unix timestamp>".
+func (h *Handler) Trigger(cronjobName, jobName string) (*batchv1.Job, error) {
+	cj, err := h.Get(cronjobName)
+	if err != nil {
+		return nil, err
+	}
+	if len(jobName) == 0 {
+		jobName = fmt.Sprintf("%s-%d", cronjobName, time.Now().Unix())
+	}
+	return h.clientset.BatchV1().Jobs(cj.Namespace).Create(h.ctx, newJobFromCronJob(cj, jobName), h.Options.CreateOptions)
+}
+
+// newJobFromCronJob creates a job named name from the cronjob job template.
+func newJobFromCronJob(cj *batchv1.CronJob, name string) *batchv1.Job {
+	annotations := map[string]string{instantiateAnnotation: "manual"}
+	for key, value := range cj.Spec.JobTemplate.Annotations {
+		annotations[key] = value
+	}
+	labels := make(map[string]string, len(cj.Spec.JobTemplate.Labels))
+	for key, value := range cj.Spec.JobTemplate.Labels {
+		labels[key] = value
+	}
+	return &batchv1.Job{
+		TypeMeta: metav1.TypeMeta{APIVersion: batchv1.SchemeGroupVersion.String(), Kind: "Job"},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:            name,
+			Namespace:       cj.Namespace,
+			Annotations:     annotations,
+			Labels:          labels,
+			OwnerReferences: []metav1.OwnerReference{*metav1.NewControllerRef(cj, GVK)},
+		},
+		Spec: *cj.Spec.JobTemplate.Spec.DeepCopy(),
+	}
+}
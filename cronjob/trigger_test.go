@@ -0,0 +1,84 @@
+package cronjob
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/forbearing/k8s/types"
+	batchv1 "k8s.io/api/batch/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/rest"
+)
+
+const testCronJob = `{"kind":"CronJob","apiVersion":"batch/v1","metadata":{"name":"backup","namespace":"test","uid":"cj-1"},
+"spec":{"schedule":"0 * * * *","suspend":true,"jobTemplate":{"metadata":{"labels":{"app":"backup"},"annotations":{"team":"ops"}},
+"spec":{"backoffLimit":2,"template":{"spec":{"restartPolicy":"Never","containers":[{"name":"backup","image":"busybox"}]}}}}}}`
+
+func TestTrigger(t *testing.T) {
+	tests := []struct {
+		name    string
+		jobName string
+	}{
+		{"named", "backup-manual"},
+		{"default name", ""},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var created *batchv1.Job
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.Header().Set("Content-Type", "application/json")
+				switch {
+				case r.Method == http.MethodGet && r.URL.Path == "/apis/batch/v1/namespaces/test/cronjobs/backup":
+					fmt.Fprintln(w, testCronJob)
+				case r.Method == http.MethodPost && r.URL.Path == "/apis/batch/v1/namespaces/test/jobs":
+					created = &batchv1.Job{}
+					if err := json.NewDecoder(r.Body).Decode(created); err != nil {
+						t.Error(err)
+					}
+					w.WriteHeader(http.StatusCreated)
+					json.NewEncoder(w).Encode(created)
+				default:
+					t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+				}
+			}))
+			defer srv.Close()
+			clientset, err := kubernetes.NewForConfig(&rest.Config{Host: srv.URL})
+			if err != nil {
+				t.Fatal(err)
+			}
+			h := &Handler{ctx: context.Background(), namespace: "test", clientset: clientset, Options: &types.HandlerOptions{}}
+
+			job, err := h.Trigger("backup", test.jobName)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if test.jobName != "" && job.Name != test.jobName {
+				t.Errorf("job name = %q, want %q", job.Name, test.jobName)
+			}
+			if test.jobName == "" && !strings.HasPrefix(job.Name, "backup-") {
+				t.Errorf("job name = %q, want backup-<timestamp>", job.Name)
+			}
+
+			cj := &batchv1.CronJob{}
+			json.Unmarshal([]byte(testCronJob), cj)
+			if !reflect.DeepEqual(created.Spec, cj.Spec.JobTemplate.Spec) {
+				t.Errorf("job spec = %+v, want %+v", created.Spec, cj.Spec.JobTemplate.Spec)
+			}
+			if created.Labels["app"] != "backup" || created.Annotations["team"] != "ops" ||
+				created.Annotations[instantiateAnnotation] != "manual" {
+				t.Errorf("job labels %v, annotations %v", created.Labels, created.Annotations)
+			}
+			ref := metav1.GetControllerOf(created)
+			if ref == nil || ref.Kind != "CronJob" || ref.APIVersion != "batch/v1" || ref.Name != "backup" || ref.UID != "cj-1" {
+				t.Errorf("job controller = %+v, want cronjob backup", ref)
+			}
+		})
+	}
+}
@@ -10,4 +10,5 @@ var (
 	ErrInvalidDeleteType = ErrInvalidCreateType
 	ErrInvalidGetType    = ErrInvalidCreateType
 	ErrInvalidPatchType  = errors.New("patch data type must be string, []byte, *batchv1.CronJob, batchv1.CronJob, metav1.Object, runtime.Object, *unstructured.Unstructured, unstructured.Unstructured or map[string]interface{}")
+	ErrInvalidObjectType = errors.New("object type is not *batchv1.CronJob")
 )
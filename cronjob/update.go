@@ -2,7 +2,6 @@ package cronjob
 
 import (
 	"encoding/json"
-	"fmt"
 	"io/ioutil"
 
 	batchv1 "k8s.io/api/batch/v1"
@@ -65,7 +64,7 @@ func (h *Handler) UpdateFromBytes(data []byte) (*batchv1.CronJob, error) {
 func (h *Handler) UpdateFromObject(obj interface{}) (*batchv1.CronJob, error) {
 	cj, ok := obj.(*batchv1.CronJob)
 	if !ok {
-		return nil, fmt.Errorf("object type is not *batchv1.CronJob")
+		return nil, ErrInvalidObjectType
 	}
 	return h.updateCronjob(cj)
 }
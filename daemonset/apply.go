@@ -1,8 +1,6 @@
 package daemonset
 
 import (
-	"fmt"
-
 	appsv1 "k8s.io/api/apps/v1"
 	k8serrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -58,7 +56,7 @@ func (h *Handler) ApplyFromBytes(data []byte) (ds *appsv1.DaemonSet, err error)
 func (h *Handler) ApplyFromObject(obj interface{}) (*appsv1.DaemonSet, error) {
 	ds, ok := obj.(*appsv1.DaemonSet)
 	if !ok {
-		return nil, fmt.Errorf("object type is not *appsv1.DaemonSet")
+		return nil, ErrInvalidObjectType
 	}
 	return h.applyDaemonset(ds)
 }
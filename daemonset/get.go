@@ -2,7 +2,6 @@ package daemonset
 
 import (
 	"encoding/json"
-	"fmt"
 	"io/ioutil"
 
 	appsv1 "k8s.io/api/apps/v1"
@@ -73,7 +72,7 @@ func (h *Handler) GetFromBytes(data []byte) (*appsv1.DaemonSet, error) {
 func (h *Handler) GetFromObject(obj interface{}) (*appsv1.DaemonSet, error) {
 	ds, ok := obj.(*appsv1.DaemonSet)
 	if !ok {
-		return nil, fmt.Errorf("object type is not *appsv1.DaemonSet")
+		return nil, ErrInvalidObjectType
 	}
 	return h.getDaemonset(ds)
 }
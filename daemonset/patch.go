@@ -2,9 +2,9 @@ package daemonset
 
 import (
 	"encoding/json"
-	"errors"
 	"os"
 
+	jsonpatch "github.com/evanphx/json-patch"
 	appsv1 "k8s.io/api/apps/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
@@ -87,7 +87,7 @@ func (h *Handler) Patch(original *appsv1.DaemonSet, patch interface{}, patchOpti
 	case metav1.Object, runtime.Object:
 		modified, ok := patch.(*appsv1.DaemonSet)
 		if !ok {
-			return nil, errors.New("patch data type is not *appsv1.DaemonSet")
+			return nil, ErrInvalidObjectType
 		}
 		return h.diffMergePatch(original, modified, patchOptions...)
 
@@ -178,7 +178,15 @@ func (h *Handler) diffMergePatch(original, modified *appsv1.DaemonSet, patchOpti
 	if modifiedJson, err = json.Marshal(modified); err != nil {
 		return nil, err
 	}
-	if patchData, err = strategicpatch.CreateTwoWayMergePatch(originalJson, modifiedJson, appsv1.DaemonSet{}); err != nil {
+	// the patch data of "JSON Merge Patch" must contain the entire new list,
+	// the patch data created by strategic merge patch only contains the
+	// changed list items, which would drop the other list items.
+	if len(patchOptions) != 0 && patchOptions[0] == types.MergePatchType {
+		patchData, err = jsonpatch.CreateMergePatch(originalJson, modifiedJson)
+	} else {
+		patchData, err = strategicpatch.CreateTwoWayMergePatch(originalJson, modifiedJson, appsv1.DaemonSet{})
+	}
+	if err != nil {
 		return nil, err
 	}
 	if len(patchData) == 0 || string(patchData) == "{}" {
@@ -0,0 +1,192 @@
+package daemonset
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	policyv1 "k8s.io/api/policy/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/intstr"
+)
+
+const (
+	// pollInterval is the interval between two checks of the daemonset status.
+	pollInterval = time.Second
+
+	// restartedAtAnnotation is the annotation stamped on the pod template by
+	// `kubectl rollout restart`.
+	restartedAtAnnotation = "kubectl.kubernetes.io/restartedAt"
+)
+
+// RollingRestartProgress describes the progress of RollingRestart.
+type RollingRestartProgress struct {
+	// NodeName is the node where the new daemon pod just became ready.
+	NodeName string
+	// PodName is the name of the new daemon pod.
+	PodName string
+	// Restarted is the number of nodes whose daemon pod has been restarted.
+	Restarted int32
+	// Desired is the number of nodes that should be running the daemon pod.
+	Desired int32
+}
+
+// RollingRestartOptions is the options for RollingRestart.
+type RollingRestartOptions struct {
+	// Wait waits for the new daemon pod on every node to be ready before
+	// RollingRestart returns. If Wait is false, RollingRestart only stamps
+	// the restartedAt annotation, just like RolloutRestart.
+	Wait bool
+	// Timeout is the max duration to wait for the restart to finish.
+	// Zero means waiting until the handler context is done.
+	Timeout time.Duration
+	// Progress will be called every time the new daemon pod on a node becomes ready.
+	Progress func(RollingRestartProgress)
+}
+
+// RolloutRestart triggers a rolling restart of the daemonset, it works like
+// `kubectl rollout restart daemonset/name`, it patches the pod template
+// annotation "kubectl.kubernetes.io/restartedAt" with current time.
+func (h *Handler) RolloutRestart(name string) error {
+	_, err := h.rolloutRestart(name)
+	return err
+}
+
+// RollingRestart triggers a rolling restart of the daemonset and optionally
+// waits node by node for the new daemon pod to be ready.
+//
+// If the daemonset updateStrategy is RollingUpdate, the daemonset controller
+// replaces the daemon pods and respects the maxUnavailable itself.
+// If the daemonset updateStrategy is OnDelete, the daemonset controller never
+// replaces the pods, so RollingRestart evicts the old daemon pods, at most
+// maxUnavailable(default to 1) nodes at a time, and waits for the new daemon
+// pod on these nodes to be ready before proceeding to the next nodes.
+func (h *Handler) RollingRestart(name string, opts RollingRestartOptions) error {
+	restartedAt, err := h.rolloutRestart(name)
+	if err != nil {
+		return err
+	}
+	if !opts.Wait {
+		return nil
+	}
+
+	ctx := h.ctx
+	if opts.Timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(h.ctx, opts.Timeout)
+		defer cancel()
+	}
+
+	restarted := make(map[string]bool)
+	for {
+		ds, err := h.clientset.AppsV1().DaemonSets(h.namespace).Get(ctx, name, h.Options.GetOptions)
+		if err != nil {
+			return err
+		}
+		pods, err := h.getPods(ds)
+		if err != nil {
+			return err
+		}
+		var oldPods []*corev1.Pod
+		for _, pod := range pods {
+			if pod.Annotations[restartedAtAnnotation] != restartedAt {
+				oldPods = append(oldPods, pod)
+				continue
+			}
+			if restarted[pod.Spec.NodeName] || !isPodReady(pod) {
+				continue
+			}
+			restarted[pod.Spec.NodeName] = true
+			if opts.Progress != nil {
+				opts.Progress(RollingRestartProgress{
+					NodeName:  pod.Spec.NodeName,
+					PodName:   pod.Name,
+					Restarted: int32(len(restarted)),
+					Desired:   ds.Status.DesiredNumberScheduled,
+				})
+			}
+		}
+		if ds.Status.ObservedGeneration >= ds.Generation &&
+			int32(len(restarted)) >= ds.Status.DesiredNumberScheduled && len(oldPods) == 0 {
+			return nil
+		}
+
+		if ds.Spec.UpdateStrategy.Type == appsv1.OnDeleteDaemonSetStrategyType {
+			if err := h.evictOldPods(ctx, ds, oldPods, len(pods)-len(restarted)-len(oldPods)); err != nil {
+				return err
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			return fmt.Errorf("daemonset/%s restarted %d/%d nodes: %w",
+				name, len(restarted), ds.Status.DesiredNumberScheduled, ctx.Err())
+		case <-time.After(pollInterval):
+		}
+	}
+}
+
+// evictOldPods evicts old daemon pods, and keeps the number of nodes that
+// are restarting no more than maxUnavailable.
+func (h *Handler) evictOldPods(ctx context.Context, ds *appsv1.DaemonSet, oldPods []*corev1.Pod, restarting int) error {
+	maxUnavailable := intstr.FromInt(1)
+	if ds.Spec.UpdateStrategy.RollingUpdate != nil && ds.Spec.UpdateStrategy.RollingUpdate.MaxUnavailable != nil {
+		maxUnavailable = *ds.Spec.UpdateStrategy.RollingUpdate.MaxUnavailable
+	}
+	max, err := intstr.GetScaledValueFromIntOrPercent(&maxUnavailable, int(ds.Status.DesiredNumberScheduled), true)
+	if err != nil {
+		return err
+	}
+	if max < 1 {
+		max = 1
+	}
+	for _, pod := range oldPods {
+		// the old pod is already terminating.
+		if pod.DeletionTimestamp != nil {
+			restarting++
+			continue
+		}
+		if restarting >= max {
+			break
+		}
+		eviction := &policyv1.Eviction{
+			ObjectMeta:    metav1.ObjectMeta{Name: pod.Name, Namespace: pod.Namespace},
+			DeleteOptions: h.Options.DeleteOptions.DeepCopy(),
+		}
+		err := h.clientset.CoreV1().Pods(pod.Namespace).EvictV1(ctx, eviction)
+		// the eviction is blocked by PodDisruptionBudget, retry it next time.
+		if k8serrors.IsTooManyRequests(err) || k8serrors.IsNotFound(err) {
+			continue
+		}
+		if err != nil {
+			return err
+		}
+		restarting++
+	}
+	return nil
+}
+
+// rolloutRestart stamps the restartedAt annotation on the daemonset pod template
+// and returns the annotation value.
+func (h *Handler) rolloutRestart(name string) (string, error) {
+	restartedAt := time.Now().Format(time.RFC3339Nano)
+	patchData := fmt.Sprintf(`{"spec":{"template":{"metadata":{"annotations":{"%s":"%s"}}}}}`,
+		restartedAtAnnotation, restartedAt)
+	_, err := h.clientset.AppsV1().DaemonSets(h.namespace).
+		Patch(h.ctx, name, types.StrategicMergePatchType, []byte(patchData), h.Options.PatchOptions)
+	return restartedAt, err
+}
+
+// isPodReady returns true if the pod condition "Ready" is true.
+func isPodReady(pod *corev1.Pod) bool {
+	for _, cond := range pod.Status.Conditions {
+		if cond.Type == corev1.PodReady {
+			return cond.Status == corev1.ConditionTrue
+		}
+	}
+	return false
+}
@@ -0,0 +1,182 @@
+package daemonset
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/forbearing/k8s/types"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/rest"
+)
+
+// newTestHandler creates a daemonset handler in namespace "test", whose
+// clientset sends the requests to the fake kubernetes API server serve.
+func newTestHandler(t *testing.T, serve http.HandlerFunc) *Handler {
+	srv := httptest.NewServer(serve)
+	t.Cleanup(srv.Close)
+	clientset, err := kubernetes.NewForConfig(&rest.Config{Host: srv.URL})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return &Handler{ctx: context.Background(), namespace: "test", clientset: clientset, Options: &types.HandlerOptions{}}
+}
+
+// daemonPod returns the pod of daemonset "fluentd" on the node, the pod
+// template annotation restartedAt is propagated to the pod.
+func daemonPod(name, node, restartedAt string) corev1.Pod {
+	return corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:            name,
+			Namespace:       "test",
+			Annotations:     map[string]string{restartedAtAnnotation: restartedAt},
+			OwnerReferences: []metav1.OwnerReference{{APIVersion: "apps/v1", Kind: "DaemonSet", Name: "fluentd", Controller: new(bool)}},
+		},
+		Spec: corev1.PodSpec{NodeName: node},
+		Status: corev1.PodStatus{Conditions: []corev1.PodCondition{
+			{Type: corev1.PodReady, Status: corev1.ConditionTrue},
+		}},
+	}
+}
+
+func TestRollingRestart(t *testing.T) {
+	var (
+		mu          sync.Mutex
+		restartedAt string
+		evicted     []string
+	)
+	nodes := []string{"node1", "node2"}
+	h := newTestHandler(t, func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case r.Method == http.MethodPatch && r.URL.Path == "/apis/apps/v1/namespaces/test/daemonsets/fluentd":
+			if contentType := r.Header.Get("Content-Type"); contentType != "application/strategic-merge-patch+json" {
+				t.Errorf("Content-Type = %q, want strategic merge patch", contentType)
+			}
+			data, _ := io.ReadAll(r.Body)
+			ds := &appsv1.DaemonSet{}
+			if err := json.Unmarshal(data, ds); err != nil {
+				t.Error(err)
+			}
+			restartedAt = ds.Spec.Template.Annotations[restartedAtAnnotation]
+			if _, err := time.Parse(time.RFC3339Nano, restartedAt); err != nil {
+				t.Errorf("restartedAt annotation %q is not a timestamp: %v", restartedAt, err)
+			}
+			fmt.Fprintln(w, `{"kind":"DaemonSet","apiVersion":"apps/v1","metadata":{"name":"fluentd","namespace":"test"}}`)
+		case r.Method == http.MethodGet && r.URL.Path == "/apis/apps/v1/namespaces/test/daemonsets/fluentd":
+			json.NewEncoder(w).Encode(&appsv1.DaemonSet{
+				TypeMeta:   metav1.TypeMeta{Kind: "DaemonSet", APIVersion: "apps/v1"},
+				ObjectMeta: metav1.ObjectMeta{Name: "fluentd", Namespace: "test", Generation: 2},
+				Spec:       appsv1.DaemonSetSpec{UpdateStrategy: appsv1.DaemonSetUpdateStrategy{Type: appsv1.OnDeleteDaemonSetStrategyType}},
+				Status:     appsv1.DaemonSetStatus{ObservedGeneration: 2, DesiredNumberScheduled: int32(len(nodes))},
+			})
+		case r.Method == http.MethodGet && r.URL.Path == "/api/v1/namespaces/test/pods":
+			// the evicted old pod is replaced by a new pod at once.
+			podList := &corev1.PodList{TypeMeta: metav1.TypeMeta{Kind: "PodList", APIVersion: "v1"}}
+			for _, node := range nodes {
+				pod := daemonPod("fluentd-"+node+"-old", node, "2022-01-01T00:00:00Z")
+				for _, name := range evicted {
+					if name == pod.Name {
+						pod = daemonPod("fluentd-"+node+"-new", node, restartedAt)
+					}
+				}
+				podList.Items = append(podList.Items, pod)
+			}
+			// the pod of the other daemonset is never evicted.
+			other := daemonPod("other-node1", "node1", "")
+			other.OwnerReferences[0].Name = "other"
+			podList.Items = append(podList.Items, other)
+			json.NewEncoder(w).Encode(podList)
+		case r.Method == http.MethodPost && strings.HasSuffix(r.URL.Path, "/eviction"):
+			// /api/v1/namespaces/test/pods/{name}/eviction
+			name := strings.Split(r.URL.Path, "/")[6]
+			if !strings.HasSuffix(name, "-old") {
+				t.Errorf("pod %s of the new revision is evicted", name)
+			}
+			evicted = append(evicted, name)
+			w.WriteHeader(http.StatusCreated)
+			fmt.Fprintln(w, `{"kind":"Status","apiVersion":"v1","status":"Success"}`)
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+	})
+
+	var progress []RollingRestartProgress
+	err := h.RollingRestart("fluentd", RollingRestartOptions{
+		Wait:     true,
+		Timeout:  10 * time.Second,
+		Progress: func(p RollingRestartProgress) { progress = append(progress, p) },
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	mu.Lock()
+	defer mu.Unlock()
+	// maxUnavailable defaults to 1, the old pods are evicted one by one.
+	if want := []string{"fluentd-node1-old", "fluentd-node2-old"}; !reflect.DeepEqual(evicted, want) {
+		t.Errorf("evicted %v, want %v", evicted, want)
+	}
+	want := []RollingRestartProgress{
+		{NodeName: "node1", PodName: "fluentd-node1-new", Restarted: 1, Desired: 2},
+		{NodeName: "node2", PodName: "fluentd-node2-new", Restarted: 2, Desired: 2},
+	}
+	if !reflect.DeepEqual(progress, want) {
+		t.Errorf("progress = %+v, want %+v", progress, want)
+	}
+}
+
+func TestEvictOldPods(t *testing.T) {
+	var evicted []string
+	h := newTestHandler(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost || !strings.HasSuffix(r.URL.Path, "/eviction") {
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+		name := strings.Split(r.URL.Path, "/")[6]
+		evicted = append(evicted, name)
+		w.Header().Set("Content-Type", "application/json")
+		if name == "blocked" {
+			w.WriteHeader(http.StatusTooManyRequests)
+			fmt.Fprintln(w, `{"kind":"Status","apiVersion":"v1","status":"Failure","reason":"TooManyRequests","code":429}`)
+			return
+		}
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprintln(w, `{"kind":"Status","apiVersion":"v1","status":"Success"}`)
+	})
+
+	maxUnavailable := intstr.FromInt(2)
+	ds := &appsv1.DaemonSet{
+		Spec: appsv1.DaemonSetSpec{UpdateStrategy: appsv1.DaemonSetUpdateStrategy{
+			Type:          appsv1.OnDeleteDaemonSetStrategyType,
+			RollingUpdate: &appsv1.RollingUpdateDaemonSet{MaxUnavailable: &maxUnavailable},
+		}},
+		Status: appsv1.DaemonSetStatus{DesiredNumberScheduled: 5},
+	}
+	terminating := daemonPod("terminating", "node1", "")
+	terminating.DeletionTimestamp = &metav1.Time{Time: time.Now()}
+	blocked := daemonPod("blocked", "node2", "")
+	old3 := daemonPod("old3", "node3", "")
+	old4 := daemonPod("old4", "node4", "")
+
+	// the terminating pod takes one of the two unavailable nodes, the eviction
+	// blocked by PodDisruptionBudget doesn't.
+	if err := h.evictOldPods(context.Background(), ds, []*corev1.Pod{&terminating, &blocked, &old3, &old4}, 0); err != nil {
+		t.Fatal(err)
+	}
+	if want := []string{"blocked", "old3"}; !reflect.DeepEqual(evicted, want) {
+		t.Errorf("evicted %v, want %v", evicted, want)
+	}
+}
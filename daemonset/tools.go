@@ -8,7 +8,6 @@ import (
 	"syscall"
 	"time"
 
-	log "github.com/sirupsen/logrus"
 	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
 	k8serrors "k8s.io/apimachinery/pkg/api/errors"
@@ -88,7 +87,7 @@ func (h *Handler) WaitReady(name string) error {
 			if err == nil {
 				return
 			}
-			log.Error(err)
+			h.log().Error(err)
 			time.Sleep(time.Second * 10)
 		}
 	}(ctxCheck)
@@ -118,13 +117,13 @@ func (h *Handler) WaitReady(name string) error {
 					errCh <- fmt.Errorf("daemonset/%s was deleted", name)
 					return
 				case watch.Bookmark:
-					log.Debug("watch daemonset: bookmark")
+					h.log().Debug("watch daemonset: bookmark")
 				case watch.Error:
-					log.Debug("watch daemonset: error")
+					h.log().Debug("watch daemonset: error")
 				}
 			}
 			// If event channel is closed, it means the kube-apiserver has closed the connection.
-			log.Debug("watch daemonset: reconnect to kubernetes")
+			h.log().Debug("watch daemonset: reconnect to kubernetes")
 			watcher.Stop()
 		}
 	}(ctxWatch)
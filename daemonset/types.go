@@ -10,4 +10,5 @@ var (
 	ErrInvalidDeleteType = ErrInvalidCreateType
 	ErrInvalidGetType    = ErrInvalidCreateType
 	ErrInvalidPatchType  = errors.New("patch data type must be string, []byte, *appsv1.DaemonSet, appsv1.DaemonSet, metav1.Object, runtime.Object, *unstructured.Unstructured, unstructured.Unstructured or map[string]interface{}")
+	ErrInvalidObjectType = errors.New("object type is not *appsv1.DaemonSet")
 )
@@ -1,7 +1,6 @@
 package daemonset
 
 import (
-	log "github.com/sirupsen/logrus"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/fields"
 	"k8s.io/apimachinery/pkg/watch"
@@ -19,7 +18,7 @@ import (
 //  * If Event.Type is Error: *api.Status is recommended; other types may make sense
 //    depending on context.
 func (h *Handler) Watch(addFunc, modifyFunc, deleteFunc func(obj interface{})) error {
-	return h.WithNamespace(metav1.NamespaceAll).WatchByLabel("", addFunc, deleteFunc, modifyFunc)
+	return h.WithNamespace(metav1.NamespaceAll).WatchByLabel("", addFunc, modifyFunc, deleteFunc)
 }
 
 // WatchByNamespace watch all daemonset resources in the specified namespace.
@@ -37,7 +36,7 @@ func (h *Handler) WatchByNamespace(namespace string, addFunc, modifyFunc, delete
 	if len(namespace) == 0 {
 		namespace = metav1.NamespaceDefault
 	}
-	return h.WithNamespace(namespace).WatchByLabel("", addFunc, deleteFunc, modifyFunc)
+	return h.WithNamespace(namespace).WatchByLabel("", addFunc, modifyFunc, deleteFunc)
 }
 
 // WatchByName watch a single daemonset reseource.
@@ -118,13 +117,13 @@ func (h *Handler) watchDaemonSet(listOptions metav1.ListOptions,
 			case watch.Deleted:
 				deleteFunc(event.Object)
 			case watch.Bookmark:
-				log.Debug("watch daemonset: bookmark")
+				h.log().Debug("watch daemonset: bookmark")
 			case watch.Error:
-				log.Debug("watch daemonset: error")
+				h.log().Debug("watch daemonset: error")
 			}
 		}
 		// If event channel is closed, it means the server has closed the connection
-		log.Debug("watch daemonset: reconnect to kubernetes")
+		h.log().Debug("watch daemonset: reconnect to kubernetes")
 		watcher.Stop()
 	}
 }
@@ -6,7 +6,6 @@ import (
 	"io/ioutil"
 	"regexp"
 
-	utilerrors "github.com/forbearing/k8s/util/errors"
 	"github.com/sirupsen/logrus"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 )
@@ -39,16 +38,7 @@ func DeleteF(ctx context.Context, kubeconfig, filename string, namespace string,
 		// dynaimc hanler will create the k8s resource is the namespace specified in dynamic.New().
 		// (namespace defined in yaml file have higher precedence than specified in dynamic.New())
 		err = handler.Delete(item)
-		for _, opt := range opts {
-			switch opt {
-			case IgnoreAlreadyExists:
-				err = utilerrors.IgnoreAlreadyExists(err)
-			case IgnoreNotFound:
-				err = utilerrors.IgnoreNotFound(err)
-			case IgnoreInvalid:
-				err = utilerrors.IgnoreInvalid(err)
-			}
-		}
+		err = ignoreErrors(err, opts...)
 
 		// If the err returned by dynamic handler is "NotFound", just output the
 		// error message and continue process the next items.
@@ -5,9 +5,11 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"io"
 	"io/ioutil"
+	"net/http"
 
-	log "github.com/sirupsen/logrus"
+	"github.com/forbearing/k8s/util/remote"
 	appsv1 "k8s.io/api/apps/v1"
 	k8serrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/api/meta"
@@ -23,7 +25,7 @@ import (
 
 // Apply applies deployment from type string, []byte, *appsv1.Deployment,
 // appsv1.Deployment, metav1.Object, runtime.Object, *unstructured.Unstructured,
-// unstructured.Unstructured or map[string]interface{}.
+// unstructured.Unstructured, map[string]interface{} or io.Reader.
 func (h *Handler) Apply(obj interface{}) (*appsv1.Deployment, error) {
 	switch val := obj.(type) {
 	case string:
@@ -40,6 +42,8 @@ func (h *Handler) Apply(obj interface{}) (*appsv1.Deployment, error) {
 		return h.ApplyFromUnstructured(&val)
 	case map[string]interface{}:
 		return h.ApplyFromMap(val)
+	case io.Reader:
+		return h.ApplyFromReader(val)
 	case metav1.Object, runtime.Object:
 		return h.ApplyFromObject(val)
 	default:
@@ -65,11 +69,31 @@ func (h *Handler) ApplyFromBytes(data []byte) (deploy *appsv1.Deployment, err er
 	return
 }
 
+// ApplyFromURL applies deployment from the yaml or json manifest fetched from the
+// url, the optional http headers are sent with the request, eg: the
+// "Authorization" header carrying the auth token.
+func (h *Handler) ApplyFromURL(url string, header ...http.Header) (*appsv1.Deployment, error) {
+	data, err := remote.Fetch(h.ctx, url, remote.DefaultTimeout, header...)
+	if err != nil {
+		return nil, err
+	}
+	return h.ApplyFromBytes(data)
+}
+
+// ApplyFromReader applies deployment from io.Reader, the reader is read until EOF.
+func (h *Handler) ApplyFromReader(r io.Reader) (*appsv1.Deployment, error) {
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return nil, fmt.Errorf("read deployment data: %w", err)
+	}
+	return h.ApplyFromBytes(data)
+}
+
 // ApplyFromObject applies deployment from metav1.Object or runtime.Object.
 func (h *Handler) ApplyFromObject(obj interface{}) (*appsv1.Deployment, error) {
 	deploy, ok := obj.(*appsv1.Deployment)
 	if !ok {
-		return nil, fmt.Errorf("object type is not *appsv1.Deployment")
+		return nil, ErrInvalidObjectType
 	}
 	return h.applyDeployment(deploy)
 }
@@ -155,8 +179,8 @@ func (h *Handler) __Apply(filename string) (deploy *appsv1.Deployment, err error
 		// json serializer runtime.Serializer --> runtime.Object, *schema.GroupVersionKind
 		object, gvk, err := serializeryaml.NewDecodingSerializer(unstructured.UnstructuredJSONScheme).Decode(rawObject.Raw, nil, nil)
 		if err != nil {
-			log.Error("NewDecodingSerializer error")
-			log.Error(err)
+			h.log().Error("NewDecodingSerializer error")
+			h.log().Error(err)
 			return nil, err
 		}
 		// runtime.Object --> map[string]interface{}
@@ -172,8 +196,8 @@ func (h *Handler) __Apply(filename string) (deploy *appsv1.Deployment, err error
 		// DiscoveryInterface / DiscoveryClient --> []*APIGroupResources
 		apiGroupResources, err := restmapper.GetAPIGroupResources(h.clientset.Discovery())
 		if err != nil {
-			log.Error("GetAPIGroupResources error")
-			log.Error(err)
+			h.log().Error("GetAPIGroupResources error")
+			h.log().Error(err)
 			return nil, err
 		}
 
@@ -186,8 +210,8 @@ func (h *Handler) __Apply(filename string) (deploy *appsv1.Deployment, err error
 		// RESTMapping identifies a preferred resource mapping for the provided group kind.
 		restMapping, err := restMapper.RESTMapping(gvk.GroupKind(), gvk.Version)
 		if err != nil {
-			log.Error("RESTMapping error")
-			log.Error(err)
+			h.log().Error("RESTMapping error")
+			h.log().Error(err)
 			return nil, err
 		}
 
@@ -207,15 +231,15 @@ func (h *Handler) __Apply(filename string) (deploy *appsv1.Deployment, err error
 			_, err = dri.Update(context.Background(), unstructuredObj, metav1.UpdateOptions{})
 		}
 		if err != nil {
-			log.Error("DynamicResourceInterface Apply error")
-			log.Error(err)
+			h.log().Error("DynamicResourceInterface Apply error")
+			h.log().Error(err)
 			return nil, err
 		}
 	}
 
 	if err = runtime.DefaultUnstructuredConverter.FromUnstructured(unstructuredObj.UnstructuredContent(), deploy); err != nil {
-		log.Error("FromUnstructured error")
-		log.Error(err)
+		h.log().Error("FromUnstructured error")
+		h.log().Error(err)
 		return nil, err
 	}
 	return deploy, nil
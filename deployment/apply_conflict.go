@@ -0,0 +1,200 @@
+package deployment
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"regexp"
+	"strings"
+
+	appsv1 "k8s.io/api/apps/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/yaml"
+)
+
+// conflictManagerRegexp extracts the field manager from the server-side apply
+// conflict cause message, eg: `conflict with "kubectl-client-side-apply" using apps/v1`.
+var conflictManagerRegexp = regexp.MustCompile(`conflict with "([^"]*)"`)
+
+// FieldConflict is a field owned by another field manager.
+type FieldConflict struct {
+	// Field is the path of the conflicting field, eg: ".spec.replicas".
+	Field string
+	// Manager is the field manager that owns the field.
+	Manager string
+	// Message is the original conflict message returned by kubernetes apiserver.
+	Message string
+}
+
+// ApplyConflict is returned when the server-side apply conflicts with other
+// field managers, it reports which fields are owned by which field managers,
+// so the caller can decide whether to force the apply or to back off.
+type ApplyConflict struct {
+	Conflicts []FieldConflict
+
+	err error
+}
+
+func (e *ApplyConflict) Error() string {
+	var conflicts []string
+	for _, c := range e.Conflicts {
+		conflicts = append(conflicts, fmt.Sprintf("%s(owned by %q)", c.Field, c.Manager))
+	}
+	return fmt.Sprintf("apply conflicts with other field managers: %s", strings.Join(conflicts, ", "))
+}
+
+// Unwrap returns the original *k8serrors.StatusError, so k8serrors.IsConflict still works.
+func (e *ApplyConflict) Unwrap() error {
+	return e.err
+}
+
+// AsApplyConflict returns the *ApplyConflict and true if the err is a
+// server-side apply conflict error.
+func AsApplyConflict(err error) (*ApplyConflict, bool) {
+	conflict := &ApplyConflict{}
+	if errors.As(err, &conflict) {
+		return conflict, true
+	}
+	return nil, false
+}
+
+// toApplyConflict converts the server-side apply conflict error to *ApplyConflict,
+// the other errors are returned unchanged.
+func toApplyConflict(err error) error {
+	statusErr := &k8serrors.StatusError{}
+	if !errors.As(err, &statusErr) || !k8serrors.IsConflict(err) {
+		return err
+	}
+	details := statusErr.ErrStatus.Details
+	if details == nil {
+		return err
+	}
+	conflict := &ApplyConflict{err: err}
+	for _, cause := range details.Causes {
+		if cause.Type != metav1.CauseTypeFieldManagerConflict {
+			continue
+		}
+		fc := FieldConflict{Field: cause.Field, Message: cause.Message}
+		if matches := conflictManagerRegexp.FindStringSubmatch(cause.Message); len(matches) == 2 {
+			fc.Manager = matches[1]
+		}
+		conflict.Conflicts = append(conflict.Conflicts, fc)
+	}
+	if len(conflict.Conflicts) == 0 {
+		return err
+	}
+	return conflict
+}
+
+// defaultFieldManager is the field manager used by ServerSideApply if it's not
+// set by WithFieldManager.
+const defaultFieldManager = "deployment-handler"
+
+// ServerSideApply applies deployment from type string, []byte, *appsv1.Deployment,
+// appsv1.Deployment, metav1.Object, runtime.Object, *unstructured.Unstructured,
+// unstructured.Unstructured, map[string]interface{} or io.Reader with the
+// "Server-Side Apply" patch type, it works like `kubectl apply --server-side`.
+//
+// The fields are owned by the field manager set by WithFieldManager(default
+// to "deployment-handler"). The conflicts with other field managers are forced
+// by default, set Options.PatchOptions.Force to false to get the conflicts
+// as *ApplyConflict instead, see AsApplyConflict.
+func (h *Handler) ServerSideApply(obj interface{}) (*appsv1.Deployment, error) {
+	deploy, err := toDeployment(obj)
+	if err != nil {
+		return nil, err
+	}
+	fieldManager := h.Options.PatchOptions.FieldManager
+	if len(fieldManager) == 0 {
+		fieldManager = defaultFieldManager
+	}
+	force := true
+	if h.Options.PatchOptions.Force != nil {
+		force = *h.Options.PatchOptions.Force
+	}
+	return h.serverSideApply(deploy, fieldManager, force)
+}
+
+// toDeployment converts the obj accepted by ServerSideApply to *appsv1.Deployment.
+func toDeployment(obj interface{}) (*appsv1.Deployment, error) {
+	deploy := &appsv1.Deployment{}
+	switch val := obj.(type) {
+	case string:
+		data, err := ioutil.ReadFile(val)
+		if err != nil {
+			return nil, err
+		}
+		return toDeployment(data)
+	case []byte:
+		deployJson, err := yaml.ToJSON(val)
+		if err != nil {
+			return nil, err
+		}
+		if err := json.Unmarshal(deployJson, deploy); err != nil {
+			return nil, err
+		}
+		return deploy, nil
+	case *appsv1.Deployment:
+		return val, nil
+	case appsv1.Deployment:
+		return &val, nil
+	case *unstructured.Unstructured:
+		return toDeployment(val.UnstructuredContent())
+	case unstructured.Unstructured:
+		return toDeployment(val.UnstructuredContent())
+	case map[string]interface{}:
+		if err := runtime.DefaultUnstructuredConverter.FromUnstructured(val, deploy); err != nil {
+			return nil, err
+		}
+		return deploy, nil
+	case io.Reader:
+		data, err := io.ReadAll(val)
+		if err != nil {
+			return nil, fmt.Errorf("read deployment data: %w", err)
+		}
+		return toDeployment(data)
+	case metav1.Object, runtime.Object:
+		deploy, ok := val.(*appsv1.Deployment)
+		if !ok {
+			return nil, ErrInvalidObjectType
+		}
+		return deploy, nil
+	default:
+		return nil, ErrInvalidApplyType
+	}
+}
+
+// serverSideApply applies the deployment with the "Server-Side Apply" patch type.
+// The conflict error is returned as *ApplyConflict.
+func (h *Handler) serverSideApply(deploy *appsv1.Deployment, fieldManager string, force bool) (*appsv1.Deployment, error) {
+	namespace := deploy.GetNamespace()
+	if len(namespace) == 0 {
+		namespace = h.namespace
+	}
+	deploy = deploy.DeepCopy()
+	deploy.APIVersion = GVK.GroupVersion().String()
+	deploy.Kind = GVK.Kind
+	// managedFields and resourceVersion must not be set in the apply configuration.
+	deploy.ManagedFields = nil
+	deploy.ResourceVersion = ""
+
+	data, err := json.Marshal(deploy)
+	if err != nil {
+		return nil, err
+	}
+	patchOptions := h.Options.PatchOptions.DeepCopy()
+	patchOptions.FieldManager = fieldManager
+	patchOptions.Force = &force
+	applied, err := h.clientset.AppsV1().Deployments(namespace).
+		Patch(h.ctx, deploy.Name, types.ApplyPatchType, data, *patchOptions)
+	if err != nil {
+		return nil, toApplyConflict(err)
+	}
+	return applied, nil
+}
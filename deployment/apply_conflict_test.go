@@ -0,0 +1,128 @@
+package deployment
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	appsv1 "k8s.io/api/apps/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+func TestToApplyConflict(t *testing.T) {
+	// the conflict status returned by kubernetes apiserver when server-side apply conflicts.
+	statusErr := &k8serrors.StatusError{ErrStatus: metav1.Status{
+		Status:  metav1.StatusFailure,
+		Code:    http.StatusConflict,
+		Reason:  metav1.StatusReasonConflict,
+		Message: `Apply failed with 2 conflicts: conflict with "kubectl-client-side-apply" using apps/v1: .spec.replicas, conflict with "hpa" using apps/v1: .spec.template.spec.containers[name="nginx"].image`,
+		Details: &metav1.StatusDetails{
+			Causes: []metav1.StatusCause{
+				{
+					Type:    metav1.CauseTypeFieldManagerConflict,
+					Message: `conflict with "kubectl-client-side-apply" using apps/v1`,
+					Field:   ".spec.replicas",
+				},
+				{
+					Type:    metav1.CauseTypeFieldManagerConflict,
+					Message: `conflict with "hpa" using apps/v1`,
+					Field:   `.spec.template.spec.containers[name="nginx"].image`,
+				},
+			},
+		},
+	}}
+
+	err := toApplyConflict(fmt.Errorf("apply: %w", statusErr))
+	conflict, ok := AsApplyConflict(err)
+	if !ok {
+		t.Fatalf("expected *ApplyConflict, got %T: %v", err, err)
+	}
+	if !k8serrors.IsConflict(err) {
+		t.Error("ApplyConflict should unwrap to the conflict status error")
+	}
+	want := []FieldConflict{
+		{Field: ".spec.replicas", Manager: "kubectl-client-side-apply"},
+		{Field: `.spec.template.spec.containers[name="nginx"].image`, Manager: "hpa"},
+	}
+	if len(conflict.Conflicts) != len(want) {
+		t.Fatalf("conflicts = %+v, want %+v", conflict.Conflicts, want)
+	}
+	for i := range want {
+		if conflict.Conflicts[i].Field != want[i].Field || conflict.Conflicts[i].Manager != want[i].Manager {
+			t.Errorf("conflicts[%d] = %+v, want %+v", i, conflict.Conflicts[i], want[i])
+		}
+	}
+
+	// the other errors are returned unchanged.
+	notFound := k8serrors.NewNotFound(GVR.GroupResource(), "mydep")
+	if err := toApplyConflict(notFound); err != notFound {
+		t.Errorf("toApplyConflict(NotFound) = %v, want unchanged", err)
+	}
+}
+
+func TestServerSideApply(t *testing.T) {
+	deploy := &appsv1.Deployment{ObjectMeta: metav1.ObjectMeta{Name: "nginx", ResourceVersion: "1"}}
+
+	tests := []struct {
+		name             string
+		handler          func(h *Handler) *Handler
+		wantFieldManager string
+		wantForce        string
+		wantConflict     bool
+	}{
+		{"default field manager", func(h *Handler) *Handler { return h }, "deployment-handler", "true", false},
+		{"with field manager", func(h *Handler) *Handler { return h.WithFieldManager("my-controller") }, "my-controller", "true", false},
+		{"not force", func(h *Handler) *Handler {
+			h = h.WithFieldManager("my-controller")
+			h.Options.PatchOptions.Force = new(bool)
+			return h
+		}, "my-controller", "false", true},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			h := newTestHandler(t, func(w http.ResponseWriter, r *http.Request) {
+				if r.Method != http.MethodPatch || r.URL.Path != "/apis/apps/v1/namespaces/test/deployments/nginx" {
+					t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+				}
+				if contentType := r.Header.Get("Content-Type"); contentType != string(types.ApplyPatchType) {
+					t.Errorf("Content-Type = %q, want %q", contentType, types.ApplyPatchType)
+				}
+				query := r.URL.Query()
+				if query.Get("fieldManager") != test.wantFieldManager || query.Get("force") != test.wantForce {
+					t.Errorf("fieldManager = %q, force = %q, want %q, %q",
+						query.Get("fieldManager"), query.Get("force"), test.wantFieldManager, test.wantForce)
+				}
+				data, _ := io.ReadAll(r.Body)
+				if !strings.Contains(string(data), `"kind":"Deployment"`) || strings.Contains(string(data), "resourceVersion") {
+					t.Errorf("unexpected apply configuration: %s", data)
+				}
+				w.Header().Set("Content-Type", "application/json")
+				if query.Get("force") == "false" {
+					w.WriteHeader(http.StatusConflict)
+					fmt.Fprintln(w, `{"kind":"Status","apiVersion":"v1","status":"Failure","reason":"Conflict","code":409,`+
+						`"details":{"causes":[{"reason":"FieldManagerConflict","message":"conflict with \"kubectl\" using apps/v1","field":".spec.replicas"}]}}`)
+					return
+				}
+				w.Write(data)
+			})
+
+			applied, err := test.handler(h).ServerSideApply(deploy)
+			if test.wantConflict {
+				if conflict, ok := AsApplyConflict(err); !ok || conflict.Conflicts[0].Manager != "kubectl" {
+					t.Errorf("ServerSideApply() = %v, want *ApplyConflict", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if applied.Name != "nginx" {
+				t.Errorf("applied deployment %q, want nginx", applied.Name)
+			}
+		})
+	}
+}
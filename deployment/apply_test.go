@@ -0,0 +1,42 @@
+package deployment
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestApplyFromURL(t *testing.T) {
+	manifests := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/nginx.yaml" {
+			http.NotFound(w, r)
+			return
+		}
+		if auth := r.Header.Get("Authorization"); auth != "Bearer mytoken" {
+			t.Errorf("Authorization header = %q, want Bearer mytoken", auth)
+		}
+		io.WriteString(w, "apiVersion: apps/v1\nkind: Deployment\nmetadata:\n  name: nginx\n")
+	}))
+	defer manifests.Close()
+	h := newTestHandler(t, func(w http.ResponseWriter, r *http.Request) {
+		// echo the created deployment.
+		w.Header().Set("Content-Type", "application/json")
+		io.Copy(w, r.Body)
+	})
+
+	header := http.Header{"Authorization": {"Bearer mytoken"}}
+	deploy, err := h.ApplyFromURL(manifests.URL+"/nginx.yaml", header)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if deploy.Name != "nginx" {
+		t.Errorf("deployment name = %q, want nginx", deploy.Name)
+	}
+
+	_, err = h.ApplyFromURL(manifests.URL+"/missing.yaml", header)
+	if err == nil || !strings.Contains(err.Error(), "404") {
+		t.Errorf("ApplyFromURL() = %v, want error with status code 404", err)
+	}
+}
@@ -3,18 +3,21 @@ package deployment
 import (
 	"encoding/json"
 	"fmt"
+	"io"
 	"io/ioutil"
+	"sync"
 
 	appsv1 "k8s.io/api/apps/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime"
+	utilerrors "k8s.io/apimachinery/pkg/util/errors"
 	"k8s.io/apimachinery/pkg/util/yaml"
 )
 
 // Create creates deployment from type string, []byte, *appsv1.Deployment,
 // appsv1.Deployment, metav1.Object, runtime.Object, *unstructured.Unstructured,
-// unstructured.Unstructured or map[string]interface{}.
+// unstructured.Unstructured, map[string]interface{} or io.Reader.
 func (h *Handler) Create(obj interface{}) (*appsv1.Deployment, error) {
 	switch val := obj.(type) {
 	case string:
@@ -31,6 +34,8 @@ func (h *Handler) Create(obj interface{}) (*appsv1.Deployment, error) {
 		return h.CreateFromUnstructured(&val)
 	case map[string]interface{}:
 		return h.CreateFromMap(val)
+	case io.Reader:
+		return h.CreateFromReader(val)
 	case metav1.Object, runtime.Object:
 		//// - 如果传入的类型是 *unstructured.Unstructured 做类型断言时,它会自动转换成
 		////   runtime.Object 类型, 而不是 *unstructured.Unstructured
@@ -66,11 +71,20 @@ func (h *Handler) CreateFromBytes(data []byte) (*appsv1.Deployment, error) {
 	return h.createDeployment(deploy)
 }
 
+// CreateFromReader creates deployment from io.Reader, the reader is read until EOF.
+func (h *Handler) CreateFromReader(r io.Reader) (*appsv1.Deployment, error) {
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return nil, fmt.Errorf("read deployment data: %w", err)
+	}
+	return h.CreateFromBytes(data)
+}
+
 // CreateFromObject creates deployment from metav1.Object or runtime.Object.
 func (h *Handler) CreateFromObject(obj interface{}) (*appsv1.Deployment, error) {
 	deploy, ok := obj.(*appsv1.Deployment)
 	if !ok {
-		return nil, fmt.Errorf("object type is not *appsv1.Deployment")
+		return nil, ErrInvalidObjectType
 	}
 	return h.createDeployment(deploy)
 }
@@ -95,6 +109,52 @@ func (h *Handler) CreateFromMap(u map[string]interface{}) (*appsv1.Deployment, e
 	return h.createDeployment(deploy)
 }
 
+// defaultConcurrency is the default max number of concurrent requests sent
+// by CreateBatch.
+const defaultConcurrency = 10
+
+// CreateBatch creates deployments from objs concurrently, every obj can be any
+// type that Create accepts. At most concurrency(default to 10 if it's not
+// positive) deployments are created at the same time.
+//
+// A failed deployment doesn't abort the others. The returned deployments are
+// indexed by the position of objs, the deployment of a failed obj is nil, and
+// the error aggregates the failures of all the failed objs. The remaining objs
+// will not be created if the handler context is done.
+func (h *Handler) CreateBatch(objs []interface{}, concurrency int) ([]*appsv1.Deployment, error) {
+	if concurrency <= 0 {
+		concurrency = defaultConcurrency
+	}
+
+	var (
+		wg      sync.WaitGroup
+		created = make([]*appsv1.Deployment, len(objs))
+		errs    = make([]error, len(objs))
+		limit   = make(chan struct{}, concurrency)
+	)
+	for i, obj := range objs {
+		select {
+		case <-h.ctx.Done():
+			errs[i] = fmt.Errorf("objs[%d]: %w", i, h.ctx.Err())
+			continue
+		case limit <- struct{}{}:
+		}
+		wg.Add(1)
+		go func(i int, obj interface{}) {
+			defer wg.Done()
+			defer func() { <-limit }()
+			deploy, err := h.Create(obj)
+			if err != nil {
+				errs[i] = fmt.Errorf("objs[%d]: %w", i, err)
+				return
+			}
+			created[i] = deploy
+		}(i, obj)
+	}
+	wg.Wait()
+	return created, utilerrors.NewAggregate(errs)
+}
+
 // createDeployment
 func (h *Handler) createDeployment(deploy *appsv1.Deployment) (*appsv1.Deployment, error) {
 	// TODO: Check if the *appsv1.deployment resource always has Namespace field
@@ -109,5 +169,8 @@ func (h *Handler) createDeployment(deploy *appsv1.Deployment) (*appsv1.Deploymen
 	// "resourceVersion should not be set on objects to be created" will be returned.
 	deploy.ResourceVersion = ""
 	deploy.UID = ""
-	return h.clientset.AppsV1().Deployments(namespace).Create(h.ctx, deploy, h.Options.CreateOptions)
+	ctx, done := h.instrument("Create", namespace, deploy.Name)
+	deploy, err := h.clientset.AppsV1().Deployments(namespace).Create(ctx, deploy, h.Options.CreateOptions)
+	done(err)
+	return deploy, err
 }
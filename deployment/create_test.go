@@ -0,0 +1,112 @@
+package deployment
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"reflect"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	appsv1 "k8s.io/api/apps/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	utilerrors "k8s.io/apimachinery/pkg/util/errors"
+)
+
+// errReader is an io.Reader always fails.
+type errReader struct{ err error }
+
+func (r errReader) Read([]byte) (int, error) { return 0, r.err }
+
+func TestCreateFromReader(t *testing.T) {
+	h := newTestHandler(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost || r.URL.Path != "/apis/apps/v1/namespaces/test/deployments" {
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+		// echo the created deployment.
+		w.Header().Set("Content-Type", "application/json")
+		io.Copy(w, r.Body)
+	})
+
+	buf := bytes.NewBufferString(`apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: nginx
+`)
+	deploy, err := h.CreateFromReader(buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if deploy.Name != "nginx" {
+		t.Errorf("deployment name = %q, want nginx", deploy.Name)
+	}
+	if buf.Len() != 0 {
+		t.Errorf("the reader is not fully consumed, %d bytes left", buf.Len())
+	}
+
+	errRead := errors.New("read failed")
+	if _, err := h.CreateFromReader(errReader{errRead}); !errors.Is(err, errRead) {
+		t.Errorf("CreateFromReader() = %v, want wrapped %v", err, errRead)
+	}
+	if err := h.DeleteFromReader(errReader{errRead}); !errors.Is(err, errRead) {
+		t.Errorf("DeleteFromReader() = %v, want wrapped %v", err, errRead)
+	}
+}
+
+func TestCreateBatch(t *testing.T) {
+	const concurrency = 2
+	var inflight, maxInflight int32
+	h := newTestHandler(t, func(w http.ResponseWriter, r *http.Request) {
+		n := atomic.AddInt32(&inflight, 1)
+		defer atomic.AddInt32(&inflight, -1)
+		for {
+			max := atomic.LoadInt32(&maxInflight)
+			if n <= max || atomic.CompareAndSwapInt32(&maxInflight, max, n) {
+				break
+			}
+		}
+		time.Sleep(20 * time.Millisecond)
+
+		data, _ := io.ReadAll(r.Body)
+		w.Header().Set("Content-Type", "application/json")
+		if bytes.Contains(data, []byte(`"name":"bad"`)) {
+			w.WriteHeader(http.StatusConflict)
+			fmt.Fprintln(w, `{"kind":"Status","apiVersion":"v1","status":"Failure","reason":"AlreadyExists","code":409}`)
+			return
+		}
+		// echo the created deployment.
+		w.Write(data)
+	})
+
+	var objs []interface{}
+	for _, name := range []string{"d0", "d1", "bad", "d3", "d4", "d5"} {
+		objs = append(objs, &appsv1.Deployment{ObjectMeta: metav1.ObjectMeta{Name: name}})
+	}
+	deploys, err := h.CreateBatch(objs, concurrency)
+	if err == nil || !strings.Contains(err.Error(), "objs[2]") {
+		t.Errorf("CreateBatch() error = %v, want the failure of objs[2]", err)
+	}
+	if !k8serrors.IsAlreadyExists(errors.Unwrap(err.(utilerrors.Aggregate).Errors()[0])) {
+		t.Errorf("CreateBatch() error = %v, want AlreadyExists", err)
+	}
+	// the deployments are indexed by the position of objs.
+	var names []string
+	for _, deploy := range deploys {
+		name := "<nil>"
+		if deploy != nil {
+			name = deploy.Name
+		}
+		names = append(names, name)
+	}
+	if want := []string{"d0", "d1", "<nil>", "d3", "d4", "d5"}; !reflect.DeepEqual(names, want) {
+		t.Errorf("created %v, want %v", names, want)
+	}
+	if maxInflight > concurrency {
+		t.Errorf("%d deployments were created concurrently, want at most %d", maxInflight, concurrency)
+	}
+}
@@ -3,6 +3,7 @@ package deployment
 import (
 	"encoding/json"
 	"fmt"
+	"io"
 	"io/ioutil"
 
 	appsv1 "k8s.io/api/apps/v1"
@@ -14,7 +15,7 @@ import (
 
 // Delete deletes deployment from type string, []byte, *appsv1.Deployment,
 // appsv1.Deployment, metav1.Object, runtime.Object, *unstructured.Unstructured,
-// unstructured.Unstructured or map[string]interface{}.
+// unstructured.Unstructured, map[string]interface{} or io.Reader.
 //
 // If passed parameter type is string, it will simply call DeleteByName instead of DeleteFromFile.
 // You should always explicitly call DeleteFromFile to delete a deployment from file path.
@@ -34,6 +35,8 @@ func (h *Handler) Delete(obj interface{}) error {
 		return h.DeleteFromUnstructured(&val)
 	case map[string]interface{}:
 		return h.DeleteFromMap(val)
+	case io.Reader:
+		return h.DeleteFromReader(val)
 	case metav1.Object, runtime.Object:
 		return h.DeleteFromObject(val)
 	default:
@@ -43,7 +46,28 @@ func (h *Handler) Delete(obj interface{}) error {
 
 // DeleteByName deletes deployment by name.
 func (h *Handler) DeleteByName(name string) error {
-	return h.clientset.AppsV1().Deployments(h.namespace).Delete(h.ctx, name, h.Options.DeleteOptions)
+	ctx, done := h.instrument("Delete", h.namespace, name)
+	err := h.clientset.AppsV1().Deployments(h.namespace).Delete(ctx, name, h.Options.DeleteOptions)
+	done(err)
+	return err
+}
+
+// DeleteWithOptions deletes the deployment by name with the delete options, such
+// as the propagation policy and the grace period, instead of the delete options
+// of the handler. It doesn't modify the handler, so it's safe to be called
+// concurrently with the different options.
+func (h *Handler) DeleteWithOptions(name string, opts metav1.DeleteOptions) error {
+	ctx, done := h.instrument("Delete", h.namespace, name)
+	err := h.clientset.AppsV1().Deployments(h.namespace).Delete(ctx, name, opts)
+	done(err)
+	return err
+}
+
+// DeleteCollection deletes all the deployments selected by the listOptions in the
+// handler namespace, such as the deployments matching the label selector.
+// The delete options of the handler, such as the propagation policy, are used.
+func (h *Handler) DeleteCollection(listOptions metav1.ListOptions) error {
+	return h.clientset.AppsV1().Deployments(h.namespace).DeleteCollection(h.ctx, h.Options.DeleteOptions, listOptions)
 }
 
 // DeleteFromFile deletes deployment from yaml or json file.
@@ -69,11 +93,20 @@ func (h *Handler) DeleteFromBytes(data []byte) error {
 	return h.deleteDeployment(deploy)
 }
 
+// DeleteFromReader deletes deployment from io.Reader, the reader is read until EOF.
+func (h *Handler) DeleteFromReader(r io.Reader) error {
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return fmt.Errorf("read deployment data: %w", err)
+	}
+	return h.DeleteFromBytes(data)
+}
+
 // DeleteFromObject deletes deployment from metav1.Object or runtime.Object.
 func (h *Handler) DeleteFromObject(obj interface{}) error {
 	deploy, ok := obj.(*appsv1.Deployment)
 	if !ok {
-		return fmt.Errorf("object type is not *appsv1.Deployment")
+		return ErrInvalidObjectType
 	}
 	return h.deleteDeployment(deploy)
 }
@@ -104,5 +137,8 @@ func (h *Handler) deleteDeployment(deploy *appsv1.Deployment) error {
 	if len(namespace) == 0 {
 		namespace = h.namespace
 	}
-	return h.clientset.AppsV1().Deployments(namespace).Delete(h.ctx, deploy.Name, h.Options.DeleteOptions)
+	ctx, done := h.instrument("Delete", namespace, deploy.Name)
+	err := h.clientset.AppsV1().Deployments(namespace).Delete(ctx, deploy.Name, h.Options.DeleteOptions)
+	done(err)
+	return err
 }
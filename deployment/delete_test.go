@@ -0,0 +1,100 @@
+package deployment
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"reflect"
+	"sort"
+	"sync"
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+)
+
+func TestDeleteCollection(t *testing.T) {
+	var (
+		mu          sync.Mutex
+		deployments = map[string]labels.Set{
+			"nginx":        {"app": "nginx"},
+			"nginx-canary": {"app": "nginx", "track": "canary"},
+			"redis":        {"app": "redis"},
+		}
+		propagationPolicy metav1.DeletionPropagation
+	)
+	h := newTestHandler(t, func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+		if r.Method != http.MethodDelete || r.URL.Path != "/apis/apps/v1/namespaces/test/deployments" {
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+		data, _ := io.ReadAll(r.Body)
+		deleteOptions := &metav1.DeleteOptions{}
+		if err := json.Unmarshal(data, deleteOptions); err != nil {
+			t.Error(err)
+		}
+		if deleteOptions.PropagationPolicy != nil {
+			propagationPolicy = *deleteOptions.PropagationPolicy
+		}
+		selector, err := labels.Parse(r.URL.Query().Get("labelSelector"))
+		if err != nil {
+			t.Error(err)
+		}
+		for name, set := range deployments {
+			if selector.Matches(set) {
+				delete(deployments, name)
+			}
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"kind":"Status","apiVersion":"v1","status":"Success"}`))
+	})
+	foreground := metav1.DeletePropagationForeground
+	h.Options.DeleteOptions.PropagationPolicy = &foreground
+
+	if err := h.DeleteCollection(metav1.ListOptions{LabelSelector: "app=nginx"}); err != nil {
+		t.Fatal(err)
+	}
+	var remained []string
+	for name := range deployments {
+		remained = append(remained, name)
+	}
+	sort.Strings(remained)
+	if want := []string{"redis"}; !reflect.DeepEqual(remained, want) {
+		t.Errorf("remained deployments = %v, want %v", remained, want)
+	}
+	if propagationPolicy != foreground {
+		t.Errorf("propagationPolicy = %q, want %q", propagationPolicy, foreground)
+	}
+}
+
+func TestDeleteWithOptions(t *testing.T) {
+	var deleteOptions metav1.DeleteOptions
+	h := newTestHandler(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodDelete || r.URL.Path != "/apis/apps/v1/namespaces/test/deployments/nginx" {
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+		if err := json.NewDecoder(r.Body).Decode(&deleteOptions); err != nil {
+			t.Error(err)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"kind":"Status","apiVersion":"v1","status":"Success"}`))
+	})
+	background := metav1.DeletePropagationBackground
+	h.Options.DeleteOptions.PropagationPolicy = &background
+
+	foreground := metav1.DeletePropagationForeground
+	gracePeriodSeconds := int64(0)
+	opts := metav1.DeleteOptions{PropagationPolicy: &foreground, GracePeriodSeconds: &gracePeriodSeconds}
+	if err := h.DeleteWithOptions("nginx", opts); err != nil {
+		t.Fatal(err)
+	}
+	if deleteOptions.PropagationPolicy == nil || *deleteOptions.PropagationPolicy != foreground ||
+		deleteOptions.GracePeriodSeconds == nil || *deleteOptions.GracePeriodSeconds != 0 {
+		t.Errorf("delete options = %+v, want %+v", deleteOptions, opts)
+	}
+	// the handler delete options are untouched.
+	if *h.Options.DeleteOptions.PropagationPolicy != background || h.Options.DeleteOptions.GracePeriodSeconds != nil {
+		t.Errorf("handler delete options are modified: %+v", h.Options.DeleteOptions)
+	}
+}
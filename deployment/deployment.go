@@ -8,8 +8,12 @@ import (
 
 	"github.com/forbearing/k8s/types"
 	"github.com/forbearing/k8s/util/client"
+	utildiscovery "github.com/forbearing/k8s/util/discovery"
+	"github.com/forbearing/k8s/util/recorder"
+	"go.opentelemetry.io/otel/trace"
 	appsv1 "k8s.io/api/apps/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/client-go/discovery"
 	"k8s.io/client-go/dynamic"
@@ -37,15 +41,21 @@ type Handler struct {
 	tweakListOptions internalinterfaces.TweakListOptionsFunc
 	informerFactory  informers.SharedInformerFactory
 
+	recorder       *recorder.Lazy
+	metrics        types.MetricsRecorder
+	tracerProvider trace.TracerProvider
+	paginateAll    bool
+
 	Options *types.HandlerOptions
+	logger  types.Logger
 
 	l sync.RWMutex
 }
 
 // NewOrDie simply call New() to get a deployment handler.
 // panic if there is any error occurs.
-func NewOrDie(ctx context.Context, kubeconfig, namespace string) *Handler {
-	handler, err := New(ctx, kubeconfig, namespace)
+func NewOrDie(ctx context.Context, kubeconfig, namespace string, opts ...Option) *Handler {
+	handler, err := New(ctx, kubeconfig, namespace, opts...)
 	if err != nil {
 		panic(err)
 	}
@@ -58,7 +68,10 @@ func NewOrDie(ctx context.Context, kubeconfig, namespace string) *Handler {
 // * KUBECONFIG environment variable pointing at a file.
 // * $HOME/.kube/config if exists.
 // * In-cluster config if running in cluster.
-func New(ctx context.Context, kubeconfig, namespace string) (*Handler, error) {
+//
+// The opts, such as WithResyncPeriod, WithUserAgent, WithQPS and WithInformerNamespace,
+// customize the clients and the informer factory of the handler.
+func New(ctx context.Context, kubeconfig, namespace string, opts ...Option) (*Handler, error) {
 	var (
 		err             error
 		config          *rest.Config
@@ -68,6 +81,7 @@ func New(ctx context.Context, kubeconfig, namespace string) (*Handler, error) {
 		dynamicClient   dynamic.Interface
 		discoveryClient *discovery.DiscoveryClient
 		informerFactory informers.SharedInformerFactory
+		o               = newOptions(opts...)
 	)
 
 	// create rest config, and config precedence.
@@ -83,6 +97,10 @@ func New(ctx context.Context, kubeconfig, namespace string) (*Handler, error) {
 	config.GroupVersion = &appsv1.SchemeGroupVersion
 	//config.GroupVersion = &schema.GroupVersion{Group: "apps", Version: "v1"}
 	config.NegotiatedSerializer = scheme.Codecs
+	if len(o.userAgent) != 0 {
+		config.UserAgent = o.userAgent
+	}
+	o.applyRateLimit(config)
 	//config.UserAgent = rest.DefaultKubernetesUserAgent()
 	//// k8s cluster endpoint, eg: https://10.250.16.10:8443
 	//config.Host = "127.0.0.1"
@@ -114,8 +132,9 @@ func New(ctx context.Context, kubeconfig, namespace string) (*Handler, error) {
 	if len(namespace) == 0 {
 		namespace = metav1.NamespaceDefault
 	}
-	// create a sharedInformerFactory for all namespaces.
-	informerFactory = informers.NewSharedInformerFactory(clientset, 0)
+	// create a sharedInformerFactory, for all namespaces by default.
+	informerFactory = informers.NewSharedInformerFactoryWithOptions(
+		clientset, o.resyncPeriod, informers.WithNamespace(o.informerScope))
 
 	return &Handler{
 		ctx:             ctx,
@@ -128,6 +147,11 @@ func New(ctx context.Context, kubeconfig, namespace string) (*Handler, error) {
 		dynamicClient:   dynamicClient,
 		discoveryClient: discoveryClient,
 		informerFactory: informerFactory,
+		resyncPeriod:    o.resyncPeriod,
+		informerScope:   o.informerScope,
+		recorder:        recorder.NewLazy(clientset, "deployment-handler"),
+		metrics:         o.metrics,
+		tracerProvider:  o.tracerProvider,
 		Options:         &types.HandlerOptions{},
 	}, nil
 }
@@ -140,6 +164,15 @@ func (h *Handler) WithNamespace(namespace string) *Handler {
 	return handler
 }
 
+// WithContext deep copies a new handler, but set the handler.ctx to the
+// provided context, the new handler shares the clients with the original one,
+// but all its operations are bound to ctx, eg: a per-call deadline.
+func (h *Handler) WithContext(ctx context.Context) *Handler {
+	handler := h.DeepCopy()
+	handler.ctx = ctx
+	return handler
+}
+
 // WithDryRun deep copies a new handler and prints the create/update/apply/delete
 // operations, without sending it to apiserver.
 func (h *Handler) WithDryRun() *Handler {
@@ -151,10 +184,26 @@ func (h *Handler) WithDryRun() *Handler {
 	handler.Options.DeleteOptions.DryRun = []string{metav1.DryRunAll}
 	return handler
 }
+
+// WithFieldManager deep copies a new handler, but set the field manager of
+// the create/update/apply/patch operations to the provided name.
+// ServerSideApply uses it as the owner of the applied fields.
+func (h *Handler) WithFieldManager(name string) *Handler {
+	handler := h.DeepCopy()
+	handler.Options.CreateOptions.FieldManager = name
+	handler.Options.UpdateOptions.FieldManager = name
+	handler.Options.ApplyOptions.FieldManager = name
+	handler.Options.PatchOptions.FieldManager = name
+	return handler
+}
 func (in *Handler) DeepCopy() *Handler {
 	if in == nil {
 		return nil
 	}
+	// hold the read lock, so the Set* methods can't modify the handler while copying it.
+	in.l.RLock()
+	defer in.l.RUnlock()
+
 	return &Handler{
 		ctx:              in.ctx,
 		kubeconfig:       in.kubeconfig,
@@ -169,6 +218,11 @@ func (in *Handler) DeepCopy() *Handler {
 		resyncPeriod:     in.resyncPeriod,
 		informerScope:    in.informerScope,
 		tweakListOptions: in.tweakListOptions,
+		logger:           in.logger,
+		recorder:         in.recorder,
+		metrics:          in.metrics,
+		tracerProvider:   in.tracerProvider,
+		paginateAll:      in.paginateAll,
 		Options: &types.HandlerOptions{
 			CreateOptions: *in.Options.CreateOptions.DeepCopy(),
 			UpdateOptions: *in.Options.UpdateOptions.DeepCopy(),
@@ -182,7 +236,7 @@ func (in *Handler) DeepCopy() *Handler {
 }
 func (h *Handler) ResetNamespace(namespace string) {
 	h.l.Lock()
-	h.l.Unlock()
+	defer h.l.Unlock()
 	h.namespace = namespace
 }
 
@@ -191,11 +245,27 @@ func (h *Handler) SetTimeout(timeout int64) {
 	defer h.l.Unlock()
 	h.Options.ListOptions.TimeoutSeconds = &timeout
 }
+
+// SetLimit sets the limit of list.
+// If paginateAll is false(default), the limit caps the total number of
+// deployments returned by ListByLabel, ListByField and ListByNamespace. If
+// paginateAll is true, the limit is the page size and they follow the continue
+// token to return all deployments. List and ListAll always follow the continue
+// token, the limit is their page size no matter whether paginateAll is set.
 func (h *Handler) SetLimit(limit int64) {
 	h.l.Lock()
 	defer h.l.Unlock()
 	h.Options.ListOptions.Limit = limit
 }
+
+// SetPaginateAll sets whether ListByLabel, ListByField and ListByNamespace
+// follow the continue token to list all pages of deployments, the limit set by
+// SetLimit is the page size. List and ListAll always list all pages.
+func (h *Handler) SetPaginateAll(paginateAll bool) {
+	h.l.Lock()
+	defer h.l.Unlock()
+	h.paginateAll = paginateAll
+}
 func (h *Handler) SetForceDelete(force bool) {
 	h.l.Lock()
 	defer h.l.Unlock()
@@ -204,6 +274,24 @@ func (h *Handler) SetForceDelete(force bool) {
 	}
 }
 
+// SetLogger sets the logger reporting the watch and informer events,
+// types.DiscardLogger silences them. The logrus standard logger is used by default.
+func (h *Handler) SetLogger(logger types.Logger) {
+	h.l.Lock()
+	defer h.l.Unlock()
+	h.logger = logger
+}
+
+// log returns the logger set by SetLogger, or the default logger.
+func (h *Handler) log() types.Logger {
+	h.l.RLock()
+	defer h.l.RUnlock()
+	if h.logger == nil {
+		return types.DefaultLogger()
+	}
+	return h.logger
+}
+
 // RESTConfig returns underlying rest config.
 func (h *Handler) RESTConfig() *rest.Config {
 	return h.config
@@ -229,6 +317,20 @@ func (h *Handler) DiscoveryClient() *discovery.DiscoveryClient {
 	return h.discoveryClient
 }
 
+// APIVerbs returns the verbs supported by the kubernetes apiserver for deployment,
+// such as "get", "list", "watch", "create", "update", "patch" and "delete".
+func (h *Handler) APIVerbs() ([]string, error) {
+	return utildiscovery.APIVerbs(h.discoveryClient, GVR)
+}
+
+// RecordEvent records an event against the object, so the actions taken by
+// the tools are visible by `kubectl describe`, eg: "ScalingReplicaSet".
+// The eventType should be corev1.EventTypeNormal or corev1.EventTypeWarning.
+// The event broadcaster is started lazily when the first event is recorded.
+func (h *Handler) RecordEvent(obj runtime.Object, eventType, reason, message string) {
+	h.recorder.Event(obj, eventType, reason, message)
+}
+
 // GVK contains the Group, Version, Kind name of deployment.
 var GVK = schema.GroupVersionKind{
 	Group:   appsv1.SchemeGroupVersion.Group,
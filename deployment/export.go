@@ -0,0 +1,62 @@
+package deployment
+
+import (
+	"github.com/forbearing/k8s/util/export"
+	appsv1 "k8s.io/api/apps/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/yaml"
+)
+
+// ExportForGit gets the deployment and serializes it to a clean yaml which is
+// suitable for committing to a git repository.
+//
+// The server-managed fields (managedFields, resourceVersion, uid, creationTimestamp,
+// generation, status, etc.) are removed, you can provide the field paths to
+// override the default strip list export.DefaultStripFields, eg:
+//     ExportForGit("mydep", []string{"metadata", "managedFields"}, []string{"status"})
+func (h *Handler) ExportForGit(name string, stripFields ...[]string) ([]byte, error) {
+	deploy, err := h.Get(name)
+	if err != nil {
+		return nil, err
+	}
+	return export.ToYAML(deploy, GVK, stripFields...)
+}
+
+// ToYAML serializes the deployment to yaml, the apiVersion and kind are always
+// set. If strip is true, the server-managed fields(export.DefaultStripFields),
+// such as managedFields and status, are removed.
+func ToYAML(deploy *appsv1.Deployment, strip bool) ([]byte, error) {
+	u, err := ToUnstructured(deploy, strip)
+	if err != nil {
+		return nil, err
+	}
+	return yaml.Marshal(u.Object)
+}
+
+// ToJSON serializes the deployment to json, the apiVersion and kind are always
+// set. If strip is true, the server-managed fields(export.DefaultStripFields),
+// such as managedFields and status, are removed.
+func ToJSON(deploy *appsv1.Deployment, strip bool) ([]byte, error) {
+	u, err := ToUnstructured(deploy, strip)
+	if err != nil {
+		return nil, err
+	}
+	return u.MarshalJSON()
+}
+
+// ToUnstructured converts the deployment to *unstructured.Unstructured, the
+// apiVersion and kind are always set. If strip is true, the server-managed
+// fields(export.DefaultStripFields), such as managedFields and status, are removed.
+func ToUnstructured(deploy *appsv1.Deployment, strip bool) (*unstructured.Unstructured, error) {
+	deploy = deploy.DeepCopy()
+	deploy.SetGroupVersionKind(GVK)
+	unstructMap, err := runtime.DefaultUnstructuredConverter.ToUnstructured(deploy)
+	if err != nil {
+		return nil, err
+	}
+	if strip {
+		export.Strip(unstructMap)
+	}
+	return &unstructured.Unstructured{Object: unstructMap}, nil
+}
@@ -0,0 +1,80 @@
+package deployment
+
+import (
+	"encoding/json"
+	"testing"
+
+	appsv1 "k8s.io/api/apps/v1"
+	apiequality "k8s.io/apimachinery/pkg/api/equality"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/yaml"
+)
+
+func TestConversion(t *testing.T) {
+	replicas := int32(3)
+	deploy := &appsv1.Deployment{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:            "nginx",
+			Namespace:       "test",
+			Labels:          map[string]string{"app": "nginx"},
+			ResourceVersion: "100",
+			ManagedFields:   []metav1.ManagedFieldsEntry{{Manager: "kubectl"}},
+		},
+		Spec:   appsv1.DeploymentSpec{Replicas: &replicas},
+		Status: appsv1.DeploymentStatus{ReadyReplicas: 3},
+	}
+	// stripped is the deployment expected after round-tripping with strip.
+	stripped := &appsv1.Deployment{
+		TypeMeta:   metav1.TypeMeta{APIVersion: "apps/v1", Kind: "Deployment"},
+		ObjectMeta: metav1.ObjectMeta{Name: "nginx", Namespace: "test", Labels: map[string]string{"app": "nginx"}},
+		Spec:       appsv1.DeploymentSpec{Replicas: &replicas},
+	}
+	full := deploy.DeepCopy()
+	full.TypeMeta = stripped.TypeMeta
+
+	for _, test := range []struct {
+		strip bool
+		want  *appsv1.Deployment
+	}{{false, full}, {true, stripped}} {
+		data, err := ToYAML(deploy, test.strip)
+		if err != nil {
+			t.Fatal(err)
+		}
+		fromYAML := &appsv1.Deployment{}
+		if err := yaml.Unmarshal(data, fromYAML); err != nil {
+			t.Fatal(err)
+		}
+		if !apiequality.Semantic.DeepEqual(fromYAML, test.want) {
+			t.Errorf("ToYAML(strip=%v) round trip = %+v, want %+v", test.strip, fromYAML, test.want)
+		}
+
+		data, err = ToJSON(deploy, test.strip)
+		if err != nil {
+			t.Fatal(err)
+		}
+		fromJSON := &appsv1.Deployment{}
+		if err := json.Unmarshal(data, fromJSON); err != nil {
+			t.Fatal(err)
+		}
+		if !apiequality.Semantic.DeepEqual(fromJSON, test.want) {
+			t.Errorf("ToJSON(strip=%v) round trip = %+v, want %+v", test.strip, fromJSON, test.want)
+		}
+
+		u, err := ToUnstructured(deploy, test.strip)
+		if err != nil {
+			t.Fatal(err)
+		}
+		fromUnstructured := &appsv1.Deployment{}
+		if err := runtime.DefaultUnstructuredConverter.FromUnstructured(u.Object, fromUnstructured); err != nil {
+			t.Fatal(err)
+		}
+		if !apiequality.Semantic.DeepEqual(fromUnstructured, test.want) {
+			t.Errorf("ToUnstructured(strip=%v) round trip = %+v, want %+v", test.strip, fromUnstructured, test.want)
+		}
+	}
+	// the deployment passed in must not be modified.
+	if deploy.Kind != "" || deploy.ResourceVersion != "100" {
+		t.Errorf("the deployment is modified: %+v", deploy)
+	}
+}
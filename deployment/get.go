@@ -3,9 +3,11 @@ package deployment
 import (
 	"encoding/json"
 	"fmt"
+	"io"
 	"io/ioutil"
 
 	appsv1 "k8s.io/api/apps/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime"
@@ -14,7 +16,7 @@ import (
 
 // Get gets deployment from type string, []byte, *appsv1.Deployment,
 // appsv1.Deployment, metav1.Object, runtime.Object, *unstructured.Unstructured,
-// unstructured.Unstructured or map[string]interface{}.
+// unstructured.Unstructured, map[string]interface{} or io.Reader.
 //
 // If passed parameter type is string, it will simply call GetByName instead of GetFromFile.
 // You should always explicitly call GetFromFile to get a deployment from file path.
@@ -34,6 +36,8 @@ func (h *Handler) Get(obj interface{}) (*appsv1.Deployment, error) {
 		return h.GetFromUnstructured(&val)
 	case map[string]interface{}:
 		return h.GetFromMap(val)
+	case io.Reader:
+		return h.GetFromReader(val)
 	case metav1.Object, runtime.Object:
 		return h.GetFromObject(val)
 	default:
@@ -43,7 +47,54 @@ func (h *Handler) Get(obj interface{}) (*appsv1.Deployment, error) {
 
 // GetByName gets deployment by name.
 func (h *Handler) GetByName(name string) (*appsv1.Deployment, error) {
-	return h.clientset.AppsV1().Deployments(h.namespace).Get(h.ctx, name, h.Options.GetOptions)
+	ctx, done := h.instrument("Get", h.namespace, name)
+	deploy, err := h.clientset.AppsV1().Deployments(h.namespace).Get(ctx, name, h.Options.GetOptions)
+	done(err)
+	return deploy, err
+}
+
+// GetFromCache gets the deployment in the handler namespace from the informer
+// cache, without sending any request to kubernetes API server. A NotFound
+// error is returned if the deployment is not in the cache, check it with
+// k8s.io/apimachinery/pkg/api/errors.IsNotFound.
+//
+// The cache is only populated after RunInformer has synced, GetFromCache
+// always returns NotFound if the informer is not running.
+func (h *Handler) GetFromCache(name string) (*appsv1.Deployment, error) {
+	return h.Lister().Deployments(h.namespace).Get(name)
+}
+
+// Exists returns true if the deployment exists. It returns false and nil error if
+// the deployment is not found, the other errors, such as forbidden, are returned.
+func (h *Handler) Exists(name string) (bool, error) {
+	_, err := h.GetByName(name)
+	switch {
+	case err == nil:
+		return true, nil
+	case k8serrors.IsNotFound(err):
+		return false, nil
+	default:
+		return false, err
+	}
+}
+
+// GetByLabel gets the only deployment matching the label selector, it's handy
+// for the singleton deployment selected by its app label.
+// ErrNoMatch is returned if no deployment matches, and ErrMultipleMatches is
+// returned if more than one deployment matches.
+func (h *Handler) GetByLabel(labelSelector string) (*appsv1.Deployment, error) {
+	deploys, err := h.ListByLabel(labelSelector)
+	if err != nil {
+		return nil, err
+	}
+	switch len(deploys) {
+	case 0:
+		return nil, fmt.Errorf("%w: %q", ErrNoMatch, labelSelector)
+	case 1:
+		return deploys[0], nil
+	default:
+		return nil, fmt.Errorf("%w: %q matches %d deployments", ErrMultipleMatches, labelSelector, len(deploys))
+	}
 }
 
 // GetFromFile gets deployment from yaml or json file.
@@ -69,11 +120,20 @@ func (h *Handler) GetFromBytes(data []byte) (*appsv1.Deployment, error) {
 	return h.getDeployment(deploy)
 }
 
+// GetFromReader gets deployment from io.Reader, the reader is read until EOF.
+func (h *Handler) GetFromReader(r io.Reader) (*appsv1.Deployment, error) {
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return nil, fmt.Errorf("read deployment data: %w", err)
+	}
+	return h.GetFromBytes(data)
+}
+
 // GetFromObject gets deployment from metav1.Object or runtime.Object.
 func (h *Handler) GetFromObject(obj interface{}) (*appsv1.Deployment, error) {
 	deploy, ok := obj.(*appsv1.Deployment)
 	if !ok {
-		return nil, fmt.Errorf("object type is not *appsv1.Deployment")
+		return nil, ErrInvalidObjectType
 	}
 	return h.getDeployment(deploy)
 }
@@ -106,5 +166,8 @@ func (h *Handler) getDeployment(deploy *appsv1.Deployment) (*appsv1.Deployment,
 	if len(namespace) == 0 {
 		namespace = h.namespace
 	}
-	return h.clientset.AppsV1().Deployments(namespace).Get(h.ctx, deploy.Name, h.Options.GetOptions)
+	ctx, done := h.instrument("Get", namespace, deploy.Name)
+	deploy, err := h.clientset.AppsV1().Deployments(namespace).Get(ctx, deploy.Name, h.Options.GetOptions)
+	done(err)
+	return deploy, err
 }
@@ -0,0 +1,108 @@
+package deployment
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+
+	appsv1 "k8s.io/api/apps/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/informers"
+)
+
+func TestGetByLabel(t *testing.T) {
+	tests := []struct {
+		name    string
+		items   []string
+		wantErr error
+	}{
+		{"zero", nil, ErrNoMatch},
+		{"one", []string{"nginx"}, nil},
+		{"multiple", []string{"nginx", "nginx-canary"}, ErrMultipleMatches},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			h := newTestHandler(t, func(w http.ResponseWriter, r *http.Request) {
+				if labelSelector := r.URL.Query().Get("labelSelector"); labelSelector != "app=nginx" {
+					t.Errorf("labelSelector = %q, want app=nginx", labelSelector)
+				}
+				var items []string
+				for _, name := range test.items {
+					items = append(items, fmt.Sprintf(`{"metadata":{"name":%q,"namespace":"test"}}`, name))
+				}
+				w.Header().Set("Content-Type", "application/json")
+				fmt.Fprintf(w, `{"kind":"DeploymentList","apiVersion":"apps/v1","metadata":{},"items":[%s]}`, strings.Join(items, ","))
+			})
+
+			deploy, err := h.GetByLabel("app=nginx")
+			if !errors.Is(err, test.wantErr) {
+				t.Fatalf("GetByLabel() error = %v, want %v", err, test.wantErr)
+			}
+			if test.wantErr == nil && deploy.Name != "nginx" {
+				t.Errorf("GetByLabel() = %s, want nginx", deploy.Name)
+			}
+		})
+	}
+}
+
+func TestExists(t *testing.T) {
+	h := newTestHandler(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/apis/apps/v1/namespaces/test/deployments/exists":
+			fmt.Fprintln(w, `{"kind":"Deployment","apiVersion":"apps/v1","metadata":{"name":"exists","namespace":"test"}}`)
+		case "/apis/apps/v1/namespaces/test/deployments/forbidden":
+			w.WriteHeader(http.StatusForbidden)
+			fmt.Fprintln(w, `{"kind":"Status","apiVersion":"v1","status":"Failure","reason":"Forbidden","code":403}`)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			fmt.Fprintln(w, `{"kind":"Status","apiVersion":"v1","status":"Failure","reason":"NotFound","code":404}`)
+		}
+	})
+
+	tests := []struct {
+		name          string
+		wantExists    bool
+		wantForbidden bool
+	}{
+		{"exists", true, false},
+		{"missing", false, false},
+		{"forbidden", false, true},
+	}
+	for _, test := range tests {
+		exists, err := h.Exists(test.name)
+		if exists != test.wantExists || k8serrors.IsForbidden(err) != test.wantForbidden ||
+			(err != nil && !test.wantForbidden) {
+			t.Errorf("Exists(%q) = %v, %v, want %v and forbidden error %v",
+				test.name, exists, err, test.wantExists, test.wantForbidden)
+		}
+	}
+}
+
+func TestGetFromCache(t *testing.T) {
+	h := newTestHandler(t, func(w http.ResponseWriter, r *http.Request) {
+		t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+	})
+	h.informerFactory = informers.NewSharedInformerFactory(h.clientset, 0)
+	for _, namespace := range []string{"test", "other"} {
+		deploy := &appsv1.Deployment{ObjectMeta: metav1.ObjectMeta{Name: "nginx-" + namespace, Namespace: namespace}}
+		if err := h.Informer().GetIndexer().Add(deploy); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	deploy, err := h.GetFromCache("nginx-test")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if deploy.Name != "nginx-test" || deploy.Namespace != "test" {
+		t.Errorf("GetFromCache(nginx-test) = %s/%s, want test/nginx-test", deploy.Namespace, deploy.Name)
+	}
+	// the deployment in the other namespace is invisible to the handler.
+	if _, err := h.GetFromCache("nginx-other"); !k8serrors.IsNotFound(err) {
+		t.Errorf("GetFromCache(nginx-other) = %v, want NotFound", err)
+	}
+}
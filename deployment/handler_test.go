@@ -0,0 +1,102 @@
+package deployment
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/forbearing/k8s/types"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/rest"
+)
+
+// newTestHandler creates a deployment handler in namespace "test", whose
+// clientset sends the requests to the fake kubernetes API server serve.
+func newTestHandler(t *testing.T, serve http.HandlerFunc) *Handler {
+	srv := httptest.NewServer(serve)
+	t.Cleanup(srv.Close)
+	clientset, err := kubernetes.NewForConfig(&rest.Config{Host: srv.URL})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return &Handler{
+		ctx:       context.Background(),
+		namespace: "test",
+		clientset: clientset,
+		Options:   &types.HandlerOptions{},
+	}
+}
+
+// TestDeepCopyConcurrent should be run with -race, DeepCopy must not race
+// with the Set* methods modifying the handler options.
+func TestDeepCopyConcurrent(t *testing.T) {
+	h := &Handler{namespace: "test", Options: &types.HandlerOptions{}}
+
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(2)
+		go func(i int) {
+			defer wg.Done()
+			h.SetTimeout(int64(i))
+			h.SetLimit(int64(i))
+			h.SetForceDelete(true)
+		}(i)
+		go func() {
+			defer wg.Done()
+			if handler := h.WithNamespace("default"); handler.namespace != "default" {
+				t.Errorf("namespace = %q, want default", handler.namespace)
+			}
+			h.WithDryRun()
+		}()
+	}
+	wg.Wait()
+}
+
+// TestResetNamespaceConcurrent should be run with -race, ResetNamespace must
+// modify the namespace inside the critical section.
+func TestResetNamespaceConcurrent(t *testing.T) {
+	h := &Handler{namespace: "test", Options: &types.HandlerOptions{}}
+
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(2)
+		go func() {
+			defer wg.Done()
+			h.ResetNamespace("default")
+		}()
+		go func() {
+			defer wg.Done()
+			if ns := h.DeepCopy().namespace; ns != "test" && ns != "default" {
+				t.Errorf("namespace = %q, want test or default", ns)
+			}
+		}()
+	}
+	wg.Wait()
+}
+
+func TestWithContext(t *testing.T) {
+	h := newTestHandler(t, func(w http.ResponseWriter, r *http.Request) {
+		// a slow kubernetes API server.
+		select {
+		case <-r.Context().Done():
+		case <-time.After(5 * time.Second):
+		}
+	})
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	handler := h.WithContext(ctx)
+	if handler.clientset != h.clientset {
+		t.Error("the handler with context doesn't share the clientset")
+	}
+	if _, err := handler.Get("nginx"); !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("Get() = %v, want context.DeadlineExceeded", err)
+	}
+	if h.ctx.Err() != nil {
+		t.Error("the context of the original handler is changed")
+	}
+}
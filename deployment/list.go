@@ -1,11 +1,21 @@
 package deployment
 
 import (
+	"fmt"
+	"sort"
+
 	appsv1 "k8s.io/api/apps/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/fields"
 )
 
+// supportedFields is the fields kubernetes supports to select deployments.
+var supportedFields = map[string]bool{
+	"metadata.name":      true,
+	"metadata.namespace": true,
+}
+
 // List list all deployments in the k8s cluster, it simply call `ListAll`.
 func (h *Handler) List() ([]*appsv1.Deployment, error) {
 	return h.ListAll()
@@ -17,23 +27,85 @@ func (h *Handler) List() ([]*appsv1.Deployment, error) {
 func (h *Handler) ListByLabel(labels string) ([]*appsv1.Deployment, error) {
 	listOptions := h.Options.ListOptions.DeepCopy()
 	listOptions.LabelSelector = labels
-	deployList, err := h.clientset.AppsV1().Deployments(h.namespace).List(h.ctx, *listOptions)
+	deployList, err := h.list(*listOptions)
 	if err != nil {
 		return nil, err
 	}
 	return extractList(deployList), nil
 }
 
+// SortField is the field and the order to sort the deployments by.
+type SortField int
+
+const (
+	// SortByName sorts the deployments by name in ascending order.
+	SortByName SortField = iota
+	// SortByNameDesc sorts the deployments by name in descending order.
+	SortByNameDesc
+	// SortByCreationTimestamp sorts the deployments from the oldest to the newest.
+	SortByCreationTimestamp
+	// SortByCreationTimestampDesc sorts the deployments from the newest to the oldest.
+	SortByCreationTimestampDesc
+)
+
+// ListByLabelSorted works like ListByLabel, but the deployments are sorted by
+// the SortField. The sort is stable, the deployments with the same key are
+// kept in the order returned by kubernetes API server.
+// ListByLabel doesn't sort, use it if the order doesn't matter.
+func (h *Handler) ListByLabelSorted(labels string, by SortField) ([]*appsv1.Deployment, error) {
+	less, err := lessFunc(by)
+	if err != nil {
+		return nil, err
+	}
+	deployList, err := h.ListByLabel(labels)
+	if err != nil {
+		return nil, err
+	}
+	sort.SliceStable(deployList, func(i, j int) bool {
+		return less(deployList[i], deployList[j])
+	})
+	return deployList, nil
+}
+
+// lessFunc returns the function reporting whether a deployment sorts before
+// the other by the SortField.
+func lessFunc(by SortField) (func(a, b *appsv1.Deployment) bool, error) {
+	byName := func(a, b *appsv1.Deployment) bool {
+		return a.Name < b.Name
+	}
+	byCreationTimestamp := func(a, b *appsv1.Deployment) bool {
+		return a.CreationTimestamp.Before(&b.CreationTimestamp)
+	}
+	switch by {
+	case SortByName:
+		return byName, nil
+	case SortByNameDesc:
+		return func(a, b *appsv1.Deployment) bool { return byName(b, a) }, nil
+	case SortByCreationTimestamp:
+		return byCreationTimestamp, nil
+	case SortByCreationTimestampDesc:
+		return func(a, b *appsv1.Deployment) bool { return byCreationTimestamp(b, a) }, nil
+	}
+	return nil, fmt.Errorf("%w: %d", ErrInvalidSortField, by)
+}
+
 // ListByField list deployments by field, work like `kubectl get xxx --field-selector=xxx`.
+// Deployments only support the "metadata.name" and "metadata.namespace" field
+// selectors, other fields return ErrUnsupportedField.
 func (h *Handler) ListByField(field string) ([]*appsv1.Deployment, error) {
 	fieldSelector, err := fields.ParseSelector(field)
 	if err != nil {
 		return nil, err
 	}
+	for _, req := range fieldSelector.Requirements() {
+		if !supportedFields[req.Field] {
+			return nil, fmt.Errorf("%w: %q", ErrUnsupportedField, req.Field)
+		}
+	}
 	listOptions := h.Options.ListOptions.DeepCopy()
 	listOptions.FieldSelector = fieldSelector.String()
 
-	deployList, err := h.clientset.AppsV1().Deployments(h.namespace).List(h.ctx, *listOptions)
+	deployList, err := h.list(*listOptions)
 	if err != nil {
 		return nil, err
 	}
@@ -46,8 +118,67 @@ func (h *Handler) ListByNamespace(namespace string) ([]*appsv1.Deployment, error
 }
 
 // ListAll list all deployments in the k8s cluster.
+// It always follows the continue token to list all pages no matter whether
+// paginateAll is set, the limit set by SetLimit is the page size, so listing
+// a large cluster doesn't time out or load everything in a single response.
 func (h *Handler) ListAll() ([]*appsv1.Deployment, error) {
-	return h.WithNamespace(metav1.NamespaceAll).ListByLabel("")
+	listOptions := h.Options.ListOptions.DeepCopy()
+	ctx, done := h.instrument("List", metav1.NamespaceAll, "")
+	objList, err := paginate(*listOptions, true, func(listOptions metav1.ListOptions) (*appsv1.DeploymentList, error) {
+		return h.clientset.AppsV1().Deployments(metav1.NamespaceAll).List(ctx, listOptions)
+	})
+	done(err)
+	if err != nil {
+		return nil, err
+	}
+	return extractList(objList), nil
+}
+
+// list lists deployments with the listOptions, if paginateAll is true, it
+// follows the continue token to list all pages.
+func (h *Handler) list(listOptions metav1.ListOptions) (*appsv1.DeploymentList, error) {
+	h.l.RLock()
+	paginateAll := h.paginateAll
+	h.l.RUnlock()
+	ctx, done := h.instrument("List", h.namespace, "")
+	objList, err := paginate(listOptions, paginateAll, func(listOptions metav1.ListOptions) (*appsv1.DeploymentList, error) {
+		return h.clientset.AppsV1().Deployments(h.namespace).List(ctx, listOptions)
+	})
+	done(err)
+	return objList, err
+}
+
+// maxPaginateRestarts is the max times the listing restarts from the first
+// page after the continue token expired.
+const maxPaginateRestarts = 3
+
+// paginate calls listFunc to list the first page, if paginateAll is true,
+// it calls listFunc with the continue token until all pages are listed.
+// If the continue token expired(410 Gone), the listing restarts from the first
+// page, at most maxPaginateRestarts times, then the Expired error is returned.
+func paginate(listOptions metav1.ListOptions, paginateAll bool,
+	listFunc func(metav1.ListOptions) (*appsv1.DeploymentList, error)) (*appsv1.DeploymentList, error) {
+restart:
+	for restarts := 0; ; restarts++ {
+		listOptions.Continue = ""
+		objList, err := listFunc(listOptions)
+		if err != nil || !paginateAll {
+			return objList, err
+		}
+		for len(objList.Continue) != 0 {
+			listOptions.Continue = objList.Continue
+			next, err := listFunc(listOptions)
+			if k8serrors.IsResourceExpired(err) && restarts < maxPaginateRestarts {
+				continue restart
+			}
+			if err != nil {
+				return nil, err
+			}
+			objList.Items = append(objList.Items, next.Items...)
+			objList.Continue = next.Continue
+		}
+		return objList, nil
+	}
 }
 
 // extractList
@@ -0,0 +1,242 @@
+package deployment
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"reflect"
+	"strconv"
+	"testing"
+
+	appsv1 "k8s.io/api/apps/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestPaginate(t *testing.T) {
+	// fakeList returns 5 deployments in pages of listOptions.Limit, with a continue token.
+	var calls int
+	fakeList := func(listOptions metav1.ListOptions) (*appsv1.DeploymentList, error) {
+		calls++
+		start, _ := strconv.Atoi(listOptions.Continue)
+		end := start + int(listOptions.Limit)
+		objList := &appsv1.DeploymentList{}
+		for i := start; i < end && i < 5; i++ {
+			objList.Items = append(objList.Items, appsv1.Deployment{ObjectMeta: metav1.ObjectMeta{Name: strconv.Itoa(i)}})
+		}
+		if end < 5 {
+			objList.Continue = strconv.Itoa(end)
+		}
+		return objList, nil
+	}
+
+	tests := []struct {
+		name        string
+		paginateAll bool
+		wantItems   int
+		wantCalls   int
+	}{
+		{"limit caps the total", false, 2, 1},
+		{"limit is the page size", true, 5, 3},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			calls = 0
+			objList, err := paginate(metav1.ListOptions{Limit: 2}, test.paginateAll, fakeList)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(objList.Items) != test.wantItems || calls != test.wantCalls {
+				t.Errorf("got %d items in %d calls, want %d items in %d calls",
+					len(objList.Items), calls, test.wantItems, test.wantCalls)
+			}
+			for i, deploy := range objList.Items {
+				if deploy.Name != strconv.Itoa(i) {
+					t.Errorf("items[%d].Name = %s, want %d", i, deploy.Name, i)
+				}
+			}
+		})
+	}
+}
+
+func TestListPaginateModes(t *testing.T) {
+	// 3 deployments are listed in pages of listOptions.Limit with a continue token.
+	serve := func(w http.ResponseWriter, r *http.Request) {
+		query := r.URL.Query()
+		if query.Get("limit") != "1" {
+			t.Errorf("limit = %q, want 1", query.Get("limit"))
+		}
+		start, _ := strconv.Atoi(query.Get("continue"))
+		next := ""
+		if start+1 < 3 {
+			next = strconv.Itoa(start + 1)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprintf(w, `{"kind":"DeploymentList","apiVersion":"apps/v1","metadata":{"continue":%q},"items":[{"metadata":{"name":"%d","namespace":"test"}}]}`, next, start)
+	}
+	tests := []struct {
+		name        string
+		paginateAll bool
+		list        func(h *Handler) ([]*appsv1.Deployment, error)
+		wantItems   int
+	}{
+		{"List caps nothing", false, (*Handler).List, 3},
+		{"ListAll caps nothing", false, (*Handler).ListAll, 3},
+		{"ListByLabel capped by limit", false, func(h *Handler) ([]*appsv1.Deployment, error) { return h.ListByLabel("") }, 1},
+		{"List paginate all", true, (*Handler).List, 3},
+		{"ListAll paginate all", true, (*Handler).ListAll, 3},
+		{"ListByLabel paginate all", true, func(h *Handler) ([]*appsv1.Deployment, error) { return h.ListByLabel("") }, 3},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			h := newTestHandler(t, serve)
+			h.SetLimit(1)
+			h.SetPaginateAll(test.paginateAll)
+			objs, err := test.list(h)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(objs) != test.wantItems {
+				t.Errorf("got %d deployments, want %d", len(objs), test.wantItems)
+			}
+		})
+	}
+}
+
+func TestPaginateExpired(t *testing.T) {
+	// the continue token of the first page always expires.
+	var calls int
+	fakeList := func(listOptions metav1.ListOptions) (*appsv1.DeploymentList, error) {
+		calls++
+		if len(listOptions.Continue) != 0 {
+			return nil, k8serrors.NewResourceExpired("the continue token is expired")
+		}
+		return &appsv1.DeploymentList{ListMeta: metav1.ListMeta{Continue: "page2"}, Items: []appsv1.Deployment{{}}}, nil
+	}
+
+	objList, err := paginate(metav1.ListOptions{Limit: 1}, true, fakeList)
+	if !k8serrors.IsResourceExpired(err) {
+		t.Fatalf("paginate() = %v, %v, want Expired error", objList, err)
+	}
+	// the first listing and maxPaginateRestarts restarts, two calls each.
+	if want := 2 * (maxPaginateRestarts + 1); calls != want {
+		t.Errorf("got %d calls, want %d", calls, want)
+	}
+}
+func TestListByField(t *testing.T) {
+	var fieldSelector string
+	h := newTestHandler(t, func(w http.ResponseWriter, r *http.Request) {
+		fieldSelector = r.URL.Query().Get("fieldSelector")
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprintln(w, `{"kind":"DeploymentList","apiVersion":"apps/v1","metadata":{},"items":[{"metadata":{"name":"nginx","namespace":"test"}}]}`)
+	})
+
+	deploys, err := h.ListByField("metadata.name=nginx")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if fieldSelector != "metadata.name=nginx" {
+		t.Errorf("fieldSelector = %q, want metadata.name=nginx", fieldSelector)
+	}
+	if len(deploys) != 1 || deploys[0].Name != "nginx" {
+		t.Errorf("ListByField() = %v, want [nginx]", deploys)
+	}
+
+	fieldSelector = ""
+	for _, field := range []string{"metadata.name", "spec.replicas=1", "metadata.name=nginx,status.phase!=Running"} {
+		if _, err := h.ListByField(field); err == nil {
+			t.Errorf("ListByField(%q) expected an error", field)
+		}
+	}
+	if _, err := h.ListByField("spec.replicas=1"); !errors.Is(err, ErrUnsupportedField) {
+		t.Errorf("ListByField(spec.replicas=1) = %v, want ErrUnsupportedField", err)
+	}
+	if fieldSelector != "" {
+		t.Errorf("invalid field selector %q is sent to the server", fieldSelector)
+	}
+}
+
+func TestListAll(t *testing.T) {
+	// deployments are listed in two pages, the first continue token expires,
+	// so the listing restarts from the first page.
+	var continues []string
+	h := newTestHandler(t, func(w http.ResponseWriter, r *http.Request) {
+		query := r.URL.Query()
+		if r.URL.Path != "/apis/apps/v1/deployments" {
+			t.Errorf("path = %s, want deployments in all namespaces", r.URL.Path)
+		}
+		if query.Get("limit") != "1" {
+			t.Errorf("limit = %q, want 1", query.Get("limit"))
+		}
+		continues = append(continues, query.Get("continue"))
+		w.Header().Set("Content-Type", "application/json")
+		switch query.Get("continue") {
+		case "":
+			next := "expired"
+			if len(continues) > 1 {
+				next = "page2"
+			}
+			fmt.Fprintf(w, `{"kind":"DeploymentList","apiVersion":"apps/v1","metadata":{"continue":%q},"items":[{"metadata":{"name":"a"}}]}`, next)
+		case "expired":
+			w.WriteHeader(http.StatusGone)
+			fmt.Fprintln(w, `{"kind":"Status","apiVersion":"v1","status":"Failure","reason":"Expired","code":410}`)
+		case "page2":
+			fmt.Fprintln(w, `{"kind":"DeploymentList","apiVersion":"apps/v1","metadata":{},"items":[{"metadata":{"name":"b"}}]}`)
+		}
+	})
+	h.SetLimit(1)
+
+	deploys, err := h.ListAll()
+	if err != nil {
+		t.Fatal(err)
+	}
+	var names []string
+	for _, deploy := range deploys {
+		names = append(names, deploy.Name)
+	}
+	if want := []string{"a", "b"}; !reflect.DeepEqual(names, want) {
+		t.Errorf("ListAll() = %v, want %v", names, want)
+	}
+	if want := []string{"", "expired", "", "page2"}; !reflect.DeepEqual(continues, want) {
+		t.Errorf("continue tokens = %q, want %q", continues, want)
+	}
+}
+
+func TestListByLabelSorted(t *testing.T) {
+	h := newTestHandler(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprintln(w, `{"kind":"DeploymentList","apiVersion":"apps/v1","metadata":{},"items":[
+{"metadata":{"name":"web","namespace":"b","creationTimestamp":"2022-01-02T00:00:00Z"}},
+{"metadata":{"name":"web","namespace":"a","creationTimestamp":"2022-01-01T00:00:00Z"}},
+{"metadata":{"name":"api","namespace":"a","creationTimestamp":"2022-01-02T00:00:00Z"}},
+{"metadata":{"name":"db","namespace":"c","creationTimestamp":"2022-01-03T00:00:00Z"}}]}`)
+	})
+
+	// the deployments with the same name or creationTimestamp are kept in the server order.
+	tests := []struct {
+		by   SortField
+		want []string
+	}{
+		{SortByName, []string{"a/api", "c/db", "b/web", "a/web"}},
+		{SortByNameDesc, []string{"b/web", "a/web", "c/db", "a/api"}},
+		{SortByCreationTimestamp, []string{"a/web", "b/web", "a/api", "c/db"}},
+		{SortByCreationTimestampDesc, []string{"c/db", "b/web", "a/api", "a/web"}},
+	}
+	for _, test := range tests {
+		deploys, err := h.ListByLabelSorted("app=nginx", test.by)
+		if err != nil {
+			t.Fatal(err)
+		}
+		var got []string
+		for _, deploy := range deploys {
+			got = append(got, deploy.Namespace+"/"+deploy.Name)
+		}
+		if !reflect.DeepEqual(got, test.want) {
+			t.Errorf("ListByLabelSorted(%d) = %v, want %v", test.by, got, test.want)
+		}
+	}
+
+	if _, err := h.ListByLabelSorted("", SortField(10)); !errors.Is(err, ErrInvalidSortField) {
+		t.Errorf("ListByLabelSorted(10) = %v, want ErrInvalidSortField", err)
+	}
+}
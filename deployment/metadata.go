@@ -0,0 +1,49 @@
+package deployment
+
+import (
+	"encoding/json"
+
+	"k8s.io/apimachinery/pkg/types"
+)
+
+// AddLabel adds the label to the deployment, the value is replaced if the
+// deployment already has the label key. The other labels are kept.
+func (h *Handler) AddLabel(name, key, value string) error {
+	return h.patchMetadata(name, "labels", key, value)
+}
+
+// RemoveLabel removes the label key from the deployment, the other labels are
+// kept. It's a no-op if the deployment doesn't have the label key.
+func (h *Handler) RemoveLabel(name, key string) error {
+	return h.patchMetadata(name, "labels", key, nil)
+}
+
+// AddAnnotation adds the annotation to the deployment, the value is replaced if
+// the deployment already has the annotation key. The other annotations are kept.
+func (h *Handler) AddAnnotation(name, key, value string) error {
+	return h.patchMetadata(name, "annotations", key, value)
+}
+
+// RemoveAnnotation removes the annotation key from the deployment, the other
+// annotations are kept. It's a no-op if the deployment doesn't have the annotation key.
+func (h *Handler) RemoveAnnotation(name, key string) error {
+	return h.patchMetadata(name, "annotations", key, nil)
+}
+
+// patchMetadata patches the key of the deployment metadata.labels or
+// metadata.annotations with the "Strategic Merge Patch" patch type, the maps
+// are merged, so only the key is changed. A nil value is marshaled to null,
+// which removes the key.
+func (h *Handler) patchMetadata(name, field, key string, value interface{}) error {
+	patchData, err := json.Marshal(map[string]interface{}{
+		"metadata": map[string]interface{}{
+			field: map[string]interface{}{key: value},
+		},
+	})
+	if err != nil {
+		return err
+	}
+	_, err = h.clientset.AppsV1().Deployments(h.namespace).
+		Patch(h.ctx, name, types.StrategicMergePatchType, patchData, h.Options.PatchOptions)
+	return err
+}
@@ -0,0 +1,86 @@
+package deployment
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"reflect"
+	"testing"
+
+	appsv1 "k8s.io/api/apps/v1"
+	"k8s.io/apimachinery/pkg/util/strategicpatch"
+)
+
+func TestPatchMetadata(t *testing.T) {
+	tests := []struct {
+		name            string
+		patch           func(h *Handler) error
+		wantLabels      map[string]string
+		wantAnnotations map[string]string
+	}{
+		{
+			"add label",
+			func(h *Handler) error { return h.AddLabel("nginx", "tier", "frontend") },
+			map[string]string{"app": "nginx", "env": "prod", "tier": "frontend"},
+			map[string]string{"owner": "devops", "note": "keep"},
+		},
+		{
+			"replace label",
+			func(h *Handler) error { return h.AddLabel("nginx", "env", "dev") },
+			map[string]string{"app": "nginx", "env": "dev"},
+			map[string]string{"owner": "devops", "note": "keep"},
+		},
+		{
+			"remove label",
+			func(h *Handler) error { return h.RemoveLabel("nginx", "env") },
+			map[string]string{"app": "nginx"},
+			map[string]string{"owner": "devops", "note": "keep"},
+		},
+		{
+			"add annotation",
+			func(h *Handler) error { return h.AddAnnotation("nginx", "team", "sre") },
+			map[string]string{"app": "nginx", "env": "prod"},
+			map[string]string{"owner": "devops", "note": "keep", "team": "sre"},
+		},
+		{
+			"remove annotation",
+			func(h *Handler) error { return h.RemoveAnnotation("nginx", "owner") },
+			map[string]string{"app": "nginx", "env": "prod"},
+			map[string]string{"note": "keep"},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			deployData := []byte(`{"kind":"Deployment","apiVersion":"apps/v1","metadata":{"name":"nginx","namespace":"test",` +
+				`"labels":{"app":"nginx","env":"prod"},"annotations":{"owner":"devops","note":"keep"}}}`)
+			// the fake kubernetes API server applies the strategic merge patch to the deployment.
+			h := newTestHandler(t, func(w http.ResponseWriter, r *http.Request) {
+				if r.Method != http.MethodPatch || r.URL.Path != "/apis/apps/v1/namespaces/test/deployments/nginx" {
+					t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+				}
+				patchData, _ := io.ReadAll(r.Body)
+				patched, err := strategicpatch.StrategicMergePatch(deployData, patchData, appsv1.Deployment{})
+				if err != nil {
+					t.Error(err)
+				}
+				deployData = patched
+				w.Header().Set("Content-Type", "application/json")
+				w.Write(deployData)
+			})
+
+			if err := test.patch(h); err != nil {
+				t.Fatal(err)
+			}
+			deploy := &appsv1.Deployment{}
+			if err := json.Unmarshal(deployData, deploy); err != nil {
+				t.Fatal(err)
+			}
+			if !reflect.DeepEqual(deploy.Labels, test.wantLabels) {
+				t.Errorf("labels = %v, want %v", deploy.Labels, test.wantLabels)
+			}
+			if !reflect.DeepEqual(deploy.Annotations, test.wantAnnotations) {
+				t.Errorf("annotations = %v, want %v", deploy.Annotations, test.wantAnnotations)
+			}
+		})
+	}
+}
@@ -0,0 +1,14 @@
+package deployment
+
+import (
+	"github.com/forbearing/k8s/types"
+)
+
+// SetMetricsRecorder sets the recorder observing the latency and the result
+// of the create, get, update, delete and list operations, nil disables it.
+// It works like WithMetricsRecorder.
+func (h *Handler) SetMetricsRecorder(metrics types.MetricsRecorder) {
+	h.l.Lock()
+	defer h.l.Unlock()
+	h.metrics = metrics
+}
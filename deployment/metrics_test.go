@@ -0,0 +1,69 @@
+package deployment
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"sync"
+	"testing"
+	"time"
+
+	appsv1 "k8s.io/api/apps/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// observation is an operation observed by fakeRecorder.
+type observation struct {
+	verb, resource string
+	err            error
+}
+
+// fakeRecorder is a types.MetricsRecorder recording the observations.
+type fakeRecorder struct {
+	mu           sync.Mutex
+	observations []observation
+}
+
+func (r *fakeRecorder) Observe(verb, resource string, dur time.Duration, err error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.observations = append(r.observations, observation{verb, resource, err})
+}
+
+func TestMetricsRecorder(t *testing.T) {
+	h := newTestHandler(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if r.Method == http.MethodPost {
+			data, _ := io.ReadAll(r.Body)
+			w.WriteHeader(http.StatusCreated)
+			w.Write(data)
+			return
+		}
+		w.WriteHeader(http.StatusNotFound)
+		fmt.Fprintln(w, `{"kind":"Status","apiVersion":"v1","status":"Failure","reason":"NotFound","code":404}`)
+	})
+
+	// no MetricsRecorder is set.
+	deploy := &appsv1.Deployment{ObjectMeta: metav1.ObjectMeta{Name: "nginx"}}
+	if _, err := h.Create(deploy); err != nil {
+		t.Fatal(err)
+	}
+
+	recorder := &fakeRecorder{}
+	h.SetMetricsRecorder(recorder)
+	if _, err := h.Create(deploy); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := h.Get("nginx"); err == nil {
+		t.Fatal("expected Get to fail")
+	}
+	if len(recorder.observations) != 2 {
+		t.Fatalf("observations = %v, want a create and a get", recorder.observations)
+	}
+	if o := recorder.observations[0]; o.verb != "create" || o.resource != "deployments" || o.err != nil {
+		t.Errorf("observation = %+v, want a succeeded create of deployments", o)
+	}
+	if o := recorder.observations[1]; o.verb != "get" || o.resource != "deployments" || o.err == nil {
+		t.Errorf("observation = %+v, want a failed get of deployments", o)
+	}
+}
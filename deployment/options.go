@@ -0,0 +1,110 @@
+package deployment
+
+import (
+	"time"
+
+	"github.com/forbearing/k8s/types"
+	"go.opentelemetry.io/otel/trace"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/rest"
+	"k8s.io/client-go/util/flowcontrol"
+)
+
+// Option configures the deployment handler created by New.
+type Option func(*options)
+
+// options is the configuration of New, the options are applied before
+// building the clients and the informer factory.
+type options struct {
+	resyncPeriod   time.Duration
+	userAgent      string
+	informerScope  string
+	qps            float32
+	burst          int
+	rateLimiter    flowcontrol.RateLimiter
+	metrics        types.MetricsRecorder
+	tracerProvider trace.TracerProvider
+}
+
+// newOptions returns the default options with the opts applied.
+func newOptions(opts ...Option) *options {
+	o := &options{informerScope: metav1.NamespaceAll}
+	for _, opt := range opts {
+		opt(o)
+	}
+	return o
+}
+
+// applyRateLimit sets the client-side rate limit of the config, the client-go
+// defaults are kept if neither WithQPS nor WithRateLimiter is set.
+func (o *options) applyRateLimit(config *rest.Config) {
+	if o.qps > 0 {
+		config.QPS = o.qps
+		config.Burst = o.burst
+	}
+	if o.rateLimiter != nil {
+		config.RateLimiter = o.rateLimiter
+	}
+}
+
+// WithResyncPeriod sets the resync period of the informer factory, zero(default)
+// means never resync, it works like SetInformerFactoryResyncPeriod.
+func WithResyncPeriod(resyncPeriod time.Duration) Option {
+	return func(o *options) {
+		o.resyncPeriod = resyncPeriod
+	}
+}
+
+// WithUserAgent sets the User-Agent header sent to kubernetes API server,
+// default to rest.DefaultKubernetesUserAgent().
+func WithUserAgent(userAgent string) Option {
+	return func(o *options) {
+		o.userAgent = userAgent
+	}
+}
+
+// WithInformerNamespace limits the scope of informer list-and-watch deployments
+// to the namespace, informer list-and-watch deployments in all namespaces by default.
+// It works like SetInformerFactoryNamespace.
+func WithInformerNamespace(namespace string) Option {
+	return func(o *options) {
+		o.informerScope = namespace
+	}
+}
+
+// WithQPS sets the maximum QPS and the maximum burst of the requests sent to
+// kubernetes API server, client-go defaults to 5 QPS and 10 burst.
+// It's ignored if WithRateLimiter is set.
+func WithQPS(qps float32, burst int) Option {
+	return func(o *options) {
+		o.qps = qps
+		o.burst = burst
+	}
+}
+
+// WithRateLimiter sets the rate limiter of the requests sent to kubernetes API
+// server, such as flowcontrol.NewTokenBucketRateLimiter, it takes precedence
+// over WithQPS.
+func WithRateLimiter(rateLimiter flowcontrol.RateLimiter) Option {
+	return func(o *options) {
+		o.rateLimiter = rateLimiter
+	}
+}
+
+// WithMetricsRecorder sets the recorder observing the latency and the result
+// of the create, get, update, delete and list operations, no metrics are
+// recorded by default. It works like SetMetricsRecorder.
+func WithMetricsRecorder(metrics types.MetricsRecorder) Option {
+	return func(o *options) {
+		o.metrics = metrics
+	}
+}
+
+// WithTracerProvider sets the provider of the tracer creating a span for each
+// create, get, update, delete and list operation, nothing is traced by default.
+// It works like SetTracerProvider.
+func WithTracerProvider(tracerProvider trace.TracerProvider) Option {
+	return func(o *options) {
+		o.tracerProvider = tracerProvider
+	}
+}
@@ -0,0 +1,110 @@
+package deployment
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"k8s.io/client-go/util/flowcontrol"
+)
+
+// writeKubeconfig writes a kubeconfig pointing at the server, and returns its path.
+func writeKubeconfig(t *testing.T, server string) string {
+	kubeconfig := filepath.Join(t.TempDir(), "kubeconfig")
+	data := fmt.Sprintf(`apiVersion: v1
+kind: Config
+clusters:
+- name: test
+  cluster:
+    server: %s
+contexts:
+- name: test
+  context:
+    cluster: test
+current-context: test
+`, server)
+	if err := os.WriteFile(kubeconfig, []byte(data), 0600); err != nil {
+		t.Fatal(err)
+	}
+	return kubeconfig
+}
+
+func TestNewWithOptions(t *testing.T) {
+	var userAgent, listPath atomic.Value
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		userAgent.Store(r.UserAgent())
+		w.Header().Set("Content-Type", "application/json")
+		if r.URL.Query().Get("watch") == "true" {
+			// keep the watch connection open until the client goes away.
+			w.(http.Flusher).Flush()
+			<-r.Context().Done()
+			return
+		}
+		listPath.Store(r.URL.Path)
+		fmt.Fprintln(w, `{"kind":"DeploymentList","apiVersion":"apps/v1","metadata":{"resourceVersion":"1"},"items":[{"metadata":{"name":"nginx","namespace":"foo"}}]}`)
+	}))
+	defer srv.Close()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	h, err := New(ctx, writeKubeconfig(t, srv.URL), "default",
+		WithUserAgent("my-agent"), WithResyncPeriod(100*time.Millisecond), WithInformerNamespace("foo"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if h.config.UserAgent != "my-agent" {
+		t.Errorf("rest.Config UserAgent = %q, want my-agent", h.config.UserAgent)
+	}
+	if h.resyncPeriod != 100*time.Millisecond || h.informerScope != "foo" {
+		t.Errorf("resyncPeriod = %v, informerScope = %q", h.resyncPeriod, h.informerScope)
+	}
+
+	// the informer only list-and-watch namespace foo, and resyncs the
+	// deployment periodically, which is delivered as an update event.
+	resynced := make(chan struct{})
+	var once bool
+	h.RunInformer(ctx.Done(), func(interface{}) {}, func(_, _ interface{}) {
+		if !once {
+			once = true
+			close(resynced)
+		}
+	}, func(interface{}) {})
+	select {
+	case <-resynced:
+	case <-time.After(10 * time.Second):
+		t.Fatal("the informer doesn't resync")
+	}
+	if path := listPath.Load(); path != "/apis/apps/v1/namespaces/foo/deployments" {
+		t.Errorf("informer lists %v, want deployments in namespace foo", path)
+	}
+	if ua := userAgent.Load(); ua != "my-agent" {
+		t.Errorf("User-Agent = %v, want my-agent", ua)
+	}
+}
+
+func TestNewWithRateLimit(t *testing.T) {
+	kubeconfig := writeKubeconfig(t, "https://127.0.0.1:6443")
+
+	h, err := New(context.Background(), kubeconfig, "default", WithQPS(50, 100))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if h.config.QPS != 50 || h.config.Burst != 100 {
+		t.Errorf("rest.Config QPS = %v, Burst = %d, want 50 and 100", h.config.QPS, h.config.Burst)
+	}
+
+	rateLimiter := flowcontrol.NewTokenBucketRateLimiter(20, 40)
+	h, err = New(context.Background(), kubeconfig, "default", WithRateLimiter(rateLimiter))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if h.config.RateLimiter != rateLimiter {
+		t.Error("rest.Config doesn't carry the rate limiter")
+	}
+}
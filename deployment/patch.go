@@ -2,16 +2,20 @@ package deployment
 
 import (
 	"encoding/json"
-	"errors"
+	"fmt"
 	"os"
+	"strings"
 
+	jsonpatch "github.com/evanphx/json-patch"
 	appsv1 "k8s.io/api/apps/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/util/strategicpatch"
 	"k8s.io/apimachinery/pkg/util/yaml"
+	sigsyaml "sigs.k8s.io/yaml"
 )
 
 /*
@@ -113,7 +117,7 @@ func (h *Handler) Patch(original *appsv1.Deployment, patch interface{}, patchOpt
 	case metav1.Object, runtime.Object:
 		modified, ok := patch.(*appsv1.Deployment)
 		if !ok {
-			return nil, errors.New("patch data type is not *appsv1.Deployment")
+			return nil, ErrInvalidObjectType
 		}
 		return h.diffMergePatch(original, modified, patchOptions...)
 
@@ -191,6 +195,60 @@ func (h *Handler) jsonPatch(original *appsv1.Deployment, patchData []byte) (*app
 // You can set patchOptions to MergePatchType to use the "JSON Merge Patch" to
 // patch deployment.
 func (h *Handler) diffMergePatch(original, modified *appsv1.Deployment, patchOptions ...types.PatchType) (*appsv1.Deployment, error) {
+	patchData, err := createPatch(original, modified, patchOptions...)
+	if err != nil {
+		return nil, err
+	}
+	if len(patchData) == 0 {
+		return original, nil
+	}
+
+	namespace := original.GetNamespace()
+	if len(namespace) == 0 {
+		namespace = h.namespace
+	}
+	if len(patchOptions) != 0 && patchOptions[0] == types.MergePatchType {
+		return h.clientset.AppsV1().Deployments(namespace).
+			Patch(h.ctx, original.Name, types.MergePatchType, patchData, h.Options.PatchOptions)
+	}
+	return h.clientset.AppsV1().Deployments(namespace).
+		Patch(h.ctx, original.Name, types.StrategicMergePatchType, patchData, h.Options.PatchOptions)
+}
+
+// Diff returns the strategic merge patch from the original deployment to the
+// modified deployment, without sending it to kubernetes API server, it's the
+// patch that Patch(original, modified) would send. It's handy to preview the
+// changes, such as in dry-run tooling. The result is empty if the original
+// and modified deployment are identical.
+func (h *Handler) Diff(original, modified *appsv1.Deployment) ([]byte, error) {
+	return createPatch(original, modified)
+}
+
+// DiffLive gets the live deployment with the same namespace and name as the
+// modified deployment, and returns the strategic merge patch from the live
+// deployment to the modified deployment, it works like a client-side
+// `kubectl diff`. The fields absent from the modified deployment, such as
+// status, are deleted in the patch, so modify the deployment got from
+// kubernetes API server to see only the fields you changed.
+//
+// If the deployment doesn't exist, the whole modified deployment is returned
+// as an addition.
+func (h *Handler) DiffLive(modified *appsv1.Deployment) ([]byte, error) {
+	live, err := h.getDeployment(modified)
+	if k8serrors.IsNotFound(err) {
+		return json.Marshal(modified)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return createPatch(live, modified)
+}
+
+// createPatch creates the patch data from the original deployment to the
+// modified deployment, it's a "JSON Merge Patch" if the patch type is
+// types.MergePatchType, otherwise it's a "Strategic Merge Patch".
+// The patch data is empty if nothing is changed.
+func createPatch(original, modified *appsv1.Deployment, patchOptions ...types.PatchType) ([]byte, error) {
 	var (
 		err          error
 		originalJson []byte
@@ -204,21 +262,98 @@ func (h *Handler) diffMergePatch(original, modified *appsv1.Deployment, patchOpt
 	if modifiedJson, err = json.Marshal(modified); err != nil {
 		return nil, err
 	}
-	if patchData, err = strategicpatch.CreateTwoWayMergePatch(originalJson, modifiedJson, appsv1.Deployment{}); err != nil {
+	// the patch data of "JSON Merge Patch" must contain the entire new list,
+	// the patch data created by strategic merge patch only contains the
+	// changed list items, which would drop the other list items.
+	if len(patchOptions) != 0 && patchOptions[0] == types.MergePatchType {
+		patchData, err = jsonpatch.CreateMergePatch(originalJson, modifiedJson)
+	} else {
+		patchData, err = strategicpatch.CreateTwoWayMergePatch(originalJson, modifiedJson, appsv1.Deployment{})
+	}
+	if err != nil {
 		return nil, err
 	}
-	if len(patchData) == 0 || string(patchData) == "{}" {
-		return original, nil
+	if string(patchData) == "{}" {
+		return nil, nil
 	}
+	return patchData, nil
+}
 
-	namespace := original.GetNamespace()
-	if len(namespace) == 0 {
-		namespace = h.namespace
+// ReplaceList replaces the list at the path of the deployment with the items,
+// instead of merging them with the existing list, eg:
+//     ReplaceList("mydep", "spec.template.spec.containers", []corev1.Container{...})
+//
+// A strategic merge patch merges the list by the patch merge key(such as the
+// container name) by default, ReplaceList appends the strategic merge directive
+// "$patch: replace" to the list, so the new list completely replaces the existing list.
+// The items must be a list of object, such as containers, volumes or env.
+func (h *Handler) ReplaceList(name, path string, items interface{}) (*appsv1.Deployment, error) {
+	patchData, err := replaceListPatch(path, items)
+	if err != nil {
+		return nil, err
 	}
-	if len(patchOptions) != 0 && patchOptions[0] == types.MergePatchType {
-		return h.clientset.AppsV1().Deployments(namespace).
-			Patch(h.ctx, original.Name, types.MergePatchType, patchData, h.Options.PatchOptions)
+	return h.clientset.AppsV1().Deployments(h.namespace).
+		Patch(h.ctx, name, types.StrategicMergePatchType, patchData, h.Options.PatchOptions)
+}
+
+// replaceListPatch constructs the strategic merge patch with the "$patch: replace"
+// directive for the list at the path.
+func replaceListPatch(path string, items interface{}) ([]byte, error) {
+	itemsJson, err := json.Marshal(items)
+	if err != nil {
+		return nil, err
 	}
-	return h.clientset.AppsV1().Deployments(namespace).
-		Patch(h.ctx, original.Name, types.StrategicMergePatchType, patchData, h.Options.PatchOptions)
+	list := []interface{}{}
+	if err := json.Unmarshal(itemsJson, &list); err != nil {
+		return nil, fmt.Errorf("items must be a list: %w", err)
+	}
+	var patch interface{} = append(list, map[string]interface{}{"$patch": "replace"})
+
+	fields := strings.Split(path, ".")
+	for i := len(fields) - 1; i >= 0; i-- {
+		if len(fields[i]) == 0 {
+			return nil, fmt.Errorf("invalid path %q", path)
+		}
+		patch = map[string]interface{}{fields[i]: patch}
+	}
+	return json.Marshal(patch)
+}
+
+// EditApply works like `kubectl edit deployment name`, it gets the deployment
+// as yaml, passes it to the edit function, and patches the deployment with
+// the minimal JSON merge patch computed from the difference between the
+// current and the edited deployment. It returns the updated deployment.
+//
+// The yaml passed to the edit function has the apiVersion and kind, they can't
+// be changed. Like the JSON merge patch, the edited lists, such as containers,
+// replace the current lists entirely instead of being merged by key.
+// If the edit function returns the yaml unchanged, no patch will be sent.
+func (h *Handler) EditApply(name string, edit func(current []byte) ([]byte, error)) (*appsv1.Deployment, error) {
+	original, err := h.Get(name)
+	if err != nil {
+		return nil, err
+	}
+	// the object got from kubernetes API server has no TypeMeta.
+	original.SetGroupVersionKind(GVK)
+	current, err := sigsyaml.Marshal(original)
+	if err != nil {
+		return nil, err
+	}
+	edited, err := edit(current)
+	if err != nil {
+		return nil, err
+	}
+	editedJson, err := yaml.ToJSON(edited)
+	if err != nil {
+		return nil, err
+	}
+	modified := &appsv1.Deployment{}
+	if err := json.Unmarshal(editedJson, modified); err != nil {
+		return nil, err
+	}
+	if modified.Name != original.Name {
+		return nil, fmt.Errorf("the name of deployment/%s can't be changed to %q", original.Name, modified.Name)
+	}
+	modified.SetGroupVersionKind(GVK)
+	return h.diffMergePatch(original, modified, types.MergePatchType)
 }
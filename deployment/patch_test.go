@@ -0,0 +1,192 @@
+package deployment
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"reflect"
+	"strings"
+	"testing"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/util/strategicpatch"
+)
+
+func TestReplaceListPatch(t *testing.T) {
+	original := appsv1.Deployment{}
+	original.Spec.Template.Spec.Containers = []corev1.Container{
+		{Name: "nginx", Image: "nginx"},
+		{Name: "sidecar", Image: "busybox"},
+	}
+	containers := []corev1.Container{{Name: "redis", Image: "redis"}}
+
+	patchData, err := replaceListPatch("spec.template.spec.containers", containers)
+	if err != nil {
+		t.Fatal(err)
+	}
+	originalJson, err := json.Marshal(original)
+	if err != nil {
+		t.Fatal(err)
+	}
+	patchedJson, err := strategicpatch.StrategicMergePatch(originalJson, patchData, appsv1.Deployment{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	patched := appsv1.Deployment{}
+	if err := json.Unmarshal(patchedJson, &patched); err != nil {
+		t.Fatal(err)
+	}
+
+	// the containers list is replaced, not merged by container name.
+	got := patched.Spec.Template.Spec.Containers
+	if len(got) != 1 || got[0].Name != "redis" || got[0].Image != "redis" {
+		t.Errorf("containers = %+v, want %+v", got, containers)
+	}
+
+	if _, err := replaceListPatch("spec..containers", containers); err == nil {
+		t.Error("expected error for invalid path")
+	}
+	if _, err := replaceListPatch("spec.template.spec.containers", "nginx"); err == nil {
+		t.Error("expected error for non-list items")
+	}
+}
+
+func TestDiff(t *testing.T) {
+	h := &Handler{}
+	original := &appsv1.Deployment{}
+	original.Name = "nginx"
+	original.Spec.Replicas = new(int32)
+	*original.Spec.Replicas = 1
+	original.Spec.Template.Spec.Containers = []corev1.Container{{Name: "nginx", Image: "nginx"}}
+
+	patchData, err := h.Diff(original, original.DeepCopy())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(patchData) != 0 {
+		t.Errorf("Diff() of identical deployments = %s, want empty", patchData)
+	}
+
+	modified := original.DeepCopy()
+	*modified.Spec.Replicas = 3
+	if patchData, err = h.Diff(original, modified); err != nil {
+		t.Fatal(err)
+	}
+	if want := `{"spec":{"replicas":3}}`; string(patchData) != want {
+		t.Errorf("Diff() = %s, want %s", patchData, want)
+	}
+}
+
+func TestDiffLive(t *testing.T) {
+	live := &appsv1.Deployment{}
+	live.Name = "nginx"
+	live.Namespace = "test"
+	live.ResourceVersion = "1"
+	live.Spec.Replicas = new(int32)
+	*live.Spec.Replicas = 1
+	live.Status.Replicas = 1
+
+	tests := []struct {
+		name   string
+		exists bool
+		want   string
+	}{
+		{"existing", true, `{"spec":{"replicas":3}}`},
+		{"non-existing", false, ""},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			h := newTestHandler(t, func(w http.ResponseWriter, r *http.Request) {
+				if r.Method != http.MethodGet || r.URL.Path != "/apis/apps/v1/namespaces/test/deployments/nginx" {
+					t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+				}
+				w.Header().Set("Content-Type", "application/json")
+				if !test.exists {
+					w.WriteHeader(http.StatusNotFound)
+					fmt.Fprintln(w, `{"kind":"Status","apiVersion":"v1","status":"Failure","reason":"NotFound","code":404}`)
+					return
+				}
+				json.NewEncoder(w).Encode(live)
+			})
+			modified := live.DeepCopy()
+			*modified.Spec.Replicas = 3
+
+			patchData, err := h.DiffLive(modified)
+			if err != nil {
+				t.Fatal(err)
+			}
+			want := test.want
+			if !test.exists {
+				// the whole deployment is an addition.
+				data, _ := json.Marshal(modified)
+				want = string(data)
+			}
+			if string(patchData) != want {
+				t.Errorf("DiffLive() = %s, want %s", patchData, want)
+			}
+		})
+	}
+}
+
+func TestEditApply(t *testing.T) {
+	tests := []struct {
+		name      string
+		edit      func(current []byte) ([]byte, error)
+		wantPatch string
+		wantErr   bool
+	}{
+		{"scale", func(current []byte) ([]byte, error) {
+			return bytes.Replace(current, []byte("replicas: 1"), []byte("replicas: 3"), 1), nil
+		}, `{"spec":{"replicas":3}}`, false},
+		{"unchanged", func(current []byte) ([]byte, error) { return current, nil }, "", false},
+		{"rename", func(current []byte) ([]byte, error) {
+			return bytes.Replace(current, []byte("name: nginx\n  namespace"), []byte("name: redis\n  namespace"), 1), nil
+		}, "", true},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var patches []string
+			h := newTestHandler(t, func(w http.ResponseWriter, r *http.Request) {
+				if r.URL.Path != "/apis/apps/v1/namespaces/test/deployments/nginx" {
+					t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+				}
+				switch r.Method {
+				case http.MethodGet:
+				case http.MethodPatch:
+					if contentType := r.Header.Get("Content-Type"); contentType != "application/merge-patch+json" {
+						t.Errorf("Content-Type = %q, want JSON merge patch", contentType)
+					}
+					data, _ := io.ReadAll(r.Body)
+					patches = append(patches, string(data))
+				default:
+					t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+				}
+				// the apiVersion and kind are dropped by the clientset.
+				w.Header().Set("Content-Type", "application/json")
+				fmt.Fprintln(w, `{"kind":"Deployment","apiVersion":"apps/v1","metadata":{"name":"nginx","namespace":"test","resourceVersion":"1"},"spec":{"replicas":1}}`)
+			})
+
+			_, err := h.EditApply("nginx", func(current []byte) ([]byte, error) {
+				for _, want := range []string{"apiVersion: apps/v1\n", "kind: Deployment\n"} {
+					if !strings.Contains(string(current), want) {
+						t.Errorf("the yaml to edit doesn't contain %q:\n%s", want, current)
+					}
+				}
+				return test.edit(current)
+			})
+			if test.wantErr != (err != nil) {
+				t.Fatalf("EditApply() error = %v, want error %v", err, test.wantErr)
+			}
+			var wantPatches []string
+			if test.wantPatch != "" {
+				wantPatches = []string{test.wantPatch}
+			}
+			if !reflect.DeepEqual(patches, wantPatches) {
+				t.Errorf("patches = %q, want %q", patches, wantPatches)
+			}
+		})
+	}
+}
@@ -0,0 +1,295 @@
+package deployment
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+	"time"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	apiequality "k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/wait"
+)
+
+const (
+	// pollInterval is the interval between two checks of the deployment status.
+	pollInterval = time.Second
+
+	// revisionAnnotation is the revision annotation of a deployment and its replicasets.
+	revisionAnnotation = "deployment.kubernetes.io/revision"
+
+	// restartedAtAnnotation is the annotation stamped on the pod template by
+	// `kubectl rollout restart`.
+	restartedAtAnnotation = "kubectl.kubernetes.io/restartedAt"
+)
+
+// RolloutRestart triggers a rolling restart of the deployment, it works like
+// `kubectl rollout restart deployment/name`, it patches the pod template
+// annotation "kubectl.kubernetes.io/restartedAt" with current time.
+func (h *Handler) RolloutRestart(name string) error {
+	patchData := fmt.Sprintf(`{"spec":{"template":{"metadata":{"annotations":{"%s":"%s"}}}}}`,
+		restartedAtAnnotation, time.Now().Format(time.RFC3339Nano))
+	_, err := h.clientset.AppsV1().Deployments(h.namespace).
+		Patch(h.ctx, name, types.StrategicMergePatchType, []byte(patchData), h.Options.PatchOptions)
+	return err
+}
+
+// WaitObservedGeneration waits until the deployment controller has observed
+// the latest deployment spec, that is status.observedGeneration >= metadata.generation.
+//
+// The other status fields (replicas, conditions, etc.) of a deployment describe
+// the spec observed by the controller, they are stale until observedGeneration
+// catches up with generation. So always call WaitObservedGeneration before
+// trusting the deployment status after an update.
+//
+// A zero timeout means waiting until the handler context is done.
+func (h *Handler) WaitObservedGeneration(name string, timeout time.Duration) error {
+	var deploy *appsv1.Deployment
+	err := wait.PollImmediateWithContext(h.ctx, pollInterval, timeout, func(ctx context.Context) (bool, error) {
+		var err error
+		if deploy, err = h.clientset.AppsV1().Deployments(h.namespace).Get(ctx, name, h.Options.GetOptions); err != nil {
+			return false, err
+		}
+		return deploy.Status.ObservedGeneration >= deploy.Generation, nil
+	})
+	if err == wait.ErrWaitTimeout && deploy != nil {
+		return fmt.Errorf("deployment/%s observedGeneration(%d) has not caught up with generation(%d): %w",
+			name, deploy.Status.ObservedGeneration, deploy.Generation, err)
+	}
+	return err
+}
+
+// RolloutStatus waits until the rollout of the deployment completes, it works
+// like `kubectl rollout status deployment/name`. The rollout completes when
+// the deployment controller has observed the latest spec, see
+// WaitObservedGeneration, and all replicas are updated and available.
+//
+// A zero timeout means waiting until the handler context is done, otherwise
+// the timeout is shared by the two waits.
+func (h *Handler) RolloutStatus(name string, timeout time.Duration) error {
+	start := time.Now()
+	if err := h.WaitObservedGeneration(name, timeout); err != nil {
+		return err
+	}
+	if timeout > 0 {
+		// check the replicas at least once even if the timeout is used up.
+		if timeout -= time.Since(start); timeout <= 0 {
+			timeout = time.Nanosecond
+		}
+	}
+
+	var deploy *appsv1.Deployment
+	err := wait.PollImmediateWithContext(h.ctx, pollInterval, timeout, func(ctx context.Context) (bool, error) {
+		var err error
+		if deploy, err = h.clientset.AppsV1().Deployments(h.namespace).Get(ctx, name, h.Options.GetOptions); err != nil {
+			return false, err
+		}
+		return rolloutComplete(deploy), nil
+	})
+	if err == wait.ErrWaitTimeout && deploy != nil {
+		return fmt.Errorf("deployment/%s rollout not complete: %d of %d updated replicas, %d available: %w",
+			name, deploy.Status.UpdatedReplicas, desiredReplicas(deploy), deploy.Status.AvailableReplicas, err)
+	}
+	return err
+}
+
+// rolloutComplete returns true if all replicas of the deployment are updated
+// and available.
+func rolloutComplete(deploy *appsv1.Deployment) bool {
+	replicas := desiredReplicas(deploy)
+	return deploy.Status.UpdatedReplicas == replicas &&
+		deploy.Status.AvailableReplicas == replicas
+}
+
+// desiredReplicas returns the .spec.replicas of the deployment, default to 1.
+func desiredReplicas(deploy *appsv1.Deployment) int32 {
+	if deploy.Spec.Replicas == nil {
+		return 1
+	}
+	return *deploy.Spec.Replicas
+}
+
+// Pause pauses the rollout of the deployment, it works like
+// `kubectl rollout pause deployment/name`. The changes to the paused
+// deployment pod template don't trigger new rollouts until it's resumed.
+// It's a no-op if the deployment is already paused.
+func (h *Handler) Pause(name string) error {
+	return h.setPaused(name, true)
+}
+
+// Resume resumes the paused rollout of the deployment, it works like
+// `kubectl rollout resume deployment/name`.
+// It's a no-op if the deployment is not paused.
+func (h *Handler) Resume(name string) error {
+	return h.setPaused(name, false)
+}
+
+// setPaused strategic-merge-patches the deployment .spec.paused, if it's not
+// already set to paused.
+func (h *Handler) setPaused(name string, paused bool) error {
+	deploy, err := h.GetByName(name)
+	if err != nil {
+		return err
+	}
+	if deploy.Spec.Paused == paused {
+		return nil
+	}
+	patchData := fmt.Sprintf(`{"spec":{"paused":%t}}`, paused)
+	_, err = h.clientset.AppsV1().Deployments(h.namespace).
+		Patch(h.ctx, name, types.StrategicMergePatchType, []byte(patchData), h.Options.PatchOptions)
+	return err
+}
+
+// SetMinReadySeconds sets the deployment .spec.minReadySeconds, the minimum
+// number of seconds for which a newly created pod should be ready without
+// any of its container crashing, for it to be considered available.
+//
+// IsReady and WaitReady count a pod only after it becomes available, so a
+// larger minReadySeconds makes the rollout and the waits gate more slowly.
+func (h *Handler) SetMinReadySeconds(name string, seconds int32) (*appsv1.Deployment, error) {
+	patchData := fmt.Sprintf(`{"spec":{"minReadySeconds":%d}}`, seconds)
+	return h.clientset.AppsV1().Deployments(h.namespace).
+		Patch(h.ctx, name, types.StrategicMergePatchType, []byte(patchData), h.Options.PatchOptions)
+}
+
+// RolloutHistory returns the replicasets of the deployment sorted by the
+// revision in ascending order, every replicaset is a revision of the
+// deployment, it works like `kubectl rollout history deployment/name`.
+func (h *Handler) RolloutHistory(name string) ([]appsv1.ReplicaSet, error) {
+	deploy, err := h.Get(name)
+	if err != nil {
+		return nil, err
+	}
+	rsList, err := h.getRS(deploy)
+	if err != nil {
+		return nil, err
+	}
+	sortByRevision(rsList)
+	history := make([]appsv1.ReplicaSet, 0, len(rsList))
+	for _, rs := range rsList {
+		history = append(history, *rs)
+	}
+	return history, nil
+}
+
+// RolloutUndo rolls back the deployment to the given revision, it works like
+// `kubectl rollout undo deployment/name --to-revision=N`, it replaces the
+// deployment pod template with the pod template of the replicaset of the revision.
+// Revision 0 means the previous revision.
+func (h *Handler) RolloutUndo(name string, toRevision int64) (*appsv1.Deployment, error) {
+	deploy, err := h.Get(name)
+	if err != nil {
+		return nil, err
+	}
+	rsList, err := h.getRS(deploy)
+	if err != nil {
+		return nil, err
+	}
+	rs, err := findRevision(rsList, revision(deploy.Annotations), toRevision)
+	if err != nil {
+		return nil, fmt.Errorf("deployment/%s: %w", name, err)
+	}
+	template := rs.Spec.Template.DeepCopy()
+	delete(template.Labels, appsv1.DefaultDeploymentUniqueLabelKey)
+	patchData, err := json.Marshal([]map[string]interface{}{
+		{"op": "replace", "path": "/spec/template", "value": template},
+	})
+	if err != nil {
+		return nil, err
+	}
+	return h.clientset.AppsV1().Deployments(h.namespace).
+		Patch(h.ctx, name, types.JSONPatchType, patchData, h.Options.PatchOptions)
+}
+
+// findRevision finds the replicaset of the revision, revision 0 means the
+// latest revision before the current revision.
+func findRevision(rsList []*appsv1.ReplicaSet, current, toRevision int64) (*appsv1.ReplicaSet, error) {
+	var target *appsv1.ReplicaSet
+	for _, rs := range rsList {
+		rev := revision(rs.Annotations)
+		if toRevision != 0 && rev == toRevision {
+			return rs, nil
+		}
+		if toRevision == 0 && rev < current && (target == nil || rev > revision(target.Annotations)) {
+			target = rs
+		}
+	}
+	if target == nil {
+		if toRevision == 0 {
+			return nil, fmt.Errorf("no previous revision of revision %d", current)
+		}
+		return nil, fmt.Errorf("revision %d not found", toRevision)
+	}
+	return target, nil
+}
+
+// sortByRevision sorts the replicasets by the revision in ascending order.
+func sortByRevision(rsList []*appsv1.ReplicaSet) {
+	sort.SliceStable(rsList, func(i, j int) bool {
+		return revision(rsList[i].Annotations) < revision(rsList[j].Annotations)
+	})
+}
+
+// revision returns the value of the revision annotation, 0 if it's not set or invalid.
+func revision(annotations map[string]string) int64 {
+	rev, err := strconv.ParseInt(annotations[revisionAnnotation], 10, 64)
+	if err != nil {
+		return 0
+	}
+	return rev
+}
+
+// CurrentReplicaSet returns the replicaset of the deployment current revision,
+// it's the replicaset whose pod template matches the deployment pod template
+// with pod-template-hash label ignored.
+//
+// The replicaset is resolved by the "deployment.kubernetes.io/revision"
+// annotation first, and then by comparing the pod template, so it works even
+// if the deployment controller hasn't updated the revision annotation yet.
+func (h *Handler) CurrentReplicaSet(name string) (*appsv1.ReplicaSet, error) {
+	deploy, err := h.Get(name)
+	if err != nil {
+		return nil, err
+	}
+	rsList, err := h.getRS(deploy)
+	if err != nil {
+		return nil, err
+	}
+	if rs := findCurrentRS(deploy, rsList); rs != nil {
+		return rs, nil
+	}
+	return nil, fmt.Errorf("no replicaset matches the current revision(%q) of deployment/%s",
+		deploy.Annotations[revisionAnnotation], name)
+}
+
+// findCurrentRS finds the replicaset of the deployment current revision.
+func findCurrentRS(deploy *appsv1.Deployment, rsList []*appsv1.ReplicaSet) *appsv1.ReplicaSet {
+	revision := deploy.Annotations[revisionAnnotation]
+	for _, rs := range rsList {
+		if len(revision) != 0 && rs.Annotations[revisionAnnotation] == revision &&
+			equalIgnoreHash(&rs.Spec.Template, &deploy.Spec.Template) {
+			return rs
+		}
+	}
+	for _, rs := range rsList {
+		if equalIgnoreHash(&rs.Spec.Template, &deploy.Spec.Template) {
+			return rs
+		}
+	}
+	return nil
+}
+
+// equalIgnoreHash returns true if two pod templates are equal, ignoring the
+// pod-template-hash label added by the deployment controller.
+// ref: https://github.com/kubernetes/kubernetes/blob/v1.24.2/pkg/controller/deployment/util/deployment_util.go#L602
+func equalIgnoreHash(template1, template2 *corev1.PodTemplateSpec) bool {
+	t1Copy := template1.DeepCopy()
+	t2Copy := template2.DeepCopy()
+	delete(t1Copy.Labels, appsv1.DefaultDeploymentUniqueLabelKey)
+	delete(t2Copy.Labels, appsv1.DefaultDeploymentUniqueLabelKey)
+	return apiequality.Semantic.DeepEqual(t1Copy, t2Copy)
+}
@@ -0,0 +1,323 @@
+package deployment
+
+import (
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"path"
+	"reflect"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/wait"
+)
+
+func TestRolloutRestart(t *testing.T) {
+	var restartedAt []string
+	h := newTestHandler(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if r.URL.Path != "/apis/apps/v1/namespaces/test/deployments/nginx" {
+			status := k8serrors.NewNotFound(schema.GroupResource{Group: "apps", Resource: "deployments"}, "missing").Status()
+			w.WriteHeader(http.StatusNotFound)
+			json.NewEncoder(w).Encode(&status)
+			return
+		}
+		if r.Method != http.MethodPatch || r.Header.Get("Content-Type") != string(types.StrategicMergePatchType) {
+			t.Errorf("got %s %s, want strategic merge patch", r.Method, r.Header.Get("Content-Type"))
+		}
+		data, _ := io.ReadAll(r.Body)
+		deploy := &appsv1.Deployment{}
+		if err := json.Unmarshal(data, deploy); err != nil {
+			t.Error(err)
+		}
+		restartedAt = append(restartedAt, deploy.Spec.Template.Annotations[restartedAtAnnotation])
+		deploy.TypeMeta = metav1.TypeMeta{Kind: "Deployment", APIVersion: "apps/v1"}
+		json.NewEncoder(w).Encode(deploy)
+	})
+
+	for i := 0; i < 2; i++ {
+		if err := h.RolloutRestart("nginx"); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if len(restartedAt) != 2 || len(restartedAt[0]) == 0 || restartedAt[0] == restartedAt[1] {
+		t.Errorf("restartedAt annotations = %q, want two different timestamps", restartedAt)
+	}
+	if err := h.RolloutRestart("missing"); !k8serrors.IsNotFound(err) {
+		t.Errorf("restart missing deployment: got %v, want NotFound error", err)
+	}
+}
+
+func TestPauseResume(t *testing.T) {
+	var (
+		paused  bool
+		patches []string
+	)
+	h := newTestHandler(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/apis/apps/v1/namespaces/test/deployments/nginx" {
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+		if r.Method == http.MethodPatch {
+			data, _ := io.ReadAll(r.Body)
+			patches = append(patches, string(data))
+			patch := &appsv1.Deployment{}
+			if err := json.Unmarshal(data, patch); err != nil {
+				t.Error(err)
+			}
+			paused = patch.Spec.Paused
+		}
+		deploy := &appsv1.Deployment{
+			TypeMeta:   metav1.TypeMeta{Kind: "Deployment", APIVersion: "apps/v1"},
+			ObjectMeta: metav1.ObjectMeta{Name: "nginx", Namespace: "test"},
+			Spec:       appsv1.DeploymentSpec{Paused: paused},
+		}
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(deploy)
+	})
+
+	steps := []struct {
+		name   string
+		toggle func(name string) error
+		want   bool
+	}{
+		{"resume a running deployment", h.Resume, false},
+		{"pause", h.Pause, true},
+		{"pause again", h.Pause, true},
+		{"resume", h.Resume, false},
+		{"resume again", h.Resume, false},
+	}
+	for _, step := range steps {
+		if err := step.toggle("nginx"); err != nil {
+			t.Fatalf("%s: %v", step.name, err)
+		}
+		if paused != step.want {
+			t.Errorf("%s: paused = %v, want %v", step.name, paused, step.want)
+		}
+	}
+	if want := []string{`{"spec":{"paused":true}}`, `{"spec":{"paused":false}}`}; !reflect.DeepEqual(patches, want) {
+		t.Errorf("patches = %q, want %q", patches, want)
+	}
+}
+
+func TestWaitObservedGeneration(t *testing.T) {
+	var (
+		mu    sync.Mutex
+		polls = map[string]int{}
+	)
+	h := newTestHandler(t, func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+		name := path.Base(r.URL.Path)
+		polls[name]++
+		deploy := &appsv1.Deployment{
+			TypeMeta:   metav1.TypeMeta{Kind: "Deployment", APIVersion: "apps/v1"},
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "test", Generation: 2},
+		}
+		// the "nginx" deployment spec is observed at the second poll, the
+		// "stuck" deployment spec is never observed.
+		deploy.Status.ObservedGeneration = 1
+		if name == "nginx" && polls[name] >= 2 {
+			deploy.Status.ObservedGeneration = 2
+		}
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(deploy)
+	})
+
+	if err := h.WaitObservedGeneration("nginx", 5*time.Second); err != nil {
+		t.Errorf("WaitObservedGeneration() = %v, want nil", err)
+	}
+	err := h.WaitObservedGeneration("stuck", 1500*time.Millisecond)
+	if !errors.Is(err, wait.ErrWaitTimeout) || !strings.Contains(err.Error(), "observedGeneration(1) has not caught up with generation(2)") {
+		t.Errorf("WaitObservedGeneration() = %v, want timeout error", err)
+	}
+	mu.Lock()
+	defer mu.Unlock()
+	if polls["nginx"] != 2 {
+		t.Errorf("polled deployment/nginx %d times, want 2", polls["nginx"])
+	}
+}
+
+func TestRolloutStatus(t *testing.T) {
+	replicas := int32(2)
+	var polls int
+	h := newTestHandler(t, func(w http.ResponseWriter, r *http.Request) {
+		deploy := &appsv1.Deployment{
+			TypeMeta:   metav1.TypeMeta{Kind: "Deployment", APIVersion: "apps/v1"},
+			ObjectMeta: metav1.ObjectMeta{Name: path.Base(r.URL.Path), Namespace: "test", Generation: 2},
+			Spec:       appsv1.DeploymentSpec{Replicas: &replicas},
+		}
+		deploy.Status.ObservedGeneration = 1
+		polls++
+		switch deploy.Name {
+		// the "nginx" deployment spec is observed at the third poll, and
+		// the rollout completes at the fourth poll.
+		case "nginx":
+			if polls >= 3 {
+				deploy.Status = appsv1.DeploymentStatus{ObservedGeneration: 2, UpdatedReplicas: 1, AvailableReplicas: 1}
+			}
+			if polls >= 4 {
+				deploy.Status = appsv1.DeploymentStatus{ObservedGeneration: 2, UpdatedReplicas: 2, AvailableReplicas: 2}
+			}
+		// the "updating" deployment spec is observed, but the rollout never completes.
+		case "updating":
+			deploy.Status = appsv1.DeploymentStatus{ObservedGeneration: 2, UpdatedReplicas: 1, AvailableReplicas: 2}
+		}
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(deploy)
+	})
+
+	if err := h.RolloutStatus("nginx", 10*time.Second); err != nil {
+		t.Errorf("RolloutStatus() = %v, want nil", err)
+	}
+	if polls != 4 {
+		t.Errorf("polled %d times, want 4", polls)
+	}
+
+	tests := []struct {
+		name    string
+		wantErr string
+	}{
+		{"stuck", "observedGeneration(1) has not caught up with generation(2)"},
+		{"updating", "rollout not complete: 1 of 2 updated replicas, 2 available"},
+	}
+	for _, test := range tests {
+		err := h.RolloutStatus(test.name, 1500*time.Millisecond)
+		if !errors.Is(err, wait.ErrWaitTimeout) || !strings.Contains(err.Error(), test.wantErr) {
+			t.Errorf("RolloutStatus(%q) = %v, want timeout error %q", test.name, err, test.wantErr)
+		}
+	}
+}
+
+func TestRolloutHistory(t *testing.T) {
+	newRS := func(name, revision string) *appsv1.ReplicaSet {
+		return &appsv1.ReplicaSet{ObjectMeta: metav1.ObjectMeta{
+			Name:        name,
+			Annotations: map[string]string{revisionAnnotation: revision},
+		}}
+	}
+	rsList := []*appsv1.ReplicaSet{newRS("rs-3", "3"), newRS("rs-10", "10"), newRS("rs-1", "1"), newRS("rs-5", "5")}
+
+	sortByRevision(rsList)
+	var names []string
+	for _, rs := range rsList {
+		names = append(names, rs.Name)
+	}
+	if want := []string{"rs-1", "rs-3", "rs-5", "rs-10"}; !reflect.DeepEqual(names, want) {
+		t.Errorf("sortByRevision() = %v, want %v", names, want)
+	}
+
+	tests := []struct {
+		current, toRevision int64
+		want                string
+	}{
+		{current: 10, toRevision: 0, want: "rs-5"},
+		{current: 5, toRevision: 0, want: "rs-3"},
+		{current: 10, toRevision: 3, want: "rs-3"},
+		{current: 10, toRevision: 4},
+		{current: 1, toRevision: 0},
+	}
+	for _, tt := range tests {
+		rs, err := findRevision(rsList, tt.current, tt.toRevision)
+		if len(tt.want) == 0 {
+			if err == nil {
+				t.Errorf("findRevision(%d, %d) = %s, want error", tt.current, tt.toRevision, rs.Name)
+			}
+			continue
+		}
+		if err != nil || rs.Name != tt.want {
+			t.Errorf("findRevision(%d, %d) = %v, %v, want %s", tt.current, tt.toRevision, rs, err, tt.want)
+		}
+	}
+}
+
+// podTemplate returns the pod template of nginx image, with the
+// pod-template-hash label if hash is not empty.
+func podTemplate(image, hash string) corev1.PodTemplateSpec {
+	template := corev1.PodTemplateSpec{
+		ObjectMeta: metav1.ObjectMeta{Labels: map[string]string{"app": "nginx"}},
+		Spec:       corev1.PodSpec{Containers: []corev1.Container{{Name: "nginx", Image: image}}},
+	}
+	if len(hash) != 0 {
+		template.Labels[appsv1.DefaultDeploymentUniqueLabelKey] = hash
+	}
+	return template
+}
+
+func TestEqualIgnoreHash(t *testing.T) {
+	tests := []struct {
+		name      string
+		template1 corev1.PodTemplateSpec
+		template2 corev1.PodTemplateSpec
+		want      bool
+	}{
+		{"only hash differs", podTemplate("nginx:1.21", "abc"), podTemplate("nginx:1.21", ""), true},
+		{"different hashes", podTemplate("nginx:1.21", "abc"), podTemplate("nginx:1.21", "def"), true},
+		{"image differs", podTemplate("nginx:1.21", "abc"), podTemplate("nginx:1.22", "abc"), false},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			template1, template2 := test.template1.DeepCopy(), test.template2.DeepCopy()
+			if got := equalIgnoreHash(template1, template2); got != test.want {
+				t.Errorf("equalIgnoreHash() = %v, want %v", got, test.want)
+			}
+			// the pod-template-hash labels of the templates are kept.
+			if !reflect.DeepEqual(*template1, test.template1) || !reflect.DeepEqual(*template2, test.template2) {
+				t.Error("equalIgnoreHash() modified the templates")
+			}
+		})
+	}
+}
+
+func TestFindCurrentRS(t *testing.T) {
+	newRS := func(name, revision string, template corev1.PodTemplateSpec) *appsv1.ReplicaSet {
+		return &appsv1.ReplicaSet{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Annotations: map[string]string{revisionAnnotation: revision}},
+			Spec:       appsv1.ReplicaSetSpec{Template: template},
+		}
+	}
+	newDeploy := func(revision, image string) *appsv1.Deployment {
+		deploy := &appsv1.Deployment{Spec: appsv1.DeploymentSpec{Template: podTemplate(image, "")}}
+		if len(revision) != 0 {
+			deploy.Annotations = map[string]string{revisionAnnotation: revision}
+		}
+		return deploy
+	}
+	// nginx-3 is rolled back to the template of nginx-1.
+	rsList := []*appsv1.ReplicaSet{
+		newRS("nginx-1", "1", podTemplate("nginx:1.21", "111")),
+		newRS("nginx-2", "2", podTemplate("nginx:1.22", "222")),
+		newRS("nginx-3", "3", podTemplate("nginx:1.21", "333")),
+	}
+
+	tests := []struct {
+		name   string
+		deploy *appsv1.Deployment
+		want   string
+	}{
+		{"revision and template match", newDeploy("2", "nginx:1.22"), "nginx-2"},
+		{"revision picks among the same templates", newDeploy("3", "nginx:1.21"), "nginx-3"},
+		{"template only without revision", newDeploy("", "nginx:1.22"), "nginx-2"},
+		{"revision doesn't match the template", newDeploy("2", "nginx:1.21"), "nginx-1"},
+		{"no replicaset matches", newDeploy("4", "nginx:1.23"), ""},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var got string
+			if rs := findCurrentRS(test.deploy, rsList); rs != nil {
+				got = rs.Name
+			}
+			if got != test.want {
+				t.Errorf("findCurrentRS() = %q, want %q", got, test.want)
+			}
+		})
+	}
+}
@@ -1,24 +1,33 @@
 package deployment
 
 import (
-	"context"
 	"fmt"
-	"os"
-	"os/signal"
-	"syscall"
 	"time"
 
-	log "github.com/sirupsen/logrus"
 	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
-	k8serrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
-	"k8s.io/apimachinery/pkg/watch"
+	k8stypes "k8s.io/apimachinery/pkg/types"
 )
 
 // IsReady check if the deployment is ready.
+//
+// The deployment is ready only if all replicas are updated and available.
+// A pod is "ready" as soon as its readiness probe passes, but it's "available"
+// only after it has been ready for at least .spec.minReadySeconds, so IsReady
+// checks .status.availableReplicas instead of .status.readyReplicas, which
+// matches how the deployment controller gates the rollout.
 // ref: https://github.com/kubernetes/kubernetes/blob/a1128e380c2cf1c2d7443694673d9f1dd63eb518/staging/src/k8s.io/kubectl/pkg/polymorphichelpers/rollout_status.go#L59
 func (h *Handler) IsReady(name string) bool {
+	deploy, err := h.Get(name)
+	if err != nil {
+		return false
+	}
+	return isReady(deploy)
+}
+
+// isReady check if the deployment is ready.
+func isReady(deploy *appsv1.Deployment) bool {
 	checkGeneration := func(deploy *appsv1.Deployment) bool {
 		if deploy.Generation != deploy.Status.ObservedGeneration {
 			return false
@@ -29,9 +38,7 @@ func (h *Handler) IsReady(name string) bool {
 		if deploy.Spec.Replicas == nil {
 			return false
 		}
-		if *deploy.Spec.Replicas != deploy.Status.ReadyReplicas {
-			return false
-		}
+		// availableReplicas already honors minReadySeconds, readyReplicas doesn't.
 		if *deploy.Spec.Replicas != deploy.Status.AvailableReplicas {
 			return false
 		}
@@ -52,100 +59,7 @@ func (h *Handler) IsReady(name string) bool {
 		return false
 	}
 
-	deploy, err := h.Get(name)
-	if err != nil {
-		return false
-	}
-	if checkGeneration(deploy) && checkReplicas(deploy) && checkCondition(deploy) {
-		return true
-	}
-	return false
-}
-
-// WaitReady waiting for the deployment to be in the ready status.
-func (h *Handler) WaitReady(name string) error {
-	if h.IsReady(name) {
-		return nil
-	}
-
-	errCh := make(chan error, 1)
-	chkCh := make(chan struct{}, 1)
-	sigCh := make(chan os.Signal, 1)
-	signal.Notify(sigCh, syscall.SIGINT, syscall.SIGTERM, syscall.SIGKILL, syscall.SIGQUIT)
-	ctxCheck, cancelCheck := context.WithCancel(h.ctx)
-	ctxWatch, cancelWatch := context.WithCancel(h.ctx)
-	defer cancelCheck()
-	defer cancelWatch()
-
-	// this goroutine used to check whether deployment is ready and exists.
-	// if deployment already ready, return nil.
-	// if deployment does not exist, return error.
-	// if deployment exists but not ready, return nothing.
-	go func(context.Context) {
-		<-chkCh
-		for i := 0; i < 6; i++ {
-			// deployment is already ready, return nil
-			if h.IsReady(name) {
-				errCh <- nil
-				return
-			}
-			// deployment no longer exists, return err
-			_, err := h.Get(name)
-			if k8serrors.IsNotFound(err) {
-				errCh <- err
-				return
-			}
-			// deployment exists but not ready, return nothing.
-			if err == nil {
-				return
-			}
-			log.Error(err)
-			time.Sleep(time.Second * 10)
-		}
-	}(ctxCheck)
-
-	// this goroutine used to watch deployment.
-	go func(ctx context.Context) {
-		for {
-			timeout := int64(0)
-			listOptions := metav1.SingleObject(metav1.ObjectMeta{Name: name, Namespace: h.namespace})
-			listOptions.TimeoutSeconds = &timeout
-			watcher, err := h.clientset.AppsV1().Deployments(h.namespace).Watch(h.ctx, listOptions)
-			if err != nil {
-				errCh <- err
-				return
-			}
-			chkCh <- struct{}{}
-			for event := range watcher.ResultChan() {
-				switch event.Type {
-				case watch.Modified:
-					if h.IsReady(name) {
-						watcher.Stop()
-						errCh <- nil
-						return
-					}
-				case watch.Deleted:
-					watcher.Stop()
-					errCh <- fmt.Errorf("deployment/%s was deleted", name)
-					return
-				case watch.Bookmark:
-					log.Debug("watch deployment: bookmark")
-				case watch.Error:
-					log.Debug("watch deployment: error")
-				}
-			}
-			// If event channel is closed, it means the kube-apiserver has closed the connection.
-			log.Debug("watch deployment: reconnect to kubernetes")
-			watcher.Stop()
-		}
-	}(ctxWatch)
-
-	select {
-	case sig := <-sigCh:
-		return fmt.Errorf("cancelled by signal: %s", sig.String())
-	case err := <-errCh:
-		return err
-	}
+	return checkGeneration(deploy) && checkReplicas(deploy) && checkCondition(deploy)
 }
 
 //// WaitReady waiting for the deployment to be in the ready state.
@@ -390,3 +304,52 @@ func (h *Handler) getImages(sts *appsv1.Deployment) []string {
 	}
 	return il
 }
+
+// Pods returns the pods currently managed by the deployment.
+//
+// The pods are selected by the deployment .spec.selector, and only the pods
+// controlled by the replicasets of the deployment are returned, so the pods
+// matched by the selector but created by others are excluded.
+func (h *Handler) Pods(name string) ([]*corev1.Pod, error) {
+	deploy, err := h.Get(name)
+	if err != nil {
+		return nil, err
+	}
+	if deploy.Spec.Selector == nil ||
+		(len(deploy.Spec.Selector.MatchLabels) == 0 && len(deploy.Spec.Selector.MatchExpressions) == 0) {
+		return nil, fmt.Errorf("deployment/%s has empty selector", name)
+	}
+	selector, err := metav1.LabelSelectorAsSelector(deploy.Spec.Selector)
+	if err != nil {
+		return nil, err
+	}
+	rsList, err := h.getRS(deploy)
+	if err != nil {
+		return nil, err
+	}
+	listOptions := h.Options.ListOptions.DeepCopy()
+	listOptions.LabelSelector = selector.String()
+	podList, err := h.clientset.CoreV1().Pods(h.namespace).List(h.ctx, *listOptions)
+	if err != nil {
+		return nil, err
+	}
+	return ownedPods(deploy, rsList, podList.Items), nil
+}
+
+// ownedPods returns the pods controlled by the replicasets which are controlled
+// by the deployment.
+func ownedPods(deploy *appsv1.Deployment, rsList []*appsv1.ReplicaSet, pods []corev1.Pod) []*corev1.Pod {
+	owned := make(map[k8stypes.UID]bool)
+	for _, rs := range rsList {
+		if ref := metav1.GetControllerOf(rs); ref != nil && ref.UID == deploy.UID {
+			owned[rs.UID] = true
+		}
+	}
+	var pl []*corev1.Pod
+	for i := range pods {
+		if ref := metav1.GetControllerOf(&pods[i]); ref != nil && owned[ref.UID] {
+			pl = append(pl, &pods[i])
+		}
+	}
+	return pl
+}
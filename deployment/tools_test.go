@@ -0,0 +1,113 @@
+package deployment
+
+import (
+	"encoding/json"
+	"net/http"
+	"reflect"
+	"testing"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+func TestIsReady(t *testing.T) {
+	newDeploy := func(ready, available int32) *appsv1.Deployment {
+		replicas := int32(3)
+		return &appsv1.Deployment{
+			ObjectMeta: metav1.ObjectMeta{Generation: 2},
+			Spec:       appsv1.DeploymentSpec{Replicas: &replicas, MinReadySeconds: 30},
+			Status: appsv1.DeploymentStatus{
+				ObservedGeneration: 2,
+				Replicas:           3,
+				UpdatedReplicas:    3,
+				ReadyReplicas:      ready,
+				AvailableReplicas:  available,
+				Conditions: []appsv1.DeploymentCondition{
+					{Type: appsv1.DeploymentAvailable, Status: corev1.ConditionTrue},
+				},
+			},
+		}
+	}
+
+	tests := []struct {
+		name   string
+		deploy *appsv1.Deployment
+		want   bool
+	}{
+		{"all available", newDeploy(3, 3), true},
+		// pods are ready but haven't been ready for minReadySeconds.
+		{"ready but not available", newDeploy(3, 1), false},
+		{"none ready", newDeploy(0, 0), false},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if got := isReady(test.deploy); got != test.want {
+				t.Errorf("isReady() = %v, want %v", got, test.want)
+			}
+		})
+	}
+}
+
+func TestPods(t *testing.T) {
+	controller := true
+	ownerRef := func(kind, name string, uid types.UID) []metav1.OwnerReference {
+		return []metav1.OwnerReference{{APIVersion: "apps/v1", Kind: kind, Name: name, UID: uid, Controller: &controller}}
+	}
+	deploy := &appsv1.Deployment{
+		TypeMeta:   metav1.TypeMeta{Kind: "Deployment", APIVersion: "apps/v1"},
+		ObjectMeta: metav1.ObjectMeta{Name: "nginx", Namespace: "test", UID: "deploy-uid"},
+		Spec:       appsv1.DeploymentSpec{Selector: &metav1.LabelSelector{MatchLabels: map[string]string{"app": "nginx"}}},
+	}
+	rsList := &appsv1.ReplicaSetList{
+		TypeMeta: metav1.TypeMeta{Kind: "ReplicaSetList", APIVersion: "apps/v1"},
+		Items: []appsv1.ReplicaSet{
+			{ObjectMeta: metav1.ObjectMeta{Name: "nginx-1", UID: "rs-uid", OwnerReferences: ownerRef("Deployment", "nginx", "deploy-uid")}},
+			{ObjectMeta: metav1.ObjectMeta{Name: "other-1", UID: "other-uid", OwnerReferences: ownerRef("Deployment", "other", "other-deploy-uid")}},
+		},
+	}
+	// the pods matched by the selector "app=nginx".
+	podList := &corev1.PodList{
+		TypeMeta: metav1.TypeMeta{Kind: "PodList", APIVersion: "v1"},
+		Items: []corev1.Pod{
+			{ObjectMeta: metav1.ObjectMeta{Name: "nginx-1-a", OwnerReferences: ownerRef("ReplicaSet", "nginx-1", "rs-uid")}},
+			{ObjectMeta: metav1.ObjectMeta{Name: "other-1-a", OwnerReferences: ownerRef("ReplicaSet", "other-1", "other-uid")}},
+			{ObjectMeta: metav1.ObjectMeta{Name: "bare"}},
+		},
+	}
+
+	h := newTestHandler(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/apis/apps/v1/namespaces/test/deployments/nginx":
+			json.NewEncoder(w).Encode(deploy)
+		case "/apis/apps/v1/namespaces/test/replicasets":
+			json.NewEncoder(w).Encode(rsList)
+		case "/api/v1/namespaces/test/pods":
+			if selector := r.URL.Query().Get("labelSelector"); selector != "app=nginx" {
+				t.Errorf("labelSelector = %q, want app=nginx", selector)
+			}
+			json.NewEncoder(w).Encode(podList)
+		default:
+			t.Errorf("unexpected request %s", r.URL.Path)
+		}
+	})
+
+	pods, err := h.Pods("nginx")
+	if err != nil {
+		t.Fatal(err)
+	}
+	var names []string
+	for _, pod := range pods {
+		names = append(names, pod.Name)
+	}
+	if want := []string{"nginx-1-a"}; !reflect.DeepEqual(names, want) {
+		t.Errorf("Pods() = %v, want %v", names, want)
+	}
+
+	deploy.Spec.Selector = &metav1.LabelSelector{}
+	if _, err := h.Pods("nginx"); err == nil {
+		t.Error("Pods() with empty selector should return error")
+	}
+}
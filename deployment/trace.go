@@ -0,0 +1,67 @@
+package deployment
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// tracerName is the instrumentation name of the tracer creating the spans.
+const tracerName = "github.com/forbearing/k8s/deployment"
+
+// SetTracerProvider sets the provider of the tracer creating a span for each
+// create, get, update, delete and list operation, nil disables tracing.
+// It works like WithTracerProvider.
+func (h *Handler) SetTracerProvider(tracerProvider trace.TracerProvider) {
+	h.l.Lock()
+	defer h.l.Unlock()
+	h.tracerProvider = tracerProvider
+}
+
+// instrument starts the API operation op, such as "Create", on the deployment
+// namespace/name, name is empty for the list operation. It returns the context
+// to make the API call with, and the func ending the operation with its error.
+//
+// If a TracerProvider is set, the operation is traced by the span named like
+// "deployment.Create". If a MetricsRecorder is set, the latency and the error
+// of the operation are observed by it. If neither is set, it returns the
+// handler context and a no-op func, without even reading the clock.
+func (h *Handler) instrument(op, namespace, name string) (context.Context, func(err error)) {
+	h.l.RLock()
+	metrics, tracerProvider := h.metrics, h.tracerProvider
+	h.l.RUnlock()
+	if metrics == nil && tracerProvider == nil {
+		return h.ctx, func(error) {}
+	}
+
+	ctx := h.ctx
+	var span trace.Span
+	if tracerProvider != nil {
+		attrs := []attribute.KeyValue{attribute.String("k8s.resource", Resource)}
+		if len(namespace) != 0 {
+			attrs = append(attrs, attribute.String("k8s.namespace", namespace))
+		}
+		if len(name) != 0 {
+			attrs = append(attrs, attribute.String("k8s.name", name))
+		}
+		ctx, span = tracerProvider.Tracer(tracerName).Start(ctx, "deployment."+op,
+			trace.WithSpanKind(trace.SpanKindClient), trace.WithAttributes(attrs...))
+	}
+	start := time.Now()
+	return ctx, func(err error) {
+		if metrics != nil {
+			metrics.Observe(strings.ToLower(op), Resource, time.Since(start), err)
+		}
+		if span != nil {
+			if err != nil {
+				span.RecordError(err)
+				span.SetStatus(codes.Error, err.Error())
+			}
+			span.End()
+		}
+	}
+}
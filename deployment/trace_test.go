@@ -0,0 +1,73 @@
+package deployment
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"testing"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	appsv1 "k8s.io/api/apps/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestTracerProvider(t *testing.T) {
+	h := newTestHandler(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if r.Method == http.MethodPost {
+			data, _ := io.ReadAll(r.Body)
+			w.WriteHeader(http.StatusCreated)
+			w.Write(data)
+			return
+		}
+		w.WriteHeader(http.StatusNotFound)
+		fmt.Fprintln(w, `{"kind":"Status","apiVersion":"v1","status":"Failure","reason":"NotFound","code":404}`)
+	})
+
+	// no TracerProvider is set.
+	deploy := &appsv1.Deployment{ObjectMeta: metav1.ObjectMeta{Name: "nginx"}}
+	if _, err := h.Create(deploy); err != nil {
+		t.Fatal(err)
+	}
+
+	spanRecorder := tracetest.NewSpanRecorder()
+	h.SetTracerProvider(sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(spanRecorder)))
+	if _, err := h.Create(deploy); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := h.Get("nginx"); err == nil {
+		t.Fatal("expected Get to fail")
+	}
+
+	spans := spanRecorder.Ended()
+	if len(spans) != 2 {
+		t.Fatalf("recorded %d spans, want a create and a get", len(spans))
+	}
+	tests := []struct {
+		name   string
+		status codes.Code
+	}{
+		{"deployment.Create", codes.Unset},
+		{"deployment.Get", codes.Error},
+	}
+	for i, test := range tests {
+		span := spans[i]
+		if span.Name() != test.name || span.Status().Code != test.status {
+			t.Errorf("span %s has status %s, want span %s with status %s",
+				span.Name(), span.Status().Code, test.name, test.status)
+		}
+		attrs := make(map[attribute.Key]string)
+		for _, attr := range span.Attributes() {
+			attrs[attr.Key] = attr.Value.AsString()
+		}
+		want := map[attribute.Key]string{"k8s.resource": "deployments", "k8s.namespace": "test", "k8s.name": "nginx"}
+		for key, value := range want {
+			if attrs[key] != value {
+				t.Errorf("span %s attribute %s = %q, want %q", span.Name(), key, attrs[key], value)
+			}
+		}
+	}
+}
@@ -4,13 +4,21 @@ import (
 	"errors"
 )
 
+// ErrNoMatch, ErrMultipleMatches, ErrUnsupportedField and ErrInvalidSortField are
+// wrapped with the offending selector or field, use errors.Is to check them.
 var (
-	ErrInvalidToolsType  = errors.New("type must be string, *appsv1.Deployment, appsv1.Deployment, metav1.Object or runtime.Object")
-	ErrInvalidCreateType = errors.New("type must be string, []byte, *appsv1.Deployment, appsv1.Deployment, metav1.Object, runtime.Object, *unstructured.Unstructured, unstructured.Unstructured or map[string]interface{}")
-	ErrInvalidUpdateType = ErrInvalidCreateType
-	ErrInvalidApplyType  = ErrInvalidCreateType
-	ErrInvalidDeleteType = ErrInvalidCreateType
-	ErrInvalidGetType    = ErrInvalidCreateType
-	ErrInvalidScaleType  = ErrInvalidCreateType
-	ErrInvalidPatchType  = errors.New("patch data type must be string, []byte, *appsv1.Deployment, appsv1.Deployment, metav1.Object, runtime.Object, *unstructured.Unstructured, unstructured.Unstructured or map[string]interface{}")
+	ErrInvalidToolsType     = errors.New("type must be string, *appsv1.Deployment, appsv1.Deployment, metav1.Object or runtime.Object")
+	ErrInvalidCreateType    = errors.New("type must be string, []byte, *appsv1.Deployment, appsv1.Deployment, metav1.Object, runtime.Object, *unstructured.Unstructured, unstructured.Unstructured or map[string]interface{}")
+	ErrInvalidUpdateType    = ErrInvalidCreateType
+	ErrInvalidApplyType     = ErrInvalidCreateType
+	ErrInvalidDeleteType    = ErrInvalidCreateType
+	ErrInvalidGetType       = ErrInvalidCreateType
+	ErrInvalidScaleType     = ErrInvalidCreateType
+	ErrInvalidPatchType     = errors.New("patch data type must be string, []byte, *appsv1.Deployment, appsv1.Deployment, metav1.Object, runtime.Object, *unstructured.Unstructured, unstructured.Unstructured or map[string]interface{}")
+	ErrInvalidObjectType    = errors.New("object type is not *appsv1.Deployment")
+	ErrUnsupportedField     = errors.New("field selector is not supported by deployments, only metadata.name and metadata.namespace are supported")
+	ErrNoMatch              = errors.New("no deployment matches the label selector")
+	ErrMultipleMatches      = errors.New("more than one deployment matches the label selector")
+	ErrEmptyResourceVersion = errors.New("resourceVersion must not be empty to update deployment with optimistic concurrency")
+	ErrInvalidSortField     = errors.New("sort field must be SortByName, SortByNameDesc, SortByCreationTimestamp or SortByCreationTimestampDesc")
 )
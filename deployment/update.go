@@ -3,6 +3,7 @@ package deployment
 import (
 	"encoding/json"
 	"fmt"
+	"io"
 	"io/ioutil"
 
 	appsv1 "k8s.io/api/apps/v1"
@@ -14,7 +15,7 @@ import (
 
 // Update updates deployment from type string, []byte, *appsv1.Deployment,
 // appsv1.Deployment, metav1.Object, runtime.Object, *unstructured.Unstructured,
-// unstructured.Unstructured or map[string]interface{}.
+// unstructured.Unstructured, map[string]interface{} or io.Reader.
 func (h *Handler) Update(obj interface{}) (*appsv1.Deployment, error) {
 	switch val := obj.(type) {
 	case string:
@@ -31,6 +32,8 @@ func (h *Handler) Update(obj interface{}) (*appsv1.Deployment, error) {
 		return h.UpdateFromUnstructured(&val)
 	case map[string]interface{}:
 		return h.UpdateFromMap(val)
+	case io.Reader:
+		return h.UpdateFromReader(val)
 	case metav1.Object, runtime.Object:
 		//if reflect.TypeOf(val).String() == "*unstructured.Unstructured" {
 		//    return h.UpdateFromUnstructured(val.(*unstructured.Unstructured))
@@ -64,11 +67,20 @@ func (h *Handler) UpdateFromBytes(data []byte) (*appsv1.Deployment, error) {
 	return h.updateDeployment(deploy)
 }
 
+// UpdateFromReader updates deployment from io.Reader, the reader is read until EOF.
+func (h *Handler) UpdateFromReader(r io.Reader) (*appsv1.Deployment, error) {
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return nil, fmt.Errorf("read deployment data: %w", err)
+	}
+	return h.UpdateFromBytes(data)
+}
+
 // UpdateFromObject updates deployment from metav1.Object or runtime.Object.
 func (h *Handler) UpdateFromObject(obj interface{}) (*appsv1.Deployment, error) {
 	deploy, ok := obj.(*appsv1.Deployment)
 	if !ok {
-		return nil, fmt.Errorf("object type is not *appsv1.Deployment")
+		return nil, ErrInvalidObjectType
 	}
 	return h.updateDeployment(deploy)
 }
@@ -93,6 +105,25 @@ func (h *Handler) UpdateFromMap(u map[string]interface{}) (*appsv1.Deployment, e
 	return h.updateDeployment(deploy)
 }
 
+// UpdateWithResourceVersion updates the deployment with optimistic concurrency.
+// Unlike Update, the resourceVersion of the deployment is kept, so the update fails
+// with a Conflict error if the deployment has been modified since it was read,
+// check it with k8serrors.IsConflict, get the latest deployment and retry.
+// ErrEmptyResourceVersion is returned if the deployment has no resourceVersion.
+func (h *Handler) UpdateWithResourceVersion(deploy *appsv1.Deployment) (*appsv1.Deployment, error) {
+	if len(deploy.ResourceVersion) == 0 {
+		return nil, ErrEmptyResourceVersion
+	}
+	namespace := deploy.GetNamespace()
+	if len(namespace) == 0 {
+		namespace = h.namespace
+	}
+	ctx, done := h.instrument("Update", namespace, deploy.Name)
+	deploy, err := h.clientset.AppsV1().Deployments(namespace).Update(ctx, deploy, h.Options.UpdateOptions)
+	done(err)
+	return deploy, err
+}
+
 // updateDeployment
 func (h *Handler) updateDeployment(deploy *appsv1.Deployment) (*appsv1.Deployment, error) {
 	namespace := deploy.GetNamespace()
@@ -102,5 +133,8 @@ func (h *Handler) updateDeployment(deploy *appsv1.Deployment) (*appsv1.Deploymen
 	// resourceVersion cann't be set, the resourceVersion field is empty.
 	deploy.ResourceVersion = ""
 	deploy.UID = ""
-	return h.clientset.AppsV1().Deployments(namespace).Update(h.ctx, deploy, h.Options.UpdateOptions)
+	ctx, done := h.instrument("Update", namespace, deploy.Name)
+	deploy, err := h.clientset.AppsV1().Deployments(namespace).Update(ctx, deploy, h.Options.UpdateOptions)
+	done(err)
+	return deploy, err
 }
@@ -2,10 +2,10 @@ package deployment
 
 import (
 	"encoding/json"
-	"fmt"
 	"io/ioutil"
 
 	appsv1 "k8s.io/api/apps/v1"
+	autoscalingv1 "k8s.io/api/autoscaling/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime"
@@ -42,21 +42,26 @@ func (h *Handler) Scale(obj interface{}, replicas int32) (*appsv1.Deployment, er
 }
 
 // ScaleByName scale deployment by name.
+//
+// It only updates the scale subresource of the deployment, so the other fields
+// of the deployment modified concurrently won't be overwritten.
+// The refreshed deployment is returned.
 func (h *Handler) ScaleByName(name string, replicas int32) (*appsv1.Deployment, error) {
-	deploy, err := h.Get(name)
-	if err != nil {
-		return nil, err
+	scale := &autoscalingv1.Scale{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: h.namespace},
+		Spec:       autoscalingv1.ScaleSpec{Replicas: replicas},
 	}
-	copiedDeploy := deploy.DeepCopy()
-	if copiedDeploy.Spec.Replicas != nil {
-		copiedDeploy.Spec.Replicas = &replicas
+	if _, err := h.clientset.AppsV1().Deployments(h.namespace).
+		UpdateScale(h.ctx, name, scale, h.Options.UpdateOptions); err != nil {
+		return nil, err
 	}
-	return h.Update(copiedDeploy)
+	return h.Get(name)
+}
 
-	//scale := &autoscalingv1.Scale{}
-	//scale.Spec.Replicas = replicas
-	//_, err := h.clientset.AppsV1().Deployments(h.namespace).UpdateScale(h.ctx, name, scale, h.Options.UpdateOptions)
-	//return nil, err
+// GetScale gets the scale subresource of the deployment, which contains the
+// desired and current replicas and the label selector of the deployment.
+func (h *Handler) GetScale(name string) (*autoscalingv1.Scale, error) {
+	return h.clientset.AppsV1().Deployments(h.namespace).GetScale(h.ctx, name, h.Options.GetOptions)
 }
 
 // ScaleFromFile scale deployment from yaml or json file.
@@ -86,7 +91,7 @@ func (h *Handler) ScaleFromBytes(data []byte, replicas int32) (*appsv1.Deploymen
 func (h *Handler) ScaleFromObject(obj interface{}, replicas int32) (*appsv1.Deployment, error) {
 	deploy, ok := obj.(*appsv1.Deployment)
 	if !ok {
-		return nil, fmt.Errorf("object type is not *appsv1.Deployment")
+		return nil, ErrInvalidObjectType
 	}
 	return h.ScaleByName(deploy.Name, replicas)
 }
@@ -0,0 +1,65 @@
+package deployment
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	appsv1 "k8s.io/api/apps/v1"
+	autoscalingv1 "k8s.io/api/autoscaling/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+func TestScaleByName(t *testing.T) {
+	deploy := &appsv1.Deployment{
+		TypeMeta:   metav1.TypeMeta{Kind: "Deployment", APIVersion: "apps/v1"},
+		ObjectMeta: metav1.ObjectMeta{Name: "nginx", Namespace: "test"},
+	}
+	replicas := int32(1)
+	deploy.Spec.Replicas = &replicas
+
+	h := newTestHandler(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/apis/apps/v1/namespaces/test/deployments/nginx/scale":
+			scale := &autoscalingv1.Scale{}
+			if r.Method == http.MethodPut {
+				if err := json.NewDecoder(r.Body).Decode(scale); err != nil {
+					t.Error(err)
+				}
+				*deploy.Spec.Replicas = scale.Spec.Replicas
+			}
+			scale.TypeMeta = metav1.TypeMeta{Kind: "Scale", APIVersion: "autoscaling/v1"}
+			scale.ObjectMeta = deploy.ObjectMeta
+			scale.Spec.Replicas = *deploy.Spec.Replicas
+			json.NewEncoder(w).Encode(scale)
+		case "/apis/apps/v1/namespaces/test/deployments/nginx":
+			json.NewEncoder(w).Encode(deploy)
+		default:
+			status := k8serrors.NewNotFound(schema.GroupResource{Group: "apps", Resource: "deployments"}, "missing").Status()
+			w.WriteHeader(http.StatusNotFound)
+			json.NewEncoder(w).Encode(&status)
+		}
+	})
+
+	got, err := h.ScaleByName("nginx", 3)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if *got.Spec.Replicas != 3 {
+		t.Errorf("replicas = %d, want 3", *got.Spec.Replicas)
+	}
+	scale, err := h.GetScale("nginx")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if scale.Spec.Replicas != 3 {
+		t.Errorf("scale replicas = %d, want 3", scale.Spec.Replicas)
+	}
+
+	if _, err := h.ScaleByName("missing", 3); !k8serrors.IsNotFound(err) {
+		t.Errorf("scale missing deployment: got %v, want NotFound error", err)
+	}
+}
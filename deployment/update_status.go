@@ -2,7 +2,6 @@ package deployment
 
 import (
 	"encoding/json"
-	"fmt"
 	"io/ioutil"
 
 	appsv1 "k8s.io/api/apps/v1"
@@ -65,7 +64,7 @@ func (h *Handler) UpdateStatusFromBytes(data []byte) (*appsv1.Deployment, error)
 func (h *Handler) UpdateStatusFromObject(obj interface{}) (*appsv1.Deployment, error) {
 	deploy, ok := obj.(*appsv1.Deployment)
 	if !ok {
-		return nil, fmt.Errorf("object type is not *appsv1.Deployment")
+		return nil, ErrInvalidObjectType
 	}
 	return h.updateDeploymentStatus(deploy)
 }
@@ -0,0 +1,65 @@
+package deployment
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"sync"
+	"testing"
+
+	appsv1 "k8s.io/api/apps/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestUpdateWithResourceVersion(t *testing.T) {
+	var (
+		mu              sync.Mutex
+		resourceVersion = 2
+	)
+	// the fake kubernetes API server rejects the update with stale resourceVersion
+	// and bumps the resourceVersion on success, like kubernetes API server does.
+	h := newTestHandler(t, func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+		if r.Method != http.MethodPut || r.URL.Path != "/apis/apps/v1/namespaces/test/deployments/nginx" {
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+		deploy := &appsv1.Deployment{}
+		if err := json.NewDecoder(r.Body).Decode(deploy); err != nil {
+			t.Error(err)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		if len(deploy.ResourceVersion) != 0 && deploy.ResourceVersion != strconv.Itoa(resourceVersion) {
+			w.WriteHeader(http.StatusConflict)
+			fmt.Fprintln(w, `{"kind":"Status","apiVersion":"v1","status":"Failure","reason":"Conflict","code":409}`)
+			return
+		}
+		resourceVersion++
+		deploy.ResourceVersion = strconv.Itoa(resourceVersion)
+		json.NewEncoder(w).Encode(deploy)
+	})
+	deploy := &appsv1.Deployment{ObjectMeta: metav1.ObjectMeta{Name: "nginx", ResourceVersion: "1"}}
+
+	if _, err := h.UpdateWithResourceVersion(deploy); !k8serrors.IsConflict(err) {
+		t.Fatalf("update with stale resourceVersion: got %v, want Conflict error", err)
+	}
+	deploy.ResourceVersion = "2"
+	updated, err := h.UpdateWithResourceVersion(deploy)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if updated.ResourceVersion != "3" {
+		t.Errorf("resourceVersion = %s, want 3", updated.ResourceVersion)
+	}
+
+	// Update clears the resourceVersion, the stale deployment overwrites the latest one.
+	if _, err := h.Update(deploy); err != nil {
+		t.Errorf("update without resourceVersion: %v", err)
+	}
+	if _, err := h.UpdateWithResourceVersion(&appsv1.Deployment{ObjectMeta: metav1.ObjectMeta{Name: "nginx"}}); !errors.Is(err, ErrEmptyResourceVersion) {
+		t.Errorf("update with empty resourceVersion: got %v, want ErrEmptyResourceVersion", err)
+	}
+}
@@ -0,0 +1,151 @@
+package deployment
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	appsv1 "k8s.io/api/apps/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/apimachinery/pkg/watch"
+)
+
+// WaitReady waits for the deployment to be ready, see IsReady for what ready
+// means. It returns an error if the deployment doesn't exist or is deleted
+// while waiting.
+//
+// The optional timeout is the max duration to wait, if the timeout is omitted
+// or zero, it waits until the handler context is done. The timeout error
+// includes the last observed status of the deployment.
+func (h *Handler) WaitReady(name string, timeout ...time.Duration) error {
+	var d time.Duration
+	if len(timeout) > 0 {
+		d = timeout[0]
+	}
+	deploy, err := h.waitFor(name, d, func(deploy *appsv1.Deployment, err error) (bool, error) {
+		if err != nil {
+			return false, err
+		}
+		return isReady(deploy), nil
+	})
+	if err == wait.ErrWaitTimeout && deploy != nil {
+		return fmt.Errorf("deployment/%s is not ready: %d of %d updated replicas, %d available: %w",
+			name, deploy.Status.UpdatedReplicas, desiredReplicas(deploy), deploy.Status.AvailableReplicas, err)
+	}
+	return err
+}
+
+// WaitDeleted waits until the deployment is deleted, that is getting the
+// deployment returns NotFound error.
+//
+// A zero timeout means waiting until the handler context is done. The timeout
+// error includes the last observed status of the deployment.
+func (h *Handler) WaitDeleted(name string, timeout time.Duration) error {
+	deploy, err := h.waitFor(name, timeout, func(_ *appsv1.Deployment, err error) (bool, error) {
+		if k8serrors.IsNotFound(err) {
+			return true, nil
+		}
+		return false, err
+	})
+	if err == wait.ErrWaitTimeout && deploy != nil {
+		return fmt.Errorf("deployment/%s is not deleted: deletionTimestamp %v, %d replicas: %w",
+			name, deploy.DeletionTimestamp, deploy.Status.Replicas, err)
+	}
+	return err
+}
+
+// waitFor waits until the condition is met, the condition is called with
+// the latest state of the deployment, or the NotFound error if the deployment
+// doesn't exist. It returns the last observed deployment.
+//
+// waitFor watches the deployment to get notified of the changes, if the watch
+// fails, it falls back to polling every pollInterval.
+func (h *Handler) waitFor(name string, timeout time.Duration,
+	condition func(deploy *appsv1.Deployment, err error) (bool, error)) (*appsv1.Deployment, error) {
+
+	ctx := h.ctx
+	if timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(h.ctx, timeout)
+		defer cancel()
+	}
+	// ctxErr returns wait.ErrWaitTimeout if the timeout is reached,
+	// otherwise returns the error of handler context.
+	ctxErr := func() error {
+		if h.ctx.Err() != nil {
+			return h.ctx.Err()
+		}
+		return wait.ErrWaitTimeout
+	}
+
+	var last *appsv1.Deployment
+	for {
+		deploy, err := h.clientset.AppsV1().Deployments(h.namespace).Get(ctx, name, h.Options.GetOptions)
+		if ctx.Err() != nil {
+			return last, ctxErr()
+		}
+		if err != nil && !k8serrors.IsNotFound(err) {
+			return last, err
+		}
+		listOptions := metav1.ListOptions{FieldSelector: fields.OneTermEqualSelector("metadata.name", name).String()}
+		if err == nil {
+			last = deploy
+			// watch the changes since the deployment we just got.
+			listOptions.ResourceVersion = deploy.ResourceVersion
+		} else {
+			deploy = nil
+		}
+		if done, err := condition(deploy, err); done || err != nil {
+			return last, err
+		}
+
+		watcher, err := h.clientset.AppsV1().Deployments(h.namespace).Watch(ctx, listOptions)
+		if err != nil {
+			// fall back to polling.
+			h.log().Debug(fmt.Sprintf("watch deployment/%s: %v, poll it", name, err))
+			select {
+			case <-ctx.Done():
+				return last, ctxErr()
+			case <-time.After(pollInterval):
+			}
+			continue
+		}
+	events:
+		for {
+			select {
+			case <-ctx.Done():
+				watcher.Stop()
+				return last, ctxErr()
+			case event, ok := <-watcher.ResultChan():
+				if !ok {
+					break events
+				}
+				var done bool
+				switch event.Type {
+				case watch.Added, watch.Modified:
+					deploy, ok := event.Object.(*appsv1.Deployment)
+					if !ok {
+						continue
+					}
+					last = deploy
+					done, err = condition(deploy, nil)
+				case watch.Deleted:
+					done, err = condition(nil, k8serrors.NewNotFound(GVR.GroupResource(), name))
+				default:
+					continue
+				}
+				if done || err != nil {
+					watcher.Stop()
+					return last, err
+				}
+			}
+		}
+		// If event channel is closed, it means the server has closed the
+		// connection, get the deployment again and re-watch it.
+		h.log().Debug("watch deployment: reconnect to kubernetes")
+		watcher.Stop()
+	}
+}
@@ -0,0 +1,154 @@
+package deployment
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/util/wait"
+)
+
+const (
+	notReadyDeploy = `{"kind":"Deployment","apiVersion":"apps/v1","metadata":{"name":"nginx","namespace":"test","resourceVersion":"1","generation":1},"spec":{"replicas":2},"status":{"observedGeneration":1,"replicas":2,"updatedReplicas":2,"availableReplicas":1}}`
+	readyDeploy    = `{"kind":"Deployment","apiVersion":"apps/v1","metadata":{"name":"nginx","namespace":"test","resourceVersion":"2","generation":1},"spec":{"replicas":2},"status":{"observedGeneration":1,"replicas":2,"updatedReplicas":2,"availableReplicas":2,"conditions":[{"type":"Available","status":"True"}]}}`
+)
+
+// serveWait returns a fake kubernetes API server, getting the deployment
+// returns get, watching the deployment streams the events then keeps the
+// connection open until the client goes away. An empty get means NotFound.
+func serveWait(t *testing.T, get string, events ...string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if r.URL.Query().Get("watch") != "true" {
+			if get == "" {
+				w.WriteHeader(http.StatusNotFound)
+				fmt.Fprintln(w, `{"kind":"Status","apiVersion":"v1","status":"Failure","reason":"NotFound","code":404}`)
+				return
+			}
+			fmt.Fprintln(w, get)
+			return
+		}
+		if fieldSelector := r.URL.Query().Get("fieldSelector"); fieldSelector != "metadata.name=nginx" {
+			t.Errorf("watch fieldSelector = %q, want metadata.name=nginx", fieldSelector)
+		}
+		for _, event := range events {
+			fmt.Fprintln(w, event)
+		}
+		w.(http.Flusher).Flush()
+		<-r.Context().Done()
+	}
+}
+
+func TestWaitReady(t *testing.T) {
+	tests := []struct {
+		name    string
+		serve   func(t *testing.T) http.HandlerFunc
+		wantErr func(error) bool
+	}{
+		{
+			name:  "ready",
+			serve: func(t *testing.T) http.HandlerFunc { return serveWait(t, readyDeploy) },
+		},
+		{
+			name: "not ready then ready",
+			serve: func(t *testing.T) http.HandlerFunc {
+				return serveWait(t, notReadyDeploy, `{"type":"MODIFIED","object":`+readyDeploy+`}`)
+			},
+		},
+		{
+			name: "deleted while waiting",
+			serve: func(t *testing.T) http.HandlerFunc {
+				return serveWait(t, notReadyDeploy, `{"type":"DELETED","object":`+notReadyDeploy+`}`)
+			},
+			wantErr: k8serrors.IsNotFound,
+		},
+		{
+			name:    "not exist",
+			serve:   func(t *testing.T) http.HandlerFunc { return serveWait(t, "") },
+			wantErr: k8serrors.IsNotFound,
+		},
+		{
+			name:  "timeout",
+			serve: func(t *testing.T) http.HandlerFunc { return serveWait(t, notReadyDeploy) },
+			wantErr: func(err error) bool {
+				return errors.Is(err, wait.ErrWaitTimeout) && strings.Contains(err.Error(), "1 available")
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			h := newTestHandler(t, test.serve(t))
+			err := h.WaitReady("nginx", 200*time.Millisecond)
+			if test.wantErr == nil && err != nil {
+				t.Errorf("WaitReady() = %v, want nil", err)
+			}
+			if test.wantErr != nil && !test.wantErr(err) {
+				t.Errorf("WaitReady() = %v, want another error", err)
+			}
+		})
+	}
+}
+
+func TestWaitReadyPoll(t *testing.T) {
+	// the watch is forbidden, WaitReady falls back to polling.
+	var gets int
+	h := newTestHandler(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if r.URL.Query().Get("watch") == "true" {
+			w.WriteHeader(http.StatusForbidden)
+			fmt.Fprintln(w, `{"kind":"Status","apiVersion":"v1","status":"Failure","reason":"Forbidden","code":403}`)
+			return
+		}
+		if gets++; gets == 1 {
+			fmt.Fprintln(w, notReadyDeploy)
+			return
+		}
+		fmt.Fprintln(w, readyDeploy)
+	})
+	if err := h.WaitReady("nginx", 10*time.Second); err != nil {
+		t.Fatal(err)
+	}
+	if gets != 2 {
+		t.Errorf("got the deployment %d times, want 2", gets)
+	}
+}
+
+func TestWaitDeleted(t *testing.T) {
+	tests := []struct {
+		name    string
+		serve   func(t *testing.T) http.HandlerFunc
+		wantErr bool
+	}{
+		{
+			name:  "not exist",
+			serve: func(t *testing.T) http.HandlerFunc { return serveWait(t, "") },
+		},
+		{
+			name: "deleted while waiting",
+			serve: func(t *testing.T) http.HandlerFunc {
+				return serveWait(t, readyDeploy, `{"type":"DELETED","object":`+readyDeploy+`}`)
+			},
+		},
+		{
+			name:    "timeout",
+			serve:   func(t *testing.T) http.HandlerFunc { return serveWait(t, readyDeploy) },
+			wantErr: true,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			h := newTestHandler(t, test.serve(t))
+			err := h.WaitDeleted("nginx", 200*time.Millisecond)
+			if test.wantErr != (err != nil) {
+				t.Errorf("WaitDeleted() = %v, wantErr %v", err, test.wantErr)
+			}
+			if test.wantErr && !errors.Is(err, wait.ErrWaitTimeout) {
+				t.Errorf("WaitDeleted() = %v, want wait.ErrWaitTimeout", err)
+			}
+		})
+	}
+}
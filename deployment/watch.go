@@ -1,7 +1,10 @@
 package deployment
 
 import (
-	log "github.com/sirupsen/logrus"
+	"fmt"
+
+	appsv1 "k8s.io/api/apps/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/fields"
 	"k8s.io/apimachinery/pkg/watch"
@@ -21,7 +24,7 @@ import (
 //  * If Event.Type is Error: *api.Status is recommended; other types may make sense
 //    depending on context.
 func (h *Handler) Watch(addFunc, modifyFunc, deleteFunc func(obj interface{})) error {
-	return h.WithNamespace(metav1.NamespaceAll).WatchByLabel("", addFunc, deleteFunc, modifyFunc)
+	return h.WithNamespace(metav1.NamespaceAll).WatchByLabel("", addFunc, modifyFunc, deleteFunc)
 }
 
 // WatchByNamespace watch all deployment resources in the specified namespace.
@@ -39,7 +42,7 @@ func (h *Handler) WatchByNamespace(namespace string, addFunc, modifyFunc, delete
 	if len(namespace) == 0 {
 		namespace = metav1.NamespaceDefault
 	}
-	return h.WithNamespace(namespace).WatchByLabel("", addFunc, deleteFunc, modifyFunc)
+	return h.WithNamespace(namespace).WatchByLabel("", addFunc, modifyFunc, deleteFunc)
 }
 
 // WatchByName watch a single deployment reseource.
@@ -97,36 +100,142 @@ func (h *Handler) WatchByField(field string, addFunc, modifyFunc, deleteFunc fun
 }
 
 // watchDeployment watch deployment resources according to listOptions.
+// It reconnects to kubernetes API server if the server closes the connection,
+// and returns the handler context error after the handler context is done.
+//
+// The watch is resumed from the last seen resourceVersion(including the one
+// from bookmark events) after reconnecting, so no event is replayed or missed.
+// If the resourceVersion is too old(410 Gone), the deployments are listed again
+// and compared with the known deployments, only the changes missed in the gap
+// are passed to addFunc, modifyFunc or deleteFunc, see syncList.
 func (h *Handler) watchDeployment(listOptions metav1.ListOptions,
 	addFunc, modifyFunc, deleteFunc func(obj interface{})) (err error) {
 
+	// known is the last seen state of the deployments, keyed by namespace/name.
+	known := make(map[string]*appsv1.Deployment)
+	listOptions.AllowWatchBookmarks = true
 	var watcher watch.Interface
 	// if event channel is closed, it means the server has closed the connection,
 	// reconnect to kubernetes API server.
 	for {
+		expired := false
 		if watcher, err = h.clientset.AppsV1().Deployments(h.namespace).Watch(h.ctx, listOptions); err != nil {
-			return err
+			// the handler context is done, stop watching.
+			if h.ctx.Err() != nil {
+				return h.ctx.Err()
+			}
+			if !isExpired(err) || listOptions.ResourceVersion == "" {
+				return err
+			}
+			expired = true
 		}
 		// kubernetes retains the resource event history, which includes this
 		// initial event, so that when our program first start, we are automatically
 		// notified of the deployment existence and current state.
 		// There we will not ignore the first resource added event.
-		for event := range watcher.ResultChan() {
-			switch event.Type {
-			case watch.Added:
-				addFunc(event.Object)
-			case watch.Modified:
-				modifyFunc(event.Object)
-			case watch.Deleted:
-				deleteFunc(event.Object)
-			case watch.Bookmark:
-				log.Debug("watch deployment: bookmark")
-			case watch.Error:
-				log.Debug("watch deployment: error")
+	events:
+		for !expired {
+			select {
+			case <-h.ctx.Done():
+				watcher.Stop()
+				return h.ctx.Err()
+			case event, ok := <-watcher.ResultChan():
+				if !ok {
+					break events
+				}
+				if event.Type == watch.Error {
+					if isExpired(k8serrors.FromObject(event.Object)) {
+						expired = true
+						break events
+					}
+					h.log().Debug("watch deployment: error")
+					continue
+				}
+				deploy, ok := event.Object.(*appsv1.Deployment)
+				if !ok {
+					h.log().Debug(fmt.Sprintf("watch deployment: unexpected object type %T", event.Object))
+					continue
+				}
+				listOptions.ResourceVersion = deploy.ResourceVersion
+				key := deploy.Namespace + "/" + deploy.Name
+				switch event.Type {
+				case watch.Added:
+					known[key] = deploy
+					addFunc(deploy)
+				case watch.Modified:
+					known[key] = deploy
+					modifyFunc(deploy)
+				case watch.Deleted:
+					delete(known, key)
+					deleteFunc(deploy)
+				case watch.Bookmark:
+					h.log().Debug("watch deployment: bookmark")
+				}
+			}
+		}
+		if watcher != nil {
+			// If event channel is closed, it means the server has closed the connection
+			h.log().Debug("watch deployment: reconnect to kubernetes")
+			watcher.Stop()
+		}
+		if expired {
+			h.log().Debug("watch deployment: resource version expired, relist")
+			if listOptions.ResourceVersion, err = h.relist(listOptions, known, addFunc, modifyFunc, deleteFunc); err != nil {
+				if h.ctx.Err() != nil {
+					return h.ctx.Err()
+				}
+				return err
 			}
 		}
-		// If event channel is closed, it means the server has closed the connection
-		log.Debug("watch deployment: reconnect to kubernetes")
-		watcher.Stop()
 	}
 }
+
+// relist lists the deployments selected by listOptions, passes the changes
+// since the known deployments to addFunc, modifyFunc or deleteFunc, see syncList,
+// and returns the resourceVersion of the list to resume the watch from.
+func (h *Handler) relist(listOptions metav1.ListOptions, known map[string]*appsv1.Deployment,
+	addFunc, modifyFunc, deleteFunc func(obj interface{})) (string, error) {
+
+	listOptions.ResourceVersion = ""
+	listOptions.TimeoutSeconds = nil
+	listOptions.AllowWatchBookmarks = false
+	deployList, err := h.clientset.AppsV1().Deployments(h.namespace).List(h.ctx, listOptions)
+	if err != nil {
+		return "", err
+	}
+	syncList(known, deployList.Items, addFunc, modifyFunc, deleteFunc)
+	return deployList.ResourceVersion, nil
+}
+
+// syncList compares the listed deployments with the known deployments, calls
+// addFunc with the new deployments, modifyFunc with the changed deployments and
+// deleteFunc with the disappeared deployments, then updates the known deployments.
+func syncList(known map[string]*appsv1.Deployment, items []appsv1.Deployment,
+	addFunc, modifyFunc, deleteFunc func(obj interface{})) {
+
+	listed := make(map[string]bool, len(items))
+	for i := range items {
+		deploy := &items[i]
+		key := deploy.Namespace + "/" + deploy.Name
+		listed[key] = true
+		old, ok := known[key]
+		known[key] = deploy
+		switch {
+		case !ok:
+			addFunc(deploy)
+		case old.ResourceVersion != deploy.ResourceVersion:
+			modifyFunc(deploy)
+		}
+	}
+	for key, deploy := range known {
+		if !listed[key] {
+			delete(known, key)
+			deleteFunc(deploy)
+		}
+	}
+}
+
+// isExpired returns true if the err means the resourceVersion is too old.
+func isExpired(err error) bool {
+	return k8serrors.IsResourceExpired(err) || k8serrors.IsGone(err)
+}
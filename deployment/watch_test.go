@@ -0,0 +1,178 @@
+package deployment
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"reflect"
+	"sync"
+	"testing"
+	"time"
+
+	appsv1 "k8s.io/api/apps/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+func TestWatchByLabel(t *testing.T) {
+	newEvent := func(eventType, name, resourceVersion string) metav1.WatchEvent {
+		deploy := &appsv1.Deployment{
+			TypeMeta: metav1.TypeMeta{Kind: "Deployment", APIVersion: "apps/v1"},
+			ObjectMeta: metav1.ObjectMeta{
+				Name:            name,
+				Namespace:       "test",
+				ResourceVersion: resourceVersion,
+				Labels:          map[string]string{"app": name},
+			},
+		}
+		data, _ := json.Marshal(deploy)
+		return metav1.WatchEvent{Type: eventType, Object: runtime.RawExtension{Raw: data}}
+	}
+	// the first connection is closed by the server after the ADDED events,
+	// the rest events are sent after reconnecting.
+	connections := [][]metav1.WatchEvent{
+		{newEvent("ADDED", "nginx", "1"), newEvent("ADDED", "redis", "2")},
+		{newEvent("MODIFIED", "redis", "3"), newEvent("MODIFIED", "nginx", "4"), newEvent("DELETED", "nginx", "5")},
+	}
+	var (
+		mu    sync.Mutex
+		count int
+	)
+	h := newTestHandler(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/apis/apps/v1/namespaces/test/deployments" || r.URL.Query().Get("watch") != "true" {
+			t.Errorf("unexpected request %s %s", r.Method, r.URL)
+		}
+		selector, err := labels.Parse(r.URL.Query().Get("labelSelector"))
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		mu.Lock()
+		connection := count
+		count++
+		mu.Unlock()
+
+		w.Header().Set("Content-Type", "application/json")
+		if connection < len(connections) {
+			for _, event := range connections[connection] {
+				deploy := &appsv1.Deployment{}
+				json.Unmarshal(event.Object.Raw, deploy)
+				if selector.Matches(labels.Set(deploy.Labels)) {
+					json.NewEncoder(w).Encode(event)
+				}
+			}
+			w.(http.Flusher).Flush()
+		}
+		if connection == 0 {
+			return
+		}
+		<-r.Context().Done()
+	})
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	h.ctx = ctx
+
+	type result struct {
+		eventType       string
+		resourceVersion string
+	}
+	results := make(chan result, 3)
+	callback := func(eventType string) func(obj interface{}) {
+		return func(obj interface{}) {
+			deploy, ok := obj.(*appsv1.Deployment)
+			if !ok {
+				t.Errorf("callback got %T, want *appsv1.Deployment", obj)
+				return
+			}
+			if deploy.Name != "nginx" {
+				t.Errorf("callback got deployment %s, want nginx", deploy.Name)
+			}
+			results <- result{eventType, deploy.ResourceVersion}
+		}
+	}
+	errCh := make(chan error, 1)
+	go func() {
+		errCh <- h.WatchByLabel("app=nginx", callback("ADDED"), callback("MODIFIED"), callback("DELETED"))
+	}()
+
+	for _, want := range []result{{"ADDED", "1"}, {"MODIFIED", "4"}, {"DELETED", "5"}} {
+		select {
+		case got := <-results:
+			if got != want {
+				t.Errorf("callback got %v, want %v", got, want)
+			}
+		case <-time.After(5 * time.Second):
+			t.Fatalf("callback isn't called for %v", want)
+		}
+	}
+	cancel()
+	select {
+	case err := <-errCh:
+		if err != context.Canceled {
+			t.Errorf("WatchByLabel() = %v, want context.Canceled", err)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("WatchByLabel() didn't return after the context is cancelled")
+	}
+}
+
+func TestWatchRelist(t *testing.T) {
+	// "a" is deleted, "b" is modified and "c" is created while the watch is
+	// disconnected, the reconnecting watch answers 410 Gone, the changes in
+	// the gap are found by listing the deployments again.
+	var (
+		mu      sync.Mutex
+		watches []string
+	)
+	h := newTestHandler(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		query := r.URL.Query()
+		if query.Get("watch") != "true" {
+			if selector := query.Get("labelSelector"); selector != "team=dev" {
+				t.Errorf("list labelSelector = %q, want team=dev", selector)
+			}
+			fmt.Fprintln(w, `{"kind":"DeploymentList","apiVersion":"apps/v1","metadata":{"resourceVersion":"30"},"items":[`+
+				`{"metadata":{"name":"b","namespace":"test","resourceVersion":"25"}},`+
+				`{"metadata":{"name":"c","namespace":"test","resourceVersion":"26"}}]}`)
+			return
+		}
+		if query.Get("allowWatchBookmarks") != "true" {
+			t.Error("the watch doesn't allow bookmarks")
+		}
+		mu.Lock()
+		watches = append(watches, query.Get("resourceVersion"))
+		count := len(watches)
+		mu.Unlock()
+		switch count {
+		case 1:
+			fmt.Fprintln(w, `{"type":"ADDED","object":{"kind":"Deployment","apiVersion":"apps/v1","metadata":{"name":"a","namespace":"test","resourceVersion":"1"}}}`)
+			fmt.Fprintln(w, `{"type":"ADDED","object":{"kind":"Deployment","apiVersion":"apps/v1","metadata":{"name":"b","namespace":"test","resourceVersion":"2"}}}`)
+			fmt.Fprintln(w, `{"type":"BOOKMARK","object":{"kind":"Deployment","apiVersion":"apps/v1","metadata":{"resourceVersion":"10"}}}`)
+		case 2:
+			fmt.Fprintln(w, `{"type":"ERROR","object":{"kind":"Status","apiVersion":"v1","status":"Failure","reason":"Expired","code":410}}`)
+		default:
+			http.Error(w, "stop watching", http.StatusForbidden)
+		}
+	})
+
+	var events []string
+	record := func(eventType string) func(obj interface{}) {
+		return func(obj interface{}) {
+			deploy := obj.(*appsv1.Deployment)
+			events = append(events, eventType+" "+deploy.Name+"@"+deploy.ResourceVersion)
+		}
+	}
+	if err := h.WatchByLabel("team=dev", record("ADDED"), record("MODIFIED"), record("DELETED")); err == nil {
+		t.Fatal("expected the last watch to fail")
+	}
+	want := []string{"ADDED a@1", "ADDED b@2", "MODIFIED b@25", "ADDED c@26", "DELETED a@1"}
+	if !reflect.DeepEqual(events, want) {
+		t.Errorf("events = %q, want %q", events, want)
+	}
+	// the watch is resumed from the bookmark, then from the list.
+	if wantWatches := []string{"", "10", "30"}; !reflect.DeepEqual(watches, wantWatches) {
+		t.Errorf("watch resourceVersions = %q, want %q", watches, wantWatches)
+	}
+}
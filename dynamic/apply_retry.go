@@ -0,0 +1,72 @@
+package dynamic
+
+import (
+	stderrors "errors"
+	"fmt"
+	"time"
+
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+const (
+	// retryInitialDelay is the first delay between two apply attempts.
+	retryInitialDelay = 500 * time.Millisecond
+	// retryMaxDelay is the max delay between two apply attempts.
+	retryMaxDelay = 5 * time.Second
+)
+
+// ApplyWithRetry applies unstructured k8s resource just like Apply, but if the
+// k8s resource depends on another k8s resource that is not present yet, eg:
+// the namespace is not created or the CRD is not registered, it retries with
+// exponential backoff until the dependency shows up or the retry window elapses.
+//
+// It smooths the eventual-consistency during bulk apply where the k8s resources
+// are not well ordered. Genuinely invalid k8s resource is never retried and
+// the error is returned immediately.
+// If the window is zero, ApplyWithRetry is the same as Apply.
+func (h *Handler) ApplyWithRetry(obj interface{}, window time.Duration) (*unstructured.Unstructured, error) {
+	deadline := time.Now().Add(window)
+	delay := retryInitialDelay
+	for {
+		unstructObj, err := h.Apply(obj)
+		if err == nil || !IsDependencyMissing(err) || time.Now().Add(delay).After(deadline) {
+			return unstructObj, err
+		}
+		// the CRD may be registered after the RESTMapper cached the discovery
+		// information, reset the RESTMapper to discover the CRD again.
+		if meta.IsNoMatchError(err) {
+			if resettable, ok := h.restMapper.(meta.ResettableRESTMapper); ok {
+				resettable.Reset()
+			}
+		}
+		select {
+		case <-h.ctx.Done():
+			return nil, fmt.Errorf("%v: %w", err, h.ctx.Err())
+		case <-time.After(delay):
+		}
+		if delay *= 2; delay > retryMaxDelay {
+			delay = retryMaxDelay
+		}
+	}
+}
+
+// IsDependencyMissing returns true if the error is caused by a k8s resource
+// that the applied k8s resource depends on is not present, that is the
+// namespace is not found or the kind is not registered(the CRD is not created).
+// The other NotFound errors are not caused by a missing dependency.
+func IsDependencyMissing(err error) bool {
+	if meta.IsNoMatchError(err) {
+		return true
+	}
+	if !errors.IsNotFound(err) {
+		return false
+	}
+	var status errors.APIStatus
+	if !stderrors.As(err, &status) {
+		return false
+	}
+	details := status.Status().Details
+	return details != nil && details.Group == "" && details.Kind == "namespaces"
+}
@@ -0,0 +1,99 @@
+package dynamic
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/dynamic/fake"
+	k8stesting "k8s.io/client-go/testing"
+)
+
+var (
+	errNamespaceNotFound = k8serrors.NewNotFound(schema.GroupResource{Resource: "namespaces"}, "test")
+	errSecretNotFound    = k8serrors.NewNotFound(schema.GroupResource{Resource: "secrets"}, "tls")
+)
+
+func TestIsDependencyMissing(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		want bool
+	}{
+		{"nil", nil, false},
+		{"namespace not found", errNamespaceNotFound, true},
+		{"wrapped namespace not found", &wrappedError{errNamespaceNotFound}, true},
+		{"kind not registered", &meta.NoKindMatchError{GroupKind: schema.GroupKind{Group: "example.com", Kind: "Foo"}}, true},
+		{"other resource not found", errSecretNotFound, false},
+		{"namespaces of other group not found", k8serrors.NewNotFound(schema.GroupResource{Group: "example.com", Resource: "namespaces"}, "test"), false},
+		{"invalid", k8serrors.NewBadRequest("invalid"), false},
+		{"other error", errors.New("connection refused"), false},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if got := IsDependencyMissing(test.err); got != test.want {
+				t.Errorf("IsDependencyMissing(%v) = %v, want %v", test.err, got, test.want)
+			}
+		})
+	}
+}
+
+// wrappedError wraps the error like fmt.Errorf("%w") does.
+type wrappedError struct{ err error }
+
+func (e *wrappedError) Error() string { return "apply: " + e.err.Error() }
+func (e *wrappedError) Unwrap() error { return e.err }
+
+func TestApplyWithRetry(t *testing.T) {
+	tests := []struct {
+		name         string
+		errs         []error
+		wantErr      error
+		wantAttempts int
+	}{
+		{"namespace created later", []error{errNamespaceNotFound}, nil, 2},
+		{"other resource not found", []error{errSecretNotFound}, errSecretNotFound, 1},
+		{"namespace never created", []error{errNamespaceNotFound, errNamespaceNotFound, errNamespaceNotFound}, errNamespaceNotFound, 2},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			h := newTestHandler()
+			attempts := 0
+			h.dynamicClient.(*fake.FakeDynamicClient).PrependReactor("create", "deployments",
+				func(k8stesting.Action) (bool, runtime.Object, error) {
+					attempts++
+					if attempts <= len(test.errs) {
+						return true, nil, test.errs[attempts-1]
+					}
+					// let the fake client create the deployment.
+					return false, nil, nil
+				})
+
+			// the window allows only one retry after retryInitialDelay.
+			_, err := h.ApplyWithRetry(newObject("apps/v1", "Deployment", "test", "nginx", nil), retryInitialDelay+retryInitialDelay/2)
+			if err != test.wantErr {
+				t.Errorf("ApplyWithRetry() = %v, want %v", err, test.wantErr)
+			}
+			if attempts != test.wantAttempts {
+				t.Errorf("apply attempts = %d, want %d", attempts, test.wantAttempts)
+			}
+		})
+	}
+}
+
+func TestApplyWithRetryZeroWindow(t *testing.T) {
+	h := newTestHandler()
+	start := time.Now()
+	// the kind isn't registered, it's never retried without the window.
+	_, err := h.ApplyWithRetry(newObject("example.com/v1", "Foo", "test", "foo", nil), 0)
+	if !meta.IsNoMatchError(err) {
+		t.Errorf("ApplyWithRetry() = %v, want NoMatch error", err)
+	}
+	if elapsed := time.Since(start); elapsed >= retryInitialDelay {
+		t.Errorf("ApplyWithRetry() took %s, want no retry", elapsed)
+	}
+}
@@ -9,10 +9,12 @@ import (
 
 	"github.com/forbearing/k8s/types"
 	"github.com/forbearing/k8s/util/client"
+	utildiscovery "github.com/forbearing/k8s/util/discovery"
 	utilrestmapper "github.com/forbearing/k8s/util/restmapper"
 	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/discovery"
 	"k8s.io/client-go/dynamic"
 	"k8s.io/client-go/dynamic/dynamicinformer"
 	"k8s.io/client-go/kubernetes/scheme"
@@ -53,6 +55,7 @@ type Handler struct {
 	informerFactory  dynamicinformer.DynamicSharedInformerFactory
 
 	Options *types.HandlerOptions
+	logger  types.Logger
 
 	l sync.RWMutex
 }
@@ -180,6 +183,10 @@ func (in *Handler) DeepCopy() *Handler {
 	if in == nil {
 		return nil
 	}
+	// hold the read lock, so the Set* methods can't modify the handler while copying it.
+	in.l.RLock()
+	defer in.l.RUnlock()
+
 	return &Handler{
 		ctx:              in.ctx,
 		gvk:              in.gvk,
@@ -196,6 +203,7 @@ func (in *Handler) DeepCopy() *Handler {
 		informerScope:    in.informerScope,
 		tweakListOptions: in.tweakListOptions,
 		restMapper:       in.restMapper,
+		logger:           in.logger,
 		Options: &types.HandlerOptions{
 			CreateOptions: *in.Options.CreateOptions.DeepCopy(),
 			UpdateOptions: *in.Options.UpdateOptions.DeepCopy(),
@@ -261,11 +269,49 @@ func (h *Handler) SetPropagationPolicy(policy string) {
 	}
 }
 
+// SetLogger sets the logger reporting the watch events, types.DiscardLogger
+// silences them. The logrus standard logger is used by default.
+func (h *Handler) SetLogger(logger types.Logger) {
+	h.l.Lock()
+	defer h.l.Unlock()
+	h.logger = logger
+}
+
+// log returns the logger set by SetLogger, or the default logger.
+func (h *Handler) log() types.Logger {
+	h.l.RLock()
+	defer h.l.RUnlock()
+	if h.logger == nil {
+		return types.DefaultLogger()
+	}
+	return h.logger
+}
+
 // DynamicClient returns the underlying dynamic client used by this dynamic handler.
 func (h *Handler) DynamicClient() dynamic.Interface {
 	return h.dynamicClient
 }
 
+// RESTMapper returns the underlying RESTMapper used by this dynamic handler.
+func (h *Handler) RESTMapper() meta.RESTMapper {
+	return h.restMapper
+}
+
+// APIVerbs returns the verbs supported by the kubernetes apiserver for the
+// k8s resource, such as "get", "list", "watch", "create", "update", "patch"
+// and "delete". Calling this method requires WithGVK() to explicitly specify GVK.
+func (h *Handler) APIVerbs() ([]string, error) {
+	gvr, err := utilrestmapper.GVKToGVR(h.restMapper, h.gvk)
+	if err != nil {
+		return nil, err
+	}
+	discoveryClient, err := discovery.NewDiscoveryClientForConfigAndClient(h.config, h.httpClient)
+	if err != nil {
+		return nil, err
+	}
+	return utildiscovery.APIVerbs(discoveryClient, gvr)
+}
+
 // IsNamespaced() return true if the k8s object is namespace-scoped or return false.
 func (h *Handler) IsNamespaced() bool {
 	return h.isNamespaced
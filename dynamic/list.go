@@ -82,6 +82,20 @@ func (h *Handler) ListAll() ([]*unstructured.Unstructured, error) {
 	return extractList(h.dynamicClient.Resource(h.gvr).List(h.ctx, *listOptions))
 }
 
+// ListWithOptions list k8s objects with the provided list options, the k8s
+// objects are listed in the handler namespace if they are namespace-scoped,
+// the namespace is ignored if they are cluster-scoped.
+// Calling this method requires WithGVK() to explicitly specify GVK.
+func (h *Handler) ListWithOptions(opts metav1.ListOptions) ([]*unstructured.Unstructured, error) {
+	if err := h.getGVRAndNamespaceScope(); err != nil {
+		return nil, err
+	}
+	if h.isNamespaced {
+		return extractList(h.dynamicClient.Resource(h.gvr).Namespace(h.namespace).List(h.ctx, opts))
+	}
+	return extractList(h.dynamicClient.Resource(h.gvr).List(h.ctx, opts))
+}
+
 // extractList
 func extractList(unstructList *unstructured.UnstructuredList, err error) ([]*unstructured.Unstructured, error) {
 	if err != nil {
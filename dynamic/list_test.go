@@ -0,0 +1,78 @@
+package dynamic
+
+import (
+	"reflect"
+	"sort"
+	"testing"
+
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+func newObject(apiVersion, kind, namespace, name string, labels map[string]string) *unstructured.Unstructured {
+	obj := &unstructured.Unstructured{}
+	obj.SetAPIVersion(apiVersion)
+	obj.SetKind(kind)
+	obj.SetNamespace(namespace)
+	obj.SetName(name)
+	obj.SetLabels(labels)
+	return obj
+}
+
+func names(objs []*unstructured.Unstructured) []string {
+	var names []string
+	for _, obj := range objs {
+		names = append(names, obj.GetNamespace()+"/"+obj.GetName())
+	}
+	sort.Strings(names)
+	return names
+}
+
+func TestGetAndList(t *testing.T) {
+	h := newTestHandler(
+		newObject("apps/v1", "Deployment", "default", "nginx", map[string]string{"app": "nginx"}),
+		newObject("apps/v1", "Deployment", "default", "redis", map[string]string{"app": "redis"}),
+		newObject("apps/v1", "Deployment", "test", "nginx", map[string]string{"app": "nginx"}),
+		newObject("v1", "Node", "", "node1", map[string]string{"role": "master"}),
+		newObject("v1", "Node", "", "node2", map[string]string{"role": "worker"}),
+	)
+	deployGVK := schema.GroupVersionKind{Group: "apps", Version: "v1", Kind: "Deployment"}
+	nodeGVK := schema.GroupVersionKind{Version: "v1", Kind: "Node"}
+
+	tests := []struct {
+		name      string
+		handler   *Handler
+		get       string
+		opts      metav1.ListOptions
+		wantNames []string
+	}{
+		{"namespaced", h.WithGVK(deployGVK), "nginx", metav1.ListOptions{LabelSelector: "app=nginx"}, []string{"default/nginx"}},
+		{"namespaced in other namespace", h.WithNamespace("test").WithGVK(deployGVK), "nginx", metav1.ListOptions{}, []string{"test/nginx"}},
+		{"cluster-scoped", h.WithNamespace("test").WithGVK(nodeGVK), "node2", metav1.ListOptions{}, []string{"/node1", "/node2"}},
+		{"cluster-scoped by label", h.WithGVK(nodeGVK), "node1", metav1.ListOptions{LabelSelector: "role=master"}, []string{"/node1"}},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			obj, err := test.handler.Get(test.get)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if obj.GetName() != test.get {
+				t.Errorf("Get() = %s, want %s", obj.GetName(), test.get)
+			}
+			objs, err := test.handler.ListWithOptions(test.opts)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got := names(objs); !reflect.DeepEqual(got, test.wantNames) {
+				t.Errorf("ListWithOptions() = %v, want %v", got, test.wantNames)
+			}
+		})
+	}
+
+	if _, err := h.WithGVK(deployGVK).Get("missing"); !k8serrors.IsNotFound(err) {
+		t.Errorf("get missing deployment: got %v, want NotFound error", err)
+	}
+}
@@ -0,0 +1,108 @@
+package dynamic
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	utilerrors "k8s.io/apimachinery/pkg/util/errors"
+	utilyaml "k8s.io/apimachinery/pkg/util/yaml"
+)
+
+// ApplyFromReader applies all the k8s resources decoded from the yaml or json
+// documents stream, work like `kubectl apply -f`. The documents are separated
+// by "---", and a "List" document is expanded to its items.
+//
+// The GVK and GVR of every k8s resource are found by RESTMapper, so the stream
+// can contain different kinds of k8s resources, it's not required to call WithGVK().
+// A failure of one k8s resource doesn't stop the others, the applied k8s resources
+// are returned in the stream order, together with all the errors aggregated.
+func (h *Handler) ApplyFromReader(r io.Reader) ([]*unstructured.Unstructured, error) {
+	return h.eachDocument(r, h.applyUnstructured)
+}
+
+// CreateFromReader creates all the k8s resources decoded from the yaml or json
+// documents stream, work like `kubectl create -f`. See ApplyFromReader for
+// how the stream is handled.
+func (h *Handler) CreateFromReader(r io.Reader) ([]*unstructured.Unstructured, error) {
+	return h.eachDocument(r, h.createUnstructured)
+}
+
+// DeleteFromReader deletes all the k8s resources decoded from the yaml or json
+// documents stream, work like `kubectl delete -f`. See ApplyFromReader for
+// how the stream is handled.
+func (h *Handler) DeleteFromReader(r io.Reader) error {
+	_, err := h.eachDocument(r, func(obj *unstructured.Unstructured) (*unstructured.Unstructured, error) {
+		return obj, h.deleteUnstructured(obj)
+	})
+	return err
+}
+
+// eachDocument decodes all the documents from the stream first, so a malformed
+// stream is rejected before any k8s resource is touched, then calls fn for
+// each k8s resource in the stream order.
+func (h *Handler) eachDocument(r io.Reader, fn func(*unstructured.Unstructured) (*unstructured.Unstructured, error)) ([]*unstructured.Unstructured, error) {
+	objs, err := decodeDocuments(r)
+	if err != nil {
+		return nil, err
+	}
+	var (
+		results []*unstructured.Unstructured
+		errs    []error
+	)
+	for _, obj := range objs {
+		result, err := fn(obj)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("%s/%s: %w", obj.GetKind(), obj.GetName(), err))
+			continue
+		}
+		results = append(results, result)
+	}
+	return results, utilerrors.NewAggregate(errs)
+}
+
+// decodeDocuments decodes every yaml or json document read from r to
+// unstructured object, the empty documents are skipped and the "List"
+// documents are expanded to their items.
+func decodeDocuments(r io.Reader) ([]*unstructured.Unstructured, error) {
+	var objs []*unstructured.Unstructured
+	reader := utilyaml.NewYAMLReader(bufio.NewReader(r))
+	for {
+		data, err := reader.Read()
+		if err == io.EOF {
+			return objs, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		if len(bytes.TrimSpace(data)) == 0 {
+			continue
+		}
+		jsonData, err := utilyaml.ToJSON(data)
+		if err != nil {
+			return nil, err
+		}
+		// the document only contains comments.
+		if bytes.Equal(bytes.TrimSpace(jsonData), []byte("null")) {
+			continue
+		}
+		obj := &unstructured.Unstructured{}
+		if err := json.Unmarshal(jsonData, obj); err != nil {
+			return nil, err
+		}
+		if !obj.IsList() {
+			objs = append(objs, obj)
+			continue
+		}
+		if err := obj.EachListItem(func(item runtime.Object) error {
+			objs = append(objs, item.(*unstructured.Unstructured))
+			return nil
+		}); err != nil {
+			return nil, err
+		}
+	}
+}
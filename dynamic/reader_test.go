@@ -0,0 +1,111 @@
+package dynamic
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/forbearing/k8s/types"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/dynamic/fake"
+)
+
+// stream contains a Deployment and a Service, the Service has no namespace.
+const stream = `# the comments are ignored.
+apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: nginx
+  namespace: test
+spec:
+  replicas: 1
+---
+---
+apiVersion: v1
+kind: Service
+metadata:
+  name: nginx
+spec:
+  ports:
+  - port: 80
+`
+
+var (
+	deployGVR  = schema.GroupVersionResource{Group: "apps", Version: "v1", Resource: "deployments"}
+	serviceGVR = schema.GroupVersionResource{Version: "v1", Resource: "services"}
+	nodeGVR    = schema.GroupVersionResource{Version: "v1", Resource: "nodes"}
+)
+
+// newTestHandler creates a dynamic handler in namespace "default", whose
+// RESTMapper knows Deployment, Service and Node, and whose dynamic client is
+// fake and holds the objs.
+func newTestHandler(objs ...runtime.Object) *Handler {
+	restMapper := meta.NewDefaultRESTMapper(nil)
+	restMapper.Add(schema.GroupVersionKind{Group: "apps", Version: "v1", Kind: "Deployment"}, meta.RESTScopeNamespace)
+	restMapper.Add(schema.GroupVersionKind{Version: "v1", Kind: "Service"}, meta.RESTScopeNamespace)
+	restMapper.Add(schema.GroupVersionKind{Version: "v1", Kind: "Node"}, meta.RESTScopeRoot)
+	listKinds := map[schema.GroupVersionResource]string{
+		deployGVR:  "DeploymentList",
+		serviceGVR: "ServiceList",
+		nodeGVR:    "NodeList",
+	}
+	return &Handler{
+		ctx:           context.Background(),
+		namespace:     metav1.NamespaceDefault,
+		dynamicClient: fake.NewSimpleDynamicClientWithCustomListKinds(runtime.NewScheme(), listKinds, objs...),
+		restMapper:    restMapper,
+		Options:       &types.HandlerOptions{},
+	}
+}
+
+func TestFromReader(t *testing.T) {
+	h := newTestHandler()
+
+	objs, err := h.CreateFromReader(strings.NewReader(stream))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(objs) != 2 || objs[0].GetKind() != "Deployment" || objs[1].GetKind() != "Service" {
+		t.Fatalf("created %d objects, want a Deployment and a Service", len(objs))
+	}
+	if _, err := h.dynamicClient.Resource(deployGVR).Namespace("test").Get(h.ctx, "nginx", metav1.GetOptions{}); err != nil {
+		t.Errorf("get created deployment: %v", err)
+	}
+	if _, err := h.dynamicClient.Resource(serviceGVR).Namespace(metav1.NamespaceDefault).Get(h.ctx, "nginx", metav1.GetOptions{}); err != nil {
+		t.Errorf("get created service: %v", err)
+	}
+
+	// creating the existing objects fails, but applying them succeeds.
+	if _, err := h.CreateFromReader(strings.NewReader(stream)); err == nil || !strings.Contains(err.Error(), "Deployment/nginx") ||
+		!strings.Contains(err.Error(), "Service/nginx") {
+		t.Errorf("create the existing objects: got %v, want errors of both objects", err)
+	}
+	if objs, err = h.ApplyFromReader(strings.NewReader(stream)); err != nil || len(objs) != 2 {
+		t.Fatalf("apply the existing objects: got %d objects, %v", len(objs), err)
+	}
+
+	if err := h.DeleteFromReader(strings.NewReader(stream)); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := h.dynamicClient.Resource(deployGVR).Namespace("test").Get(h.ctx, "nginx", metav1.GetOptions{}); !k8serrors.IsNotFound(err) {
+		t.Errorf("get deleted deployment: got %v, want NotFound error", err)
+	}
+	if _, err := h.dynamicClient.Resource(serviceGVR).Namespace(metav1.NamespaceDefault).Get(h.ctx, "nginx", metav1.GetOptions{}); !k8serrors.IsNotFound(err) {
+		t.Errorf("get deleted service: got %v, want NotFound error", err)
+	}
+}
+
+func TestFromReaderMalformed(t *testing.T) {
+	h := newTestHandler()
+	if _, err := h.ApplyFromReader(strings.NewReader(stream + "---\nkind: [\n")); err == nil {
+		t.Fatal("expected the malformed stream to fail")
+	}
+	// nothing is applied if any document is malformed.
+	if _, err := h.dynamicClient.Resource(deployGVR).Namespace("test").Get(h.ctx, "nginx", metav1.GetOptions{}); !k8serrors.IsNotFound(err) {
+		t.Errorf("get deployment: got %v, want NotFound error", err)
+	}
+}
@@ -1,8 +1,9 @@
 package dynamic
 
 import (
+	"fmt"
+
 	utilrestmapper "github.com/forbearing/k8s/util/restmapper"
-	log "github.com/sirupsen/logrus"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/fields"
 	"k8s.io/apimachinery/pkg/watch"
@@ -12,14 +13,14 @@ import (
 // You should always specify the GroupVersionKind with WithGVK() method.
 //
 // Object as the parameter of addFunc, modifyFunc, deleteFunc:
-//  * If Event.Type is Added or Modified: the new state of the object.
-//  * If Event.Type is Deleted: the state of the object immediately before deletion.
-//  * If Event.Type is Bookmark: the object (instance of a type being watched) where
-//    only ResourceVersion field is set. On successful restart of watch from a
-//    bookmark resourceVersion, client is guaranteed to not get repeat event
-//    nor miss any events.
-//  * If Event.Type is Error: *api.Status is recommended; other types may make sense
-//    depending on context.
+//   - If Event.Type is Added or Modified: the new state of the object.
+//   - If Event.Type is Deleted: the state of the object immediately before deletion.
+//   - If Event.Type is Bookmark: the object (instance of a type being watched) where
+//     only ResourceVersion field is set. On successful restart of watch from a
+//     bookmark resourceVersion, client is guaranteed to not get repeat event
+//     nor miss any events.
+//   - If Event.Type is Error: *api.Status is recommended; other types may make sense
+//     depending on context.
 func (h *Handler) Watch(addFunc, modifyFunc, deleteFunc func(obj interface{})) error {
 	return h.WithNamespace(metav1.NamespaceAll).WatchByLabel("", addFunc, modifyFunc, deleteFunc)
 }
@@ -28,14 +29,14 @@ func (h *Handler) Watch(addFunc, modifyFunc, deleteFunc func(obj interface{})) e
 // You should always specify the GroupVersionKind with WithGVK() method.
 //
 // Object as the parameter of addFunc, modifyFunc, deleteFunc:
-//  * If Event.Type is Added or Modified: the new state of the object.
-//  * If Event.Type is Deleted: the state of the object immediately before deletion.
-//  * If Event.Type is Bookmark: the object (instance of a type being watched) where
-//    only ResourceVersion field is set. On successful restart of watch from a
-//    bookmark resourceVersion, client is guaranteed to not get repeat event
-//    nor miss any events.
-//  * If Event.Type is Error: *api.Status is recommended; other types may make sense
-//    depending on context.
+//   - If Event.Type is Added or Modified: the new state of the object.
+//   - If Event.Type is Deleted: the state of the object immediately before deletion.
+//   - If Event.Type is Bookmark: the object (instance of a type being watched) where
+//     only ResourceVersion field is set. On successful restart of watch from a
+//     bookmark resourceVersion, client is guaranteed to not get repeat event
+//     nor miss any events.
+//   - If Event.Type is Error: *api.Status is recommended; other types may make sense
+//     depending on context.
 func (h *Handler) WatchByNamespace(namespace string, addFunc, modifyFunc, deleteFunc func(obj interface{})) error {
 	if len(namespace) == 0 {
 		namespace = metav1.NamespaceDefault
@@ -47,14 +48,14 @@ func (h *Handler) WatchByNamespace(namespace string, addFunc, modifyFunc, delete
 // You should always specify the GroupVersionKind with WithGVK() method.
 //
 // Object as the parameter of addFunc, modifyFunc, deleteFunc:
-//  * If Event.Type is Added or Modified: the new state of the object.
-//  * If Event.Type is Deleted: the state of the object immediately before deletion.
-//  * If Event.Type is Bookmark: the object (instance of a type being watched) where
-//    only ResourceVersion field is set. On successful restart of watch from a
-//    bookmark resourceVersion, client is guaranteed to not get repeat event
-//    nor miss any events.
-//  * If Event.Type is Error: *api.Status is recommended; other types may make sense
-//    depending on context.
+//   - If Event.Type is Added or Modified: the new state of the object.
+//   - If Event.Type is Deleted: the state of the object immediately before deletion.
+//   - If Event.Type is Bookmark: the object (instance of a type being watched) where
+//     only ResourceVersion field is set. On successful restart of watch from a
+//     bookmark resourceVersion, client is guaranteed to not get repeat event
+//     nor miss any events.
+//   - If Event.Type is Error: *api.Status is recommended; other types may make sense
+//     depending on context.
 func (h *Handler) WatchByName(name string, addFunc, modifyFunc, deleteFunc func(obj interface{})) error {
 	listOptions := metav1.SingleObject(metav1.ObjectMeta{Name: name, Namespace: h.namespace})
 	listOptions.TimeoutSeconds = new(int64)
@@ -67,14 +68,14 @@ func (h *Handler) WatchByName(name string, addFunc, modifyFunc, deleteFunc func(
 // You should always specify the GroupVersionKind with WithGVK() method.
 //
 // Object as the parameter of addFunc, modifyFunc, deleteFunc:
-//  * If Event.Type is Added or Modified: the new state of the object.
-//  * If Event.Type is Deleted: the state of the object immediately before deletion.
-//  * If Event.Type is Bookmark: the object (instance of a type being watched) where
-//    only ResourceVersion field is set. On successful restart of watch from a
-//    bookmark resourceVersion, client is guaranteed to not get repeat event
-//    nor miss any events.
-//  * If Event.Type is Error: *api.Status is recommended; other types may make sense
-//    depending on context.
+//   - If Event.Type is Added or Modified: the new state of the object.
+//   - If Event.Type is Deleted: the state of the object immediately before deletion.
+//   - If Event.Type is Bookmark: the object (instance of a type being watched) where
+//     only ResourceVersion field is set. On successful restart of watch from a
+//     bookmark resourceVersion, client is guaranteed to not get repeat event
+//     nor miss any events.
+//   - If Event.Type is Error: *api.Status is recommended; other types may make sense
+//     depending on context.
 func (h *Handler) WatchByLabel(labels string, addFunc, modifyFunc, deleteFunc func(obj interface{})) error {
 	return h.watchUnstructuredObj(
 		metav1.ListOptions{LabelSelector: labels, TimeoutSeconds: new(int64)},
@@ -86,14 +87,14 @@ func (h *Handler) WatchByLabel(labels string, addFunc, modifyFunc, deleteFunc fu
 // You should always specify the GroupVersionKind with WithGVK() method.
 //
 // Object as the parameter of addFunc, modifyFunc, deleteFunc:
-//  * If Event.Type is Added or Modified: the new state of the object.
-//  * If Event.Type is Deleted: the state of the object immediately before deletion.
-//  * If Event.Type is Bookmark: the object (instance of a type being watched) where
-//    only ResourceVersion field is set. On successful restart of watch from a
-//    bookmark resourceVersion, client is guaranteed to not get repeat event
-//    nor miss any events.
-//  * If Event.Type is Error: *api.Status is recommended; other types may make sense
-//    depending on context.
+//   - If Event.Type is Added or Modified: the new state of the object.
+//   - If Event.Type is Deleted: the state of the object immediately before deletion.
+//   - If Event.Type is Bookmark: the object (instance of a type being watched) where
+//     only ResourceVersion field is set. On successful restart of watch from a
+//     bookmark resourceVersion, client is guaranteed to not get repeat event
+//     nor miss any events.
+//   - If Event.Type is Error: *api.Status is recommended; other types may make sense
+//     depending on context.
 func (h *Handler) WatchByField(field string, addFunc, modifyFunc, deleteFunc func(obj interface{})) error {
 	fieldSelector, err := fields.ParseSelector(field)
 	if err != nil {
@@ -140,13 +141,13 @@ func (h *Handler) watchUnstructuredObj(listOptions metav1.ListOptions,
 			case watch.Deleted:
 				deleteFunc(event.Object)
 			case watch.Bookmark:
-				log.Debugf("watch %s: bookmark", h.gvr.Resource)
+				h.log().Debug(fmt.Sprintf("watch %s: bookmark", h.gvr.Resource))
 			case watch.Error:
-				log.Debugf("watch %s: error", h.gvr.Resource)
+				h.log().Debug(fmt.Sprintf("watch %s: error", h.gvr.Resource))
 			}
 		}
 		// If event channel is closed, it means the server has closed the connection
-		log.Debugf("watch %s: reconnect to kubernetes", h.gvr.Resource)
+		h.log().Debug(fmt.Sprintf("watch %s: reconnect to kubernetes", h.gvr.Resource))
 		watcher.Stop()
 	}
 }
@@ -0,0 +1,91 @@
+package endpointslice
+
+import (
+	discoveryv1 "k8s.io/api/discovery/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+// Apply applies endpointslice from type string, []byte, *discoveryv1.EndpointSlice,
+// discoveryv1.EndpointSlice, metav1.Object, runtime.Object, *unstructured.Unstructured,
+// unstructured.Unstructured or map[string]interface{}.
+func (h *Handler) Apply(obj interface{}) (*discoveryv1.EndpointSlice, error) {
+	switch val := obj.(type) {
+	case string:
+		return h.ApplyFromFile(val)
+	case []byte:
+		return h.ApplyFromBytes(val)
+	case *discoveryv1.EndpointSlice:
+		return h.ApplyFromObject(val)
+	case discoveryv1.EndpointSlice:
+		return h.ApplyFromObject(&val)
+	case *unstructured.Unstructured:
+		return h.ApplyFromUnstructured(val)
+	case unstructured.Unstructured:
+		return h.ApplyFromUnstructured(&val)
+	case map[string]interface{}:
+		return h.ApplyFromMap(val)
+	case metav1.Object, runtime.Object:
+		return h.ApplyFromObject(val)
+	default:
+		return nil, ErrInvalidApplyType
+	}
+}
+
+// ApplyFromFile applies endpointslice from yaml or json file.
+func (h *Handler) ApplyFromFile(filename string) (eps *discoveryv1.EndpointSlice, err error) {
+	eps, err = h.CreateFromFile(filename)
+	if k8serrors.IsAlreadyExists(err) { // if endpointslice already exist, update it.
+		eps, err = h.UpdateFromFile(filename)
+	}
+	return
+}
+
+// ApplyFromBytes pply endpointslice from bytes data.
+func (h *Handler) ApplyFromBytes(data []byte) (eps *discoveryv1.EndpointSlice, err error) {
+	eps, err = h.CreateFromBytes(data)
+	if k8serrors.IsAlreadyExists(err) {
+		eps, err = h.UpdateFromBytes(data)
+	}
+	return
+}
+
+// ApplyFromObject applies endpointslice from metav1.Object or runtime.Object.
+func (h *Handler) ApplyFromObject(obj interface{}) (*discoveryv1.EndpointSlice, error) {
+	eps, ok := obj.(*discoveryv1.EndpointSlice)
+	if !ok {
+		return nil, ErrInvalidObjectType
+	}
+	return h.applyEndpointSlice(eps)
+}
+
+// ApplyFromUnstructured applies endpointslice from *unstructured.Unstructured.
+func (h *Handler) ApplyFromUnstructured(u *unstructured.Unstructured) (*discoveryv1.EndpointSlice, error) {
+	eps := &discoveryv1.EndpointSlice{}
+	err := runtime.DefaultUnstructuredConverter.FromUnstructured(u.UnstructuredContent(), eps)
+	if err != nil {
+		return nil, err
+	}
+	return h.applyEndpointSlice(eps)
+}
+
+// ApplyFromMap applies endpointslice from map[string]interface{}.
+func (h *Handler) ApplyFromMap(u map[string]interface{}) (*discoveryv1.EndpointSlice, error) {
+	eps := &discoveryv1.EndpointSlice{}
+	err := runtime.DefaultUnstructuredConverter.FromUnstructured(u, eps)
+	if err != nil {
+		return nil, err
+	}
+	return h.applyEndpointSlice(eps)
+}
+
+// applyEndpointSlice
+func (h *Handler) applyEndpointSlice(eps *discoveryv1.EndpointSlice) (*discoveryv1.EndpointSlice, error) {
+	_, err := h.createEndpointSlice(eps)
+	if k8serrors.IsAlreadyExists(err) {
+		return h.updateEndpointSlice(eps)
+	}
+	return eps, err
+}
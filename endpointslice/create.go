@@ -0,0 +1,101 @@
+package endpointslice
+
+import (
+	"encoding/json"
+	"io/ioutil"
+
+	discoveryv1 "k8s.io/api/discovery/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/yaml"
+)
+
+// Create creates endpointslice from type string, []byte, *discoveryv1.EndpointSlice,
+// discoveryv1.EndpointSlice, metav1.Object, runtime.Object, *unstructured.Unstructured,
+// unstructured.Unstructured or map[string]interface{}.
+func (h *Handler) Create(obj interface{}) (*discoveryv1.EndpointSlice, error) {
+	switch val := obj.(type) {
+	case string:
+		return h.CreateFromFile(val)
+	case []byte:
+		return h.CreateFromBytes(val)
+	case *discoveryv1.EndpointSlice:
+		return h.CreateFromObject(val)
+	case discoveryv1.EndpointSlice:
+		return h.CreateFromObject(&val)
+	case *unstructured.Unstructured:
+		return h.CreateFromUnstructured(val)
+	case unstructured.Unstructured:
+		return h.CreateFromUnstructured(&val)
+	case map[string]interface{}:
+		return h.CreateFromMap(val)
+	case metav1.Object, runtime.Object:
+		return h.CreateFromObject(val)
+	default:
+		return nil, ErrInvalidCreateType
+	}
+}
+
+// CreateFromFile creates endpointslice from yaml or json file.
+func (h *Handler) CreateFromFile(filename string) (*discoveryv1.EndpointSlice, error) {
+	data, err := ioutil.ReadFile(filename)
+	if err != nil {
+		return nil, err
+	}
+	return h.CreateFromBytes(data)
+}
+
+// CreateFromBytes creates endpointslice from bytes data.
+func (h *Handler) CreateFromBytes(data []byte) (*discoveryv1.EndpointSlice, error) {
+	epsJson, err := yaml.ToJSON(data)
+	if err != nil {
+		return nil, err
+	}
+
+	eps := &discoveryv1.EndpointSlice{}
+	if err = json.Unmarshal(epsJson, eps); err != nil {
+		return nil, err
+	}
+	return h.createEndpointSlice(eps)
+}
+
+// CreateFromObject creates endpointslice from metav1.Object or runtime.Object.
+func (h *Handler) CreateFromObject(obj interface{}) (*discoveryv1.EndpointSlice, error) {
+	eps, ok := obj.(*discoveryv1.EndpointSlice)
+	if !ok {
+		return nil, ErrInvalidObjectType
+	}
+	return h.createEndpointSlice(eps)
+}
+
+// CreateFromUnstructured creates endpointslice from *unstructured.Unstructured.
+func (h *Handler) CreateFromUnstructured(u *unstructured.Unstructured) (*discoveryv1.EndpointSlice, error) {
+	eps := &discoveryv1.EndpointSlice{}
+	err := runtime.DefaultUnstructuredConverter.FromUnstructured(u.UnstructuredContent(), eps)
+	if err != nil {
+		return nil, err
+	}
+	return h.createEndpointSlice(eps)
+}
+
+// CreateFromMap creates endpointslice from map[string]interface{}.
+func (h *Handler) CreateFromMap(u map[string]interface{}) (*discoveryv1.EndpointSlice, error) {
+	eps := &discoveryv1.EndpointSlice{}
+	err := runtime.DefaultUnstructuredConverter.FromUnstructured(u, eps)
+	if err != nil {
+		return nil, err
+	}
+	return h.createEndpointSlice(eps)
+}
+
+// createEndpointSlice
+func (h *Handler) createEndpointSlice(eps *discoveryv1.EndpointSlice) (*discoveryv1.EndpointSlice, error) {
+	namespace := eps.GetNamespace()
+	if len(namespace) == 0 {
+		namespace = h.namespace
+	}
+	eps.ResourceVersion = ""
+	eps.UID = ""
+	return h.clientset.DiscoveryV1().EndpointSlices(namespace).Create(h.ctx, eps, h.Options.CreateOptions)
+}
@@ -0,0 +1,107 @@
+package endpointslice
+
+import (
+	"encoding/json"
+	"io/ioutil"
+
+	discoveryv1 "k8s.io/api/discovery/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/yaml"
+)
+
+// Delete deletes endpointslice from type string, []byte, *discoveryv1.EndpointSlice,
+// discoveryv1.EndpointSlice, metav1.Object, runtime.Object, *unstructured.Unstructured,
+// unstructured.Unstructured or map[string]interface{}.
+//
+// If passed parameter type is string, it will simply call DeleteByName instead of DeleteFromFile.
+// You should always explicitly call DeleteFromFile to delete a endpointslice from file path.
+func (h *Handler) Delete(obj interface{}) error {
+	switch val := obj.(type) {
+	case string:
+		return h.DeleteByName(val)
+	case []byte:
+		return h.DeleteFromBytes(val)
+	case *discoveryv1.EndpointSlice:
+		return h.DeleteFromObject(val)
+	case discoveryv1.EndpointSlice:
+		return h.DeleteFromObject(&val)
+	case *unstructured.Unstructured:
+		return h.DeleteFromUnstructured(val)
+	case unstructured.Unstructured:
+		return h.DeleteFromUnstructured(&val)
+	case map[string]interface{}:
+		return h.DeleteFromMap(val)
+	case metav1.Object, runtime.Object:
+		return h.DeleteFromObject(val)
+	default:
+		return ErrInvalidDeleteType
+	}
+}
+
+// DeleteByName deletes endpointslice by name.
+func (h *Handler) DeleteByName(name string) error {
+	return h.clientset.DiscoveryV1().EndpointSlices(h.namespace).Delete(h.ctx, name, h.Options.DeleteOptions)
+}
+
+// DeleteFromFile deletes endpointslice from yaml or json file.
+func (h *Handler) DeleteFromFile(filename string) error {
+	data, err := ioutil.ReadFile(filename)
+	if err != nil {
+		return err
+	}
+	return h.DeleteFromBytes(data)
+}
+
+// DeleteFromBytes deletes endpointslice from bytes data.
+func (h *Handler) DeleteFromBytes(data []byte) error {
+	epsJson, err := yaml.ToJSON(data)
+	if err != nil {
+		return err
+	}
+
+	eps := &discoveryv1.EndpointSlice{}
+	if err = json.Unmarshal(epsJson, eps); err != nil {
+		return err
+	}
+	return h.deleteEndpointSlice(eps)
+}
+
+// DeleteFromObject deletes endpointslice from metav1.Object or runtime.Object.
+func (h *Handler) DeleteFromObject(obj interface{}) error {
+	eps, ok := obj.(*discoveryv1.EndpointSlice)
+	if !ok {
+		return ErrInvalidObjectType
+	}
+	return h.deleteEndpointSlice(eps)
+}
+
+// DeleteFromUnstructured deletes endpointslice from *unstructured.Unstructured.
+func (h *Handler) DeleteFromUnstructured(u *unstructured.Unstructured) error {
+	eps := &discoveryv1.EndpointSlice{}
+	err := runtime.DefaultUnstructuredConverter.FromUnstructured(u.UnstructuredContent(), eps)
+	if err != nil {
+		return err
+	}
+	return h.deleteEndpointSlice(eps)
+}
+
+// DeleteFromMap deletes endpointslice from map[string]interface{}.
+func (h *Handler) DeleteFromMap(u map[string]interface{}) error {
+	eps := &discoveryv1.EndpointSlice{}
+	err := runtime.DefaultUnstructuredConverter.FromUnstructured(u, eps)
+	if err != nil {
+		return err
+	}
+	return h.deleteEndpointSlice(eps)
+}
+
+// deleteEndpointSlice
+func (h *Handler) deleteEndpointSlice(eps *discoveryv1.EndpointSlice) error {
+	namespace := eps.GetNamespace()
+	if len(namespace) == 0 {
+		namespace = h.namespace
+	}
+	return h.clientset.DiscoveryV1().EndpointSlices(namespace).Delete(h.ctx, eps.Name, h.Options.DeleteOptions)
+}
@@ -0,0 +1,13 @@
+package endpointslice
+
+import (
+	"github.com/forbearing/k8s/types"
+	discoveryv1 "k8s.io/api/discovery/v1"
+)
+
+// Generic returns the handler as a types.Handler, whose methods return
+// runtime.Object instead of *discoveryv1.EndpointSlice, so it can be used together with
+// the handlers of other resources.
+func (h *Handler) Generic() types.Handler {
+	return types.NewGeneric[*discoveryv1.EndpointSlice](h, GVK)
+}
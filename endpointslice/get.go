@@ -0,0 +1,109 @@
+package endpointslice
+
+import (
+	"encoding/json"
+	"io/ioutil"
+
+	discoveryv1 "k8s.io/api/discovery/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/yaml"
+)
+
+// Get gets endpointslice from type string, []byte, *discoveryv1.EndpointSlice,
+// discoveryv1.EndpointSlice, metav1.Object, runtime.Object, *unstructured.Unstructured,
+// unstructured.Unstructured or map[string]interface{}.
+//
+// If passed parameter type is string, it will simply call GetByName instead of GetFromFile.
+// You should always explicitly call GetFromFile to get a endpointslice from file path.
+func (h *Handler) Get(obj interface{}) (*discoveryv1.EndpointSlice, error) {
+	switch val := obj.(type) {
+	case string:
+		return h.GetByName(val)
+	case []byte:
+		return h.GetFromBytes(val)
+	case *discoveryv1.EndpointSlice:
+		return h.GetFromObject(val)
+	case discoveryv1.EndpointSlice:
+		return h.GetFromObject(&val)
+	case *unstructured.Unstructured:
+		return h.GetFromUnstructured(val)
+	case unstructured.Unstructured:
+		return h.GetFromUnstructured(&val)
+	case map[string]interface{}:
+		return h.GetFromMap(val)
+	case metav1.Object, runtime.Object:
+		return h.GetFromObject(val)
+	default:
+		return nil, ErrInvalidGetType
+	}
+}
+
+// GetByName gets endpointslice by name.
+func (h *Handler) GetByName(name string) (*discoveryv1.EndpointSlice, error) {
+	return h.clientset.DiscoveryV1().EndpointSlices(h.namespace).Get(h.ctx, name, h.Options.GetOptions)
+}
+
+// GetFromFile gets endpointslice from yaml or json file.
+func (h *Handler) GetFromFile(filename string) (*discoveryv1.EndpointSlice, error) {
+	data, err := ioutil.ReadFile(filename)
+	if err != nil {
+		return nil, err
+	}
+	return h.GetFromBytes(data)
+}
+
+// GetFromBytes gets endpointslice from bytes data.
+func (h *Handler) GetFromBytes(data []byte) (*discoveryv1.EndpointSlice, error) {
+	epsJson, err := yaml.ToJSON(data)
+	if err != nil {
+		return nil, err
+	}
+
+	eps := &discoveryv1.EndpointSlice{}
+	if err = json.Unmarshal(epsJson, eps); err != nil {
+		return nil, err
+	}
+	return h.getEndpointSlice(eps)
+}
+
+// GetFromObject gets endpointslice from metav1.Object or runtime.Object.
+func (h *Handler) GetFromObject(obj interface{}) (*discoveryv1.EndpointSlice, error) {
+	eps, ok := obj.(*discoveryv1.EndpointSlice)
+	if !ok {
+		return nil, ErrInvalidObjectType
+	}
+	return h.getEndpointSlice(eps)
+}
+
+// GetFromUnstructured gets endpointslice from *unstructured.Unstructured.
+func (h *Handler) GetFromUnstructured(u *unstructured.Unstructured) (*discoveryv1.EndpointSlice, error) {
+	eps := &discoveryv1.EndpointSlice{}
+	err := runtime.DefaultUnstructuredConverter.FromUnstructured(u.UnstructuredContent(), eps)
+	if err != nil {
+		return nil, err
+	}
+	return h.getEndpointSlice(eps)
+}
+
+// GetFromMap gets endpointslice from map[string]interface{}.
+func (h *Handler) GetFromMap(u map[string]interface{}) (*discoveryv1.EndpointSlice, error) {
+	eps := &discoveryv1.EndpointSlice{}
+	err := runtime.DefaultUnstructuredConverter.FromUnstructured(u, eps)
+	if err != nil {
+		return nil, err
+	}
+	return h.getEndpointSlice(eps)
+}
+
+// getEndpointSlice
+// It's necessary to get a new endpointslice resource from a old endpointslice resource,
+// because old endpointslice usually don't have endpointslice.Status field.
+func (h *Handler) getEndpointSlice(eps *discoveryv1.EndpointSlice) (*discoveryv1.EndpointSlice, error) {
+	namespace := eps.GetNamespace()
+	if len(namespace) == 0 {
+		namespace = h.namespace
+	}
+	return h.clientset.DiscoveryV1().EndpointSlices(namespace).Get(h.ctx, eps.Name, h.Options.GetOptions)
+}
@@ -0,0 +1,67 @@
+package endpointslice
+
+import (
+	discoveryv1 "k8s.io/api/discovery/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/fields"
+)
+
+// List list all endpointslices in the k8s cluster, it simply call `ListAll`.
+func (h *Handler) List() ([]*discoveryv1.EndpointSlice, error) {
+	return h.ListAll()
+}
+
+// ListByLabel list endpointslices by labels.
+// Multiple labels separated by comma(",") eg: "name=myapp,role=devops",
+// and there is an "And" relationship between multiple labels.
+func (h *Handler) ListByLabel(labels string) ([]*discoveryv1.EndpointSlice, error) {
+	listOptions := h.Options.ListOptions.DeepCopy()
+	listOptions.LabelSelector = labels
+	epsList, err := h.clientset.DiscoveryV1().EndpointSlices(h.namespace).List(h.ctx, *listOptions)
+	if err != nil {
+		return nil, err
+	}
+	return extractList(epsList), nil
+}
+
+// ListByService list the endpointslices of the service in the handler namespace,
+// they're selected by the "kubernetes.io/service-name" label set by the
+// endpointslice controller.
+func (h *Handler) ListByService(svcName string) ([]*discoveryv1.EndpointSlice, error) {
+	return h.ListByLabel(discoveryv1.LabelServiceName + "=" + svcName)
+}
+
+// ListByField list endpointslices by field, work like `kubectl get xxx --field-selector=xxx`.
+func (h *Handler) ListByField(field string) ([]*discoveryv1.EndpointSlice, error) {
+	fieldSelector, err := fields.ParseSelector(field)
+	if err != nil {
+		return nil, err
+	}
+	listOptions := h.Options.ListOptions.DeepCopy()
+	listOptions.FieldSelector = fieldSelector.String()
+
+	epsList, err := h.clientset.DiscoveryV1().EndpointSlices(h.namespace).List(h.ctx, *listOptions)
+	if err != nil {
+		return nil, err
+	}
+	return extractList(epsList), nil
+}
+
+// ListByNamespace list all endpointslices in the specified namespace.
+func (h *Handler) ListByNamespace(namespace string) ([]*discoveryv1.EndpointSlice, error) {
+	return h.WithNamespace(namespace).ListByLabel("")
+}
+
+// ListAll list all endpointslices in the k8s cluster.
+func (h *Handler) ListAll() ([]*discoveryv1.EndpointSlice, error) {
+	return h.WithNamespace(metav1.NamespaceAll).ListByLabel("")
+}
+
+// extractList
+func extractList(epsList *discoveryv1.EndpointSliceList) []*discoveryv1.EndpointSlice {
+	var objList []*discoveryv1.EndpointSlice
+	for i := range epsList.Items {
+		objList = append(objList, &epsList.Items[i])
+	}
+	return objList
+}
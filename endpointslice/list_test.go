@@ -0,0 +1,64 @@
+package endpointslice
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/forbearing/k8s/types"
+	discoveryv1 "k8s.io/api/discovery/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/rest"
+)
+
+func TestListByService(t *testing.T) {
+	// slices are the endpointslices of the services nginx and redis.
+	var slices []discoveryv1.EndpointSlice
+	for _, name := range []string{"nginx-abcde", "nginx-fghij", "redis-klmno"} {
+		slices = append(slices, discoveryv1.EndpointSlice{ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: "test",
+			Labels:    map[string]string{discoveryv1.LabelServiceName: name[:5]},
+		}})
+	}
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/apis/discovery.k8s.io/v1/namespaces/test/endpointslices" {
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+		selector, err := labels.Parse(r.URL.Query().Get("labelSelector"))
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		epsList := &discoveryv1.EndpointSliceList{TypeMeta: metav1.TypeMeta{Kind: "EndpointSliceList", APIVersion: "discovery.k8s.io/v1"}}
+		for _, eps := range slices {
+			if selector.Matches(labels.Set(eps.Labels)) {
+				epsList.Items = append(epsList.Items, eps)
+			}
+		}
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(epsList)
+	}))
+	defer srv.Close()
+	clientset, err := kubernetes.NewForConfig(&rest.Config{Host: srv.URL})
+	if err != nil {
+		t.Fatal(err)
+	}
+	h := &Handler{ctx: context.Background(), namespace: "test", clientset: clientset, Options: &types.HandlerOptions{}}
+
+	epsList, err := h.ListByService("nginx")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(epsList) != 2 || epsList[0].Name != "nginx-abcde" || epsList[1].Name != "nginx-fghij" {
+		var names []string
+		for _, eps := range epsList {
+			names = append(names, eps.Name)
+		}
+		t.Errorf("ListByService(nginx) = %v, want [nginx-abcde nginx-fghij]", names)
+	}
+}
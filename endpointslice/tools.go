@@ -0,0 +1,25 @@
+package endpointslice
+
+import (
+	"time"
+
+	discoveryv1 "k8s.io/api/discovery/v1"
+)
+
+// GetAge get the endpointslice age.
+func (h *Handler) GetAge(object interface{}) (time.Duration, error) {
+	switch val := object.(type) {
+	case string:
+		ns, err := h.Get(val)
+		if err != nil {
+			return time.Duration(0), err
+		}
+		return time.Now().Sub(ns.CreationTimestamp.Time), nil
+	case *discoveryv1.EndpointSlice:
+		return time.Now().Sub(val.CreationTimestamp.Time), nil
+	case discoveryv1.EndpointSlice:
+		return time.Now().Sub(val.CreationTimestamp.Time), nil
+	default:
+		return time.Duration(0), ErrInvalidToolsType
+	}
+}
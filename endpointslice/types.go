@@ -0,0 +1,14 @@
+package endpointslice
+
+import "errors"
+
+var (
+	ErrInvalidToolsType  = errors.New("type must be string, *discoveryv1.EndpointSlice, discoveryv1.EndpointSlice, metav1.Object or runtime.Object")
+	ErrInvalidCreateType = errors.New("type must be string, []byte, *discoveryv1.EndpointSlice, discoveryv1.EndpointSlice, metav1.Object, runtime.Object, *unstructured.Unstructured, unstructured.Unstructured or map[string]interface{}")
+	ErrInvalidUpdateType = ErrInvalidCreateType
+	ErrInvalidApplyType  = ErrInvalidCreateType
+	ErrInvalidDeleteType = ErrInvalidCreateType
+	ErrInvalidGetType    = ErrInvalidCreateType
+	ErrInvalidPatchType  = errors.New("patch data type must be string, []byte, *discoveryv1.EndpointSlice, discoveryv1.EndpointSlice, metav1.Object, runtime.Object, *unstructured.Unstructured, unstructured.Unstructured or map[string]interface{}")
+	ErrInvalidObjectType = errors.New("object type is not *discoveryv1.EndpointSlice")
+)
@@ -0,0 +1,101 @@
+package endpointslice
+
+import (
+	"encoding/json"
+	"io/ioutil"
+
+	discoveryv1 "k8s.io/api/discovery/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/yaml"
+)
+
+// Update updates endpointslice from type string, []byte, *discoveryv1.EndpointSlice,
+// discoveryv1.EndpointSlice, metav1.Object, runtime.Object, *unstructured.Unstructured,
+// unstructured.Unstructured or map[string]interface{}.
+func (h *Handler) Update(obj interface{}) (*discoveryv1.EndpointSlice, error) {
+	switch val := obj.(type) {
+	case string:
+		return h.UpdateFromFile(val)
+	case []byte:
+		return h.UpdateFromBytes(val)
+	case *discoveryv1.EndpointSlice:
+		return h.UpdateFromObject(val)
+	case discoveryv1.EndpointSlice:
+		return h.UpdateFromObject(&val)
+	case *unstructured.Unstructured:
+		return h.UpdateFromUnstructured(val)
+	case unstructured.Unstructured:
+		return h.UpdateFromUnstructured(&val)
+	case map[string]interface{}:
+		return h.UpdateFromMap(val)
+	case metav1.Object, runtime.Object:
+		return h.UpdateFromObject(val)
+	default:
+		return nil, ErrInvalidUpdateType
+	}
+}
+
+// UpdateFromFile updates endpointslice from yaml or json file.
+func (h *Handler) UpdateFromFile(filename string) (*discoveryv1.EndpointSlice, error) {
+	data, err := ioutil.ReadFile(filename)
+	if err != nil {
+		return nil, err
+	}
+	return h.UpdateFromBytes(data)
+}
+
+// UpdateFromBytes updates endpointslice from bytes data.
+func (h *Handler) UpdateFromBytes(data []byte) (*discoveryv1.EndpointSlice, error) {
+	epsJson, err := yaml.ToJSON(data)
+	if err != nil {
+		return nil, err
+	}
+
+	eps := &discoveryv1.EndpointSlice{}
+	if err = json.Unmarshal(epsJson, eps); err != nil {
+		return nil, err
+	}
+	return h.updateEndpointSlice(eps)
+}
+
+// UpdateFromObject updates endpointslice from metav1.Object or runtime.Object.
+func (h *Handler) UpdateFromObject(obj interface{}) (*discoveryv1.EndpointSlice, error) {
+	eps, ok := obj.(*discoveryv1.EndpointSlice)
+	if !ok {
+		return nil, ErrInvalidObjectType
+	}
+	return h.updateEndpointSlice(eps)
+}
+
+// UpdateFromUnstructured updates endpointslice from *unstructured.Unstructured.
+func (h *Handler) UpdateFromUnstructured(u *unstructured.Unstructured) (*discoveryv1.EndpointSlice, error) {
+	eps := &discoveryv1.EndpointSlice{}
+	err := runtime.DefaultUnstructuredConverter.FromUnstructured(u.UnstructuredContent(), eps)
+	if err != nil {
+		return nil, err
+	}
+	return h.updateEndpointSlice(eps)
+}
+
+// UpdateFromMap updates endpointslice from map[string]interface{}.
+func (h *Handler) UpdateFromMap(u map[string]interface{}) (*discoveryv1.EndpointSlice, error) {
+	eps := &discoveryv1.EndpointSlice{}
+	err := runtime.DefaultUnstructuredConverter.FromUnstructured(u, eps)
+	if err != nil {
+		return nil, err
+	}
+	return h.updateEndpointSlice(eps)
+}
+
+// updateEndpointSlice
+func (h *Handler) updateEndpointSlice(eps *discoveryv1.EndpointSlice) (*discoveryv1.EndpointSlice, error) {
+	namespace := eps.GetNamespace()
+	if len(namespace) == 0 {
+		namespace = h.namespace
+	}
+	eps.ResourceVersion = ""
+	eps.UID = ""
+	return h.clientset.DiscoveryV1().EndpointSlices(namespace).Update(h.ctx, eps, h.Options.UpdateOptions)
+}
@@ -0,0 +1,90 @@
+package k8s
+
+import (
+	"context"
+
+	"github.com/forbearing/k8s/dynamic"
+	utilrestmapper "github.com/forbearing/k8s/util/restmapper"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+// Get work like "kubectl get deployment nginx -n test", it gets the k8s
+// resource with the given kind and name, and returns the unstructured object.
+//
+// The kind can be the kind or resource name, such as "Deployment", "deployment",
+// "deployments", "deployments.apps" or "deployments.v1.apps".
+// The namespace will be ignored if the k8s resource is cluster scope.
+func Get(ctx context.Context, kubeconfig, kind, namespace, name string) (*unstructured.Unstructured, error) {
+	handler, _, err := newForKind(ctx, kubeconfig, kind, namespace)
+	if err != nil {
+		return nil, err
+	}
+	return handler.GetByName(name)
+}
+
+// List work like "kubectl get deployment -n test", it lists all k8s resources
+// with the given kind in the namespace. If the namespace is metav1.NamespaceAll(""),
+// it lists the k8s resources in all namespaces, just like "kubectl get deployment -A".
+//
+// The kind can be the kind or resource name, such as "Deployment", "deployment",
+// "deployments", "deployments.apps" or "deployments.v1.apps".
+func List(ctx context.Context, kubeconfig, kind, namespace string) ([]*unstructured.Unstructured, error) {
+	handler, namespaced, err := newForKind(ctx, kubeconfig, kind, namespace)
+	if err != nil {
+		return nil, err
+	}
+	if len(namespace) == 0 || !namespaced {
+		return handler.ListAll()
+	}
+	return handler.ListByNamespace(namespace)
+}
+
+// Delete work like "kubectl delete deployment nginx -n test", it deletes the
+// k8s resource with the given kind and name.
+//
+// The kind can be the kind or resource name, such as "Deployment", "deployment",
+// "deployments", "deployments.apps" or "deployments.v1.apps".
+// The namespace will be ignored if the k8s resource is cluster scope.
+func Delete(ctx context.Context, kubeconfig, kind, namespace, name string, opts ...Options) error {
+	handler, _, err := newForKind(ctx, kubeconfig, kind, namespace)
+	if err != nil {
+		return err
+	}
+	return ignoreErrors(handler.DeleteByName(name), opts...)
+}
+
+// Watch work like "kubectl get deployment -n test -w", it watches all k8s
+// resources with the given kind in the namespace. If the namespace is
+// metav1.NamespaceAll(""), it watches the k8s resources in all namespaces.
+//
+// The kind can be the kind or resource name, such as "Deployment", "deployment",
+// "deployments", "deployments.apps" or "deployments.v1.apps".
+func Watch(ctx context.Context, kubeconfig, kind, namespace string, addFunc, modifyFunc, deleteFunc func(obj interface{})) error {
+	handler, namespaced, err := newForKind(ctx, kubeconfig, kind, namespace)
+	if err != nil {
+		return err
+	}
+	if len(namespace) == 0 || !namespaced {
+		return handler.Watch(addFunc, modifyFunc, deleteFunc)
+	}
+	return handler.WatchByNamespace(namespace, addFunc, modifyFunc, deleteFunc)
+}
+
+// newForKind creates a dynamic handler for the given kind, the GroupVersionKind
+// and the namespace scope of the kind are found through the discovery backed
+// RESTMapper.
+func newForKind(ctx context.Context, kubeconfig, kind, namespace string) (*dynamic.Handler, bool, error) {
+	handler, err := New(ctx, kubeconfig, namespace)
+	if err != nil {
+		return nil, false, err
+	}
+	gvk, err := utilrestmapper.KindToGVK(handler.RESTMapper(), kind)
+	if err != nil {
+		return nil, false, err
+	}
+	namespaced, err := utilrestmapper.IsNamespaced(handler.RESTMapper(), gvk)
+	if err != nil {
+		return nil, false, err
+	}
+	return handler.WithGVK(gvk), namespaced, nil
+}
@@ -0,0 +1,211 @@
+package k8s
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"sync"
+	"sync/atomic"
+	"testing"
+
+	"github.com/forbearing/k8s/deployment"
+	"github.com/forbearing/k8s/service"
+	"github.com/forbearing/k8s/types"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// serveObjects is a fake kubernetes API server storing the created objects by path.
+type serveObjects struct {
+	mu      sync.Mutex
+	objects map[string][]byte
+}
+
+func (s *serveObjects) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	w.Header().Set("Content-Type", "application/json")
+	if r.Method == http.MethodPost {
+		data, _ := io.ReadAll(r.Body)
+		obj := &metav1.PartialObjectMetadata{}
+		json.Unmarshal(data, obj)
+		s.objects[r.URL.Path+"/"+obj.Name] = data
+		w.WriteHeader(http.StatusCreated)
+		w.Write(data)
+		return
+	}
+	data, ok := s.objects[r.URL.Path]
+	if !ok {
+		w.WriteHeader(http.StatusNotFound)
+		fmt.Fprintln(w, `{"kind":"Status","apiVersion":"v1","status":"Failure","reason":"NotFound","code":404}`)
+		return
+	}
+	w.Write(data)
+}
+
+func TestGenericHandler(t *testing.T) {
+	srv := httptest.NewServer(&serveObjects{objects: make(map[string][]byte)})
+	defer srv.Close()
+	kubeconfig := filepath.Join(t.TempDir(), "kubeconfig")
+	data := fmt.Sprintf("apiVersion: v1\nkind: Config\nclusters:\n- name: test\n  cluster:\n    server: %s\n"+
+		"contexts:\n- name: test\n  context:\n    cluster: test\ncurrent-context: test\n", srv.URL)
+	if err := os.WriteFile(kubeconfig, []byte(data), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	deployHandler, err := deployment.New(context.Background(), kubeconfig, "test")
+	if err != nil {
+		t.Fatal(err)
+	}
+	svcHandler, err := service.New(context.Background(), kubeconfig, "test")
+	if err != nil {
+		t.Fatal(err)
+	}
+	handlers := map[schema.GroupVersionKind]types.Handler{
+		deployment.GVK: deployHandler.Generic(),
+		service.GVK:    svcHandler.Generic(),
+	}
+
+	manifests := map[schema.GroupVersionKind]string{
+		deployment.GVK: `{"apiVersion":"apps/v1","kind":"Deployment","metadata":{"name":"nginx","namespace":"test"}}`,
+		service.GVK:    `{"apiVersion":"v1","kind":"Service","metadata":{"name":"nginx","namespace":"test"}}`,
+	}
+	for gvk, manifest := range manifests {
+		handler := handlers[gvk]
+		if handler.GVK() != gvk {
+			t.Errorf("handler GVK = %v, want %v", handler.GVK(), gvk)
+		}
+		if _, err := handler.CreateFromBytes([]byte(manifest)); err != nil {
+			t.Fatalf("create %s: %v", gvk.Kind, err)
+		}
+		obj, err := handler.GetByName("nginx")
+		if err != nil {
+			t.Fatalf("get %s: %v", gvk.Kind, err)
+		}
+		if kind := fmt.Sprintf("%T", obj); kind != "*v1."+gvk.Kind {
+			t.Errorf("got %s, want *v1.%s", kind, gvk.Kind)
+		}
+		if name := obj.(metav1.Object).GetName(); name != "nginx" {
+			t.Errorf("got %s/%s, want nginx", gvk.Kind, name)
+		}
+
+		// the not found error isn't returned with a typed nil object.
+		obj, err = handler.GetByName("absent")
+		if err == nil || obj != nil {
+			t.Errorf("GetByName(absent) = %v, %v, want nil object and error", obj, err)
+		}
+	}
+}
+
+// serveKinds is a fake kubernetes API server serving the discovery of
+// deployments and namespaces, and the deployment "test/nginx".
+func serveKinds(t *testing.T, deleted *int32, watched chan struct{}) http.HandlerFunc {
+	deploy := `{"apiVersion":"apps/v1","kind":"Deployment","metadata":{"name":"nginx","namespace":"test"}}`
+	return func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case r.URL.Path == "/api":
+			fmt.Fprintln(w, `{"kind":"APIVersions","versions":["v1"]}`)
+		case r.URL.Path == "/apis":
+			fmt.Fprintln(w, `{"kind":"APIGroupList","apiVersion":"v1","groups":[{"name":"apps",`+
+				`"versions":[{"groupVersion":"apps/v1","version":"v1"}],"preferredVersion":{"groupVersion":"apps/v1","version":"v1"}}]}`)
+		case r.URL.Path == "/api/v1":
+			fmt.Fprintln(w, `{"kind":"APIResourceList","groupVersion":"v1","resources":[`+
+				`{"name":"namespaces","singularName":"namespace","namespaced":false,"kind":"Namespace","verbs":["get","list"]}]}`)
+		case r.URL.Path == "/apis/apps/v1":
+			fmt.Fprintln(w, `{"kind":"APIResourceList","groupVersion":"apps/v1","resources":[`+
+				`{"name":"deployments","singularName":"deployment","namespaced":true,"kind":"Deployment","verbs":["get","list","delete","watch"]}]}`)
+		case r.URL.Query().Get("watch") == "true":
+			fmt.Fprintf(w, `{"type":"ADDED","object":%s}`+"\n", deploy)
+			w.(http.Flusher).Flush()
+			select {
+			case <-watched:
+			case <-r.Context().Done():
+			}
+		case r.Method == http.MethodGet && r.URL.Path == "/apis/apps/v1/namespaces/test/deployments/nginx":
+			fmt.Fprintln(w, deploy)
+		case r.Method == http.MethodGet && r.URL.Path == "/apis/apps/v1/namespaces/test/deployments":
+			fmt.Fprintf(w, `{"apiVersion":"apps/v1","kind":"DeploymentList","metadata":{},"items":[%s]}`+"\n", deploy)
+		case r.Method == http.MethodGet && r.URL.Path == "/api/v1/namespaces":
+			// the namespace is ignored for the cluster scope kind.
+			fmt.Fprintln(w, `{"apiVersion":"v1","kind":"NamespaceList","metadata":{},"items":[{"apiVersion":"v1","kind":"Namespace","metadata":{"name":"test"}}]}`)
+		case r.Method == http.MethodDelete && r.URL.Path == "/apis/apps/v1/namespaces/test/deployments/nginx":
+			atomic.StoreInt32(deleted, 1)
+			fmt.Fprintln(w, `{"kind":"Status","apiVersion":"v1","status":"Success"}`)
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL)
+			w.WriteHeader(http.StatusNotFound)
+			fmt.Fprintln(w, `{"kind":"Status","apiVersion":"v1","status":"Failure","reason":"NotFound","code":404}`)
+		}
+	}
+}
+
+func TestByKind(t *testing.T) {
+	var deleted int32
+	watched := make(chan struct{})
+	srv := httptest.NewServer(serveKinds(t, &deleted, watched))
+	defer srv.Close()
+	kubeconfig := filepath.Join(t.TempDir(), "kubeconfig")
+	data := fmt.Sprintf("apiVersion: v1\nkind: Config\nclusters:\n- name: test\n  cluster:\n    server: %s\n"+
+		"contexts:\n- name: test\n  context:\n    cluster: test\ncurrent-context: test\n", srv.URL)
+	if err := os.WriteFile(kubeconfig, []byte(data), 0600); err != nil {
+		t.Fatal(err)
+	}
+	ctx := context.Background()
+
+	for _, kind := range []string{"Deployment", "deployments", "deployments.apps", "deployments.v1.apps"} {
+		obj, err := Get(ctx, kubeconfig, kind, "test", "nginx")
+		if err != nil {
+			t.Fatalf("Get(%s) error = %v", kind, err)
+		}
+		if obj.GetKind() != "Deployment" || obj.GetName() != "nginx" {
+			t.Errorf("Get(%s) = %s/%s, want Deployment/nginx", kind, obj.GetKind(), obj.GetName())
+		}
+	}
+	objs, err := List(ctx, kubeconfig, "deployment", "test")
+	if err != nil || len(objs) != 1 || objs[0].GetName() != "nginx" {
+		t.Errorf("List(deployment) = %v, %v, want deployment nginx", objs, err)
+	}
+	objs, err = List(ctx, kubeconfig, "namespace", "test")
+	if err != nil || len(objs) != 1 || objs[0].GetName() != "test" {
+		t.Errorf("List(namespace) = %v, %v, want namespace test", objs, err)
+	}
+	if err := Delete(ctx, kubeconfig, "deployment", "test", "nginx"); err != nil || atomic.LoadInt32(&deleted) == 0 {
+		t.Errorf("Delete(deployment) = %v, want deployment nginx deleted", err)
+	}
+
+	// Watch returns after the handler context is done.
+	watchCtx, cancel := context.WithCancel(ctx)
+	var added []string
+	addFunc := func(obj interface{}) {
+		added = append(added, obj.(metav1.Object).GetName())
+		cancel()
+		close(watched)
+	}
+	if err := Watch(watchCtx, kubeconfig, "deployment", "test", addFunc, nil, nil); !errors.Is(err, context.Canceled) {
+		t.Errorf("Watch(deployment) = %v, want context.Canceled", err)
+	}
+	if len(added) != 1 || added[0] != "nginx" {
+		t.Errorf("Watch(deployment) added %v, want [nginx]", added)
+	}
+
+	// the unknown kind is not found by the RESTMapper.
+	if _, err := Get(ctx, kubeconfig, "Foo", "test", "nginx"); err == nil {
+		t.Error("Get(Foo) error = nil, want an error")
+	}
+	if _, err := List(ctx, kubeconfig, "Foo", "test"); err == nil {
+		t.Error("List(Foo) error = nil, want an error")
+	}
+	if err := Delete(ctx, kubeconfig, "Foo", "test", "nginx"); err == nil {
+		t.Error("Delete(Foo) error = nil, want an error")
+	}
+	if err := Watch(ctx, kubeconfig, "Foo", "test", nil, nil, nil); err == nil {
+		t.Error("Watch(Foo) error = nil, want an error")
+	}
+}
@@ -0,0 +1,120 @@
+package k8s
+
+import (
+	"testing"
+
+	"github.com/forbearing/k8s/clusterrole"
+	"github.com/forbearing/k8s/clusterrolebinding"
+	"github.com/forbearing/k8s/configmap"
+	"github.com/forbearing/k8s/cronjob"
+	"github.com/forbearing/k8s/daemonset"
+	"github.com/forbearing/k8s/deployment"
+	"github.com/forbearing/k8s/endpointslice"
+	"github.com/forbearing/k8s/hpa"
+	"github.com/forbearing/k8s/ingress"
+	"github.com/forbearing/k8s/ingressclass"
+	"github.com/forbearing/k8s/job"
+	"github.com/forbearing/k8s/lease"
+	"github.com/forbearing/k8s/namespace"
+	"github.com/forbearing/k8s/networkpolicy"
+	"github.com/forbearing/k8s/node"
+	"github.com/forbearing/k8s/pdb"
+	"github.com/forbearing/k8s/persistentvolume"
+	"github.com/forbearing/k8s/persistentvolumeclaim"
+	"github.com/forbearing/k8s/pod"
+	"github.com/forbearing/k8s/replicaset"
+	"github.com/forbearing/k8s/replicationcontroller"
+	"github.com/forbearing/k8s/resourcequota"
+	"github.com/forbearing/k8s/role"
+	"github.com/forbearing/k8s/rolebinding"
+	"github.com/forbearing/k8s/secret"
+	"github.com/forbearing/k8s/service"
+	"github.com/forbearing/k8s/serviceaccount"
+	"github.com/forbearing/k8s/statefulset"
+	"github.com/forbearing/k8s/storageclass"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// TestGVR checks every resource package exposes the GVK, GVR, Group, Version,
+// Resource and Kind of its resource consistently.
+func TestGVR(t *testing.T) {
+	tests := []struct {
+		name     string
+		gvk      schema.GroupVersionKind
+		gvr      schema.GroupVersionResource
+		vars     [4]string // Group, Version, Resource and Kind.
+		wantGVR  schema.GroupVersionResource
+		wantKind string
+	}{
+		{"clusterrole", clusterrole.GVK, clusterrole.GVR, [4]string{clusterrole.Group, clusterrole.Version, clusterrole.Resource, clusterrole.Kind},
+			schema.GroupVersionResource{Group: "rbac.authorization.k8s.io", Version: "v1", Resource: "clusterroles"}, "ClusterRole"},
+		{"clusterrolebinding", clusterrolebinding.GVK, clusterrolebinding.GVR, [4]string{clusterrolebinding.Group, clusterrolebinding.Version, clusterrolebinding.Resource, clusterrolebinding.Kind},
+			schema.GroupVersionResource{Group: "rbac.authorization.k8s.io", Version: "v1", Resource: "clusterrolebindings"}, "ClusterRoleBinding"},
+		{"configmap", configmap.GVK, configmap.GVR, [4]string{configmap.Group, configmap.Version, configmap.Resource, configmap.Kind},
+			schema.GroupVersionResource{Group: "", Version: "v1", Resource: "configmaps"}, "ConfigMap"},
+		{"cronjob", cronjob.GVK, cronjob.GVR, [4]string{cronjob.Group, cronjob.Version, cronjob.Resource, cronjob.Kind},
+			schema.GroupVersionResource{Group: "batch", Version: "v1", Resource: "cronjobs"}, "CronJob"},
+		{"daemonset", daemonset.GVK, daemonset.GVR, [4]string{daemonset.Group, daemonset.Version, daemonset.Resource, daemonset.Kind},
+			schema.GroupVersionResource{Group: "apps", Version: "v1", Resource: "daemonsets"}, "DaemonSet"},
+		{"deployment", deployment.GVK, deployment.GVR, [4]string{deployment.Group, deployment.Version, deployment.Resource, deployment.Kind},
+			schema.GroupVersionResource{Group: "apps", Version: "v1", Resource: "deployments"}, "Deployment"},
+		{"endpointslice", endpointslice.GVK, endpointslice.GVR, [4]string{endpointslice.Group, endpointslice.Version, endpointslice.Resource, endpointslice.Kind},
+			schema.GroupVersionResource{Group: "discovery.k8s.io", Version: "v1", Resource: "endpointslices"}, "EndpointSlice"},
+		{"hpa", hpa.GVK, hpa.GVR, [4]string{hpa.Group, hpa.Version, hpa.Resource, hpa.Kind},
+			schema.GroupVersionResource{Group: "autoscaling", Version: "v2", Resource: "horizontalpodautoscalers"}, "HorizontalPodAutoscaler"},
+		{"ingress", ingress.GVK, ingress.GVR, [4]string{ingress.Group, ingress.Version, ingress.Resource, ingress.Kind},
+			schema.GroupVersionResource{Group: "networking.k8s.io", Version: "v1", Resource: "ingresses"}, "Ingress"},
+		{"ingressclass", ingressclass.GVK, ingressclass.GVR, [4]string{ingressclass.Group, ingressclass.Version, ingressclass.Resource, ingressclass.Kind},
+			schema.GroupVersionResource{Group: "networking.k8s.io", Version: "v1", Resource: "ingressclasses"}, "IngressClass"},
+		{"job", job.GVK, job.GVR, [4]string{job.Group, job.Version, job.Resource, job.Kind},
+			schema.GroupVersionResource{Group: "batch", Version: "v1", Resource: "jobs"}, "Job"},
+		{"lease", lease.GVK, lease.GVR, [4]string{lease.Group, lease.Version, lease.Resource, lease.Kind},
+			schema.GroupVersionResource{Group: "coordination.k8s.io", Version: "v1", Resource: "leases"}, "Lease"},
+		{"namespace", namespace.GVK, namespace.GVR, [4]string{namespace.Group, namespace.Version, namespace.Resource, namespace.Kind},
+			schema.GroupVersionResource{Group: "", Version: "v1", Resource: "namespaces"}, "Namespace"},
+		{"networkpolicy", networkpolicy.GVK, networkpolicy.GVR, [4]string{networkpolicy.Group, networkpolicy.Version, networkpolicy.Resource, networkpolicy.Kind},
+			schema.GroupVersionResource{Group: "networking.k8s.io", Version: "v1", Resource: "networkpolicies"}, "NetworkPolicy"},
+		{"node", node.GVK, node.GVR, [4]string{node.Group, node.Version, node.Resource, node.Kind},
+			schema.GroupVersionResource{Group: "", Version: "v1", Resource: "nodes"}, "Node"},
+		{"pdb", pdb.GVK, pdb.GVR, [4]string{pdb.Group, pdb.Version, pdb.Resource, pdb.Kind},
+			schema.GroupVersionResource{Group: "policy", Version: "v1", Resource: "poddisruptionbudgets"}, "PodDisruptionBudget"},
+		{"persistentvolume", persistentvolume.GVK, persistentvolume.GVR, [4]string{persistentvolume.Group, persistentvolume.Version, persistentvolume.Resource, persistentvolume.Kind},
+			schema.GroupVersionResource{Group: "", Version: "v1", Resource: "persistentvolumes"}, "PersistentVolume"},
+		{"persistentvolumeclaim", persistentvolumeclaim.GVK, persistentvolumeclaim.GVR, [4]string{persistentvolumeclaim.Group, persistentvolumeclaim.Version, persistentvolumeclaim.Resource, persistentvolumeclaim.Kind},
+			schema.GroupVersionResource{Group: "", Version: "v1", Resource: "persistentvolumeclaims"}, "PersistentVolumeClaim"},
+		{"pod", pod.GVK, pod.GVR, [4]string{pod.Group, pod.Version, pod.Resource, pod.Kind},
+			schema.GroupVersionResource{Group: "", Version: "v1", Resource: "pods"}, "Pod"},
+		{"replicaset", replicaset.GVK, replicaset.GVR, [4]string{replicaset.Group, replicaset.Version, replicaset.Resource, replicaset.Kind},
+			schema.GroupVersionResource{Group: "apps", Version: "v1", Resource: "replicasets"}, "ReplicaSet"},
+		{"replicationcontroller", replicationcontroller.GVK, replicationcontroller.GVR, [4]string{replicationcontroller.Group, replicationcontroller.Version, replicationcontroller.Resource, replicationcontroller.Kind},
+			schema.GroupVersionResource{Group: "", Version: "v1", Resource: "replicationcontrollers"}, "ReplicationController"},
+		{"resourcequota", resourcequota.GVK, resourcequota.GVR, [4]string{resourcequota.Group, resourcequota.Version, resourcequota.Resource, resourcequota.Kind},
+			schema.GroupVersionResource{Group: "", Version: "v1", Resource: "resourcequotas"}, "ResourceQuota"},
+		{"role", role.GVK, role.GVR, [4]string{role.Group, role.Version, role.Resource, role.Kind},
+			schema.GroupVersionResource{Group: "rbac.authorization.k8s.io", Version: "v1", Resource: "roles"}, "Role"},
+		{"rolebinding", rolebinding.GVK, rolebinding.GVR, [4]string{rolebinding.Group, rolebinding.Version, rolebinding.Resource, rolebinding.Kind},
+			schema.GroupVersionResource{Group: "rbac.authorization.k8s.io", Version: "v1", Resource: "rolebindings"}, "RoleBinding"},
+		{"secret", secret.GVK, secret.GVR, [4]string{secret.Group, secret.Version, secret.Resource, secret.Kind},
+			schema.GroupVersionResource{Group: "", Version: "v1", Resource: "secrets"}, "Secret"},
+		{"service", service.GVK, service.GVR, [4]string{service.Group, service.Version, service.Resource, service.Kind},
+			schema.GroupVersionResource{Group: "", Version: "v1", Resource: "services"}, "Service"},
+		{"serviceaccount", serviceaccount.GVK, serviceaccount.GVR, [4]string{serviceaccount.Group, serviceaccount.Version, serviceaccount.Resource, serviceaccount.Kind},
+			schema.GroupVersionResource{Group: "", Version: "v1", Resource: "serviceaccounts"}, "ServiceAccount"},
+		{"statefulset", statefulset.GVK, statefulset.GVR, [4]string{statefulset.Group, statefulset.Version, statefulset.Resource, statefulset.Kind},
+			schema.GroupVersionResource{Group: "apps", Version: "v1", Resource: "statefulsets"}, "StatefulSet"},
+		{"storageclass", storageclass.GVK, storageclass.GVR, [4]string{storageclass.Group, storageclass.Version, storageclass.Resource, storageclass.Kind},
+			schema.GroupVersionResource{Group: "storage.k8s.io", Version: "v1", Resource: "storageclasses"}, "StorageClass"},
+	}
+	for _, test := range tests {
+		if test.gvr != test.wantGVR {
+			t.Errorf("%s.GVR = %v, want %v", test.name, test.gvr, test.wantGVR)
+		}
+		if wantGVK := test.wantGVR.GroupVersion().WithKind(test.wantKind); test.gvk != wantGVK {
+			t.Errorf("%s.GVK = %v, want %v", test.name, test.gvk, wantGVK)
+		}
+		wantVars := [4]string{test.wantGVR.Group, test.wantGVR.Version, test.wantGVR.Resource, test.wantKind}
+		if test.vars != wantVars {
+			t.Errorf("%s Group, Version, Resource, Kind = %q, want %q", test.name, test.vars, wantVars)
+		}
+	}
+}
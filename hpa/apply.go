@@ -0,0 +1,91 @@
+package hpa
+
+import (
+	autoscalingv2 "k8s.io/api/autoscaling/v2"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+// Apply applies hpa from type string, []byte, *autoscalingv2.HorizontalPodAutoscaler,
+// autoscalingv2.HorizontalPodAutoscaler, metav1.Object, runtime.Object, *unstructured.Unstructured,
+// unstructured.Unstructured or map[string]interface{}.
+func (h *Handler) Apply(obj interface{}) (*autoscalingv2.HorizontalPodAutoscaler, error) {
+	switch val := obj.(type) {
+	case string:
+		return h.ApplyFromFile(val)
+	case []byte:
+		return h.ApplyFromBytes(val)
+	case *autoscalingv2.HorizontalPodAutoscaler:
+		return h.ApplyFromObject(val)
+	case autoscalingv2.HorizontalPodAutoscaler:
+		return h.ApplyFromObject(&val)
+	case *unstructured.Unstructured:
+		return h.ApplyFromUnstructured(val)
+	case unstructured.Unstructured:
+		return h.ApplyFromUnstructured(&val)
+	case map[string]interface{}:
+		return h.ApplyFromMap(val)
+	case metav1.Object, runtime.Object:
+		return h.ApplyFromObject(val)
+	default:
+		return nil, ErrInvalidApplyType
+	}
+}
+
+// ApplyFromFile applies hpa from yaml or json file.
+func (h *Handler) ApplyFromFile(filename string) (hpa *autoscalingv2.HorizontalPodAutoscaler, err error) {
+	hpa, err = h.CreateFromFile(filename)
+	if k8serrors.IsAlreadyExists(err) { // if hpa already exist, update it.
+		hpa, err = h.UpdateFromFile(filename)
+	}
+	return
+}
+
+// ApplyFromBytes pply hpa from bytes data.
+func (h *Handler) ApplyFromBytes(data []byte) (hpa *autoscalingv2.HorizontalPodAutoscaler, err error) {
+	hpa, err = h.CreateFromBytes(data)
+	if k8serrors.IsAlreadyExists(err) {
+		hpa, err = h.UpdateFromBytes(data)
+	}
+	return
+}
+
+// ApplyFromObject applies hpa from metav1.Object or runtime.Object.
+func (h *Handler) ApplyFromObject(obj interface{}) (*autoscalingv2.HorizontalPodAutoscaler, error) {
+	hpa, ok := obj.(*autoscalingv2.HorizontalPodAutoscaler)
+	if !ok {
+		return nil, ErrInvalidObjectType
+	}
+	return h.applyHPA(hpa)
+}
+
+// ApplyFromUnstructured applies hpa from *unstructured.Unstructured.
+func (h *Handler) ApplyFromUnstructured(u *unstructured.Unstructured) (*autoscalingv2.HorizontalPodAutoscaler, error) {
+	hpa := &autoscalingv2.HorizontalPodAutoscaler{}
+	err := runtime.DefaultUnstructuredConverter.FromUnstructured(u.UnstructuredContent(), hpa)
+	if err != nil {
+		return nil, err
+	}
+	return h.applyHPA(hpa)
+}
+
+// ApplyFromMap applies hpa from map[string]interface{}.
+func (h *Handler) ApplyFromMap(u map[string]interface{}) (*autoscalingv2.HorizontalPodAutoscaler, error) {
+	hpa := &autoscalingv2.HorizontalPodAutoscaler{}
+	err := runtime.DefaultUnstructuredConverter.FromUnstructured(u, hpa)
+	if err != nil {
+		return nil, err
+	}
+	return h.applyHPA(hpa)
+}
+
+// applyHPA
+func (h *Handler) applyHPA(hpa *autoscalingv2.HorizontalPodAutoscaler) (*autoscalingv2.HorizontalPodAutoscaler, error) {
+	_, err := h.createHPA(hpa)
+	if k8serrors.IsAlreadyExists(err) {
+		return h.updateHPA(hpa)
+	}
+	return hpa, err
+}
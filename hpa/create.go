@@ -0,0 +1,115 @@
+package hpa
+
+import (
+	"encoding/json"
+	"io/ioutil"
+
+	autoscalingv2 "k8s.io/api/autoscaling/v2"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/yaml"
+)
+
+// Create creates hpa from type string, []byte, *autoscalingv2.HorizontalPodAutoscaler,
+// autoscalingv2.HorizontalPodAutoscaler, metav1.Object, runtime.Object, *unstructured.Unstructured,
+// unstructured.Unstructured or map[string]interface{}.
+func (h *Handler) Create(obj interface{}) (*autoscalingv2.HorizontalPodAutoscaler, error) {
+	switch val := obj.(type) {
+	case string:
+		return h.CreateFromFile(val)
+	case []byte:
+		return h.CreateFromBytes(val)
+	case *autoscalingv2.HorizontalPodAutoscaler:
+		return h.CreateFromObject(val)
+	case autoscalingv2.HorizontalPodAutoscaler:
+		return h.CreateFromObject(&val)
+	case *unstructured.Unstructured:
+		return h.CreateFromUnstructured(val)
+	case unstructured.Unstructured:
+		return h.CreateFromUnstructured(&val)
+	case map[string]interface{}:
+		return h.CreateFromMap(val)
+	case metav1.Object, runtime.Object:
+		return h.CreateFromObject(val)
+	default:
+		return nil, ErrInvalidCreateType
+	}
+}
+
+// CreateFromFile creates hpa from yaml or json file.
+func (h *Handler) CreateFromFile(filename string) (*autoscalingv2.HorizontalPodAutoscaler, error) {
+	data, err := ioutil.ReadFile(filename)
+	if err != nil {
+		return nil, err
+	}
+	return h.CreateFromBytes(data)
+}
+
+// CreateFromBytes creates hpa from bytes data.
+func (h *Handler) CreateFromBytes(data []byte) (*autoscalingv2.HorizontalPodAutoscaler, error) {
+	hpaJson, err := yaml.ToJSON(data)
+	if err != nil {
+		return nil, err
+	}
+
+	hpa := &autoscalingv2.HorizontalPodAutoscaler{}
+	if err = json.Unmarshal(hpaJson, hpa); err != nil {
+		return nil, err
+	}
+	return h.createHPA(hpa)
+}
+
+// CreateFromObject creates hpa from metav1.Object or runtime.Object.
+func (h *Handler) CreateFromObject(obj interface{}) (*autoscalingv2.HorizontalPodAutoscaler, error) {
+	hpa, ok := obj.(*autoscalingv2.HorizontalPodAutoscaler)
+	if !ok {
+		return nil, ErrInvalidObjectType
+	}
+	return h.createHPA(hpa)
+}
+
+// CreateFromUnstructured creates hpa from *unstructured.Unstructured.
+func (h *Handler) CreateFromUnstructured(u *unstructured.Unstructured) (*autoscalingv2.HorizontalPodAutoscaler, error) {
+	hpa := &autoscalingv2.HorizontalPodAutoscaler{}
+	err := runtime.DefaultUnstructuredConverter.FromUnstructured(u.UnstructuredContent(), hpa)
+	if err != nil {
+		return nil, err
+	}
+	return h.createHPA(hpa)
+}
+
+// CreateFromMap creates hpa from map[string]interface{}.
+func (h *Handler) CreateFromMap(u map[string]interface{}) (*autoscalingv2.HorizontalPodAutoscaler, error) {
+	hpa := &autoscalingv2.HorizontalPodAutoscaler{}
+	err := runtime.DefaultUnstructuredConverter.FromUnstructured(u, hpa)
+	if err != nil {
+		return nil, err
+	}
+	return h.createHPA(hpa)
+}
+
+// createHPA
+func (h *Handler) createHPA(hpa *autoscalingv2.HorizontalPodAutoscaler) (*autoscalingv2.HorizontalPodAutoscaler, error) {
+	namespace := hpa.GetNamespace()
+	if len(namespace) == 0 {
+		namespace = h.namespace
+	}
+	hpa.ResourceVersion = ""
+	hpa.UID = ""
+	useV1, err := h.useV1()
+	if err != nil {
+		return nil, err
+	}
+	if !useV1 {
+		return h.clientset.AutoscalingV2().HorizontalPodAutoscalers(namespace).Create(h.ctx, hpa, h.Options.CreateOptions)
+	}
+	hpaV1, err := toV1(hpa)
+	if err != nil {
+		return nil, err
+	}
+	if hpaV1, err = h.clientset.AutoscalingV1().HorizontalPodAutoscalers(namespace).Create(h.ctx, hpaV1, h.Options.CreateOptions); err != nil {
+		return nil, err
+	}
+	return fromV1(hpaV1), nil
+}
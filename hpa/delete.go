@@ -0,0 +1,114 @@
+package hpa
+
+import (
+	"encoding/json"
+	"io/ioutil"
+
+	autoscalingv2 "k8s.io/api/autoscaling/v2"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/yaml"
+)
+
+// Delete deletes hpa from type string, []byte, *autoscalingv2.HorizontalPodAutoscaler,
+// autoscalingv2.HorizontalPodAutoscaler, metav1.Object, runtime.Object, *unstructured.Unstructured,
+// unstructured.Unstructured or map[string]interface{}.
+//
+// If passed parameter type is string, it will simply call DeleteByName instead of DeleteFromFile.
+// You should always explicitly call DeleteFromFile to delete a hpa from file path.
+func (h *Handler) Delete(obj interface{}) error {
+	switch val := obj.(type) {
+	case string:
+		return h.DeleteByName(val)
+	case []byte:
+		return h.DeleteFromBytes(val)
+	case *autoscalingv2.HorizontalPodAutoscaler:
+		return h.DeleteFromObject(val)
+	case autoscalingv2.HorizontalPodAutoscaler:
+		return h.DeleteFromObject(&val)
+	case *unstructured.Unstructured:
+		return h.DeleteFromUnstructured(val)
+	case unstructured.Unstructured:
+		return h.DeleteFromUnstructured(&val)
+	case map[string]interface{}:
+		return h.DeleteFromMap(val)
+	case metav1.Object, runtime.Object:
+		return h.DeleteFromObject(val)
+	default:
+		return ErrInvalidDeleteType
+	}
+}
+
+// DeleteByName deletes hpa by name.
+func (h *Handler) DeleteByName(name string) error {
+	return h.deleteHPA(&autoscalingv2.HorizontalPodAutoscaler{ObjectMeta: metav1.ObjectMeta{Name: name}})
+}
+
+// DeleteFromFile deletes hpa from yaml or json file.
+func (h *Handler) DeleteFromFile(filename string) error {
+	data, err := ioutil.ReadFile(filename)
+	if err != nil {
+		return err
+	}
+	return h.DeleteFromBytes(data)
+}
+
+// DeleteFromBytes deletes hpa from bytes data.
+func (h *Handler) DeleteFromBytes(data []byte) error {
+	hpaJson, err := yaml.ToJSON(data)
+	if err != nil {
+		return err
+	}
+
+	hpa := &autoscalingv2.HorizontalPodAutoscaler{}
+	if err = json.Unmarshal(hpaJson, hpa); err != nil {
+		return err
+	}
+	return h.deleteHPA(hpa)
+}
+
+// DeleteFromObject deletes hpa from metav1.Object or runtime.Object.
+func (h *Handler) DeleteFromObject(obj interface{}) error {
+	hpa, ok := obj.(*autoscalingv2.HorizontalPodAutoscaler)
+	if !ok {
+		return ErrInvalidObjectType
+	}
+	return h.deleteHPA(hpa)
+}
+
+// DeleteFromUnstructured deletes hpa from *unstructured.Unstructured.
+func (h *Handler) DeleteFromUnstructured(u *unstructured.Unstructured) error {
+	hpa := &autoscalingv2.HorizontalPodAutoscaler{}
+	err := runtime.DefaultUnstructuredConverter.FromUnstructured(u.UnstructuredContent(), hpa)
+	if err != nil {
+		return err
+	}
+	return h.deleteHPA(hpa)
+}
+
+// DeleteFromMap deletes hpa from map[string]interface{}.
+func (h *Handler) DeleteFromMap(u map[string]interface{}) error {
+	hpa := &autoscalingv2.HorizontalPodAutoscaler{}
+	err := runtime.DefaultUnstructuredConverter.FromUnstructured(u, hpa)
+	if err != nil {
+		return err
+	}
+	return h.deleteHPA(hpa)
+}
+
+// deleteHPA
+func (h *Handler) deleteHPA(hpa *autoscalingv2.HorizontalPodAutoscaler) error {
+	namespace := hpa.GetNamespace()
+	if len(namespace) == 0 {
+		namespace = h.namespace
+	}
+	useV1, err := h.useV1()
+	if err != nil {
+		return err
+	}
+	if useV1 {
+		return h.clientset.AutoscalingV1().HorizontalPodAutoscalers(namespace).Delete(h.ctx, hpa.Name, h.Options.DeleteOptions)
+	}
+	return h.clientset.AutoscalingV2().HorizontalPodAutoscalers(namespace).Delete(h.ctx, hpa.Name, h.Options.DeleteOptions)
+}
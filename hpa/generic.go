@@ -0,0 +1,13 @@
+package hpa
+
+import (
+	"github.com/forbearing/k8s/types"
+	autoscalingv2 "k8s.io/api/autoscaling/v2"
+)
+
+// Generic returns the handler as a types.Handler, whose methods return
+// runtime.Object instead of *autoscalingv2.HorizontalPodAutoscaler, so it can be used together with
+// the handlers of other resources.
+func (h *Handler) Generic() types.Handler {
+	return types.NewGeneric[*autoscalingv2.HorizontalPodAutoscaler](h, GVK)
+}
@@ -0,0 +1,120 @@
+package hpa
+
+import (
+	"encoding/json"
+	"io/ioutil"
+
+	autoscalingv2 "k8s.io/api/autoscaling/v2"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/yaml"
+)
+
+// Get gets hpa from type string, []byte, *autoscalingv2.HorizontalPodAutoscaler,
+// autoscalingv2.HorizontalPodAutoscaler, metav1.Object, runtime.Object, *unstructured.Unstructured,
+// unstructured.Unstructured or map[string]interface{}.
+//
+// If passed parameter type is string, it will simply call GetByName instead of GetFromFile.
+// You should always explicitly call GetFromFile to get a hpa from file path.
+func (h *Handler) Get(obj interface{}) (*autoscalingv2.HorizontalPodAutoscaler, error) {
+	switch val := obj.(type) {
+	case string:
+		return h.GetByName(val)
+	case []byte:
+		return h.GetFromBytes(val)
+	case *autoscalingv2.HorizontalPodAutoscaler:
+		return h.GetFromObject(val)
+	case autoscalingv2.HorizontalPodAutoscaler:
+		return h.GetFromObject(&val)
+	case *unstructured.Unstructured:
+		return h.GetFromUnstructured(val)
+	case unstructured.Unstructured:
+		return h.GetFromUnstructured(&val)
+	case map[string]interface{}:
+		return h.GetFromMap(val)
+	case metav1.Object, runtime.Object:
+		return h.GetFromObject(val)
+	default:
+		return nil, ErrInvalidGetType
+	}
+}
+
+// GetByName gets hpa by name.
+func (h *Handler) GetByName(name string) (*autoscalingv2.HorizontalPodAutoscaler, error) {
+	return h.getHPA(&autoscalingv2.HorizontalPodAutoscaler{ObjectMeta: metav1.ObjectMeta{Name: name}})
+}
+
+// GetFromFile gets hpa from yaml or json file.
+func (h *Handler) GetFromFile(filename string) (*autoscalingv2.HorizontalPodAutoscaler, error) {
+	data, err := ioutil.ReadFile(filename)
+	if err != nil {
+		return nil, err
+	}
+	return h.GetFromBytes(data)
+}
+
+// GetFromBytes gets hpa from bytes data.
+func (h *Handler) GetFromBytes(data []byte) (*autoscalingv2.HorizontalPodAutoscaler, error) {
+	hpaJson, err := yaml.ToJSON(data)
+	if err != nil {
+		return nil, err
+	}
+
+	hpa := &autoscalingv2.HorizontalPodAutoscaler{}
+	if err = json.Unmarshal(hpaJson, hpa); err != nil {
+		return nil, err
+	}
+	return h.getHPA(hpa)
+}
+
+// GetFromObject gets hpa from metav1.Object or runtime.Object.
+func (h *Handler) GetFromObject(obj interface{}) (*autoscalingv2.HorizontalPodAutoscaler, error) {
+	hpa, ok := obj.(*autoscalingv2.HorizontalPodAutoscaler)
+	if !ok {
+		return nil, ErrInvalidObjectType
+	}
+	return h.getHPA(hpa)
+}
+
+// GetFromUnstructured gets hpa from *unstructured.Unstructured.
+func (h *Handler) GetFromUnstructured(u *unstructured.Unstructured) (*autoscalingv2.HorizontalPodAutoscaler, error) {
+	hpa := &autoscalingv2.HorizontalPodAutoscaler{}
+	err := runtime.DefaultUnstructuredConverter.FromUnstructured(u.UnstructuredContent(), hpa)
+	if err != nil {
+		return nil, err
+	}
+	return h.getHPA(hpa)
+}
+
+// GetFromMap gets hpa from map[string]interface{}.
+func (h *Handler) GetFromMap(u map[string]interface{}) (*autoscalingv2.HorizontalPodAutoscaler, error) {
+	hpa := &autoscalingv2.HorizontalPodAutoscaler{}
+	err := runtime.DefaultUnstructuredConverter.FromUnstructured(u, hpa)
+	if err != nil {
+		return nil, err
+	}
+	return h.getHPA(hpa)
+}
+
+// getHPA
+// It's necessary to get a new hpa resource from a old hpa resource,
+// because old hpa usually don't have hpa.Status field.
+func (h *Handler) getHPA(hpa *autoscalingv2.HorizontalPodAutoscaler) (*autoscalingv2.HorizontalPodAutoscaler, error) {
+	namespace := hpa.GetNamespace()
+	if len(namespace) == 0 {
+		namespace = h.namespace
+	}
+	useV1, err := h.useV1()
+	if err != nil {
+		return nil, err
+	}
+	if !useV1 {
+		return h.clientset.AutoscalingV2().HorizontalPodAutoscalers(namespace).Get(h.ctx, hpa.Name, h.Options.GetOptions)
+	}
+	hpaV1, err := h.clientset.AutoscalingV1().HorizontalPodAutoscalers(namespace).Get(h.ctx, hpa.Name, h.Options.GetOptions)
+	if err != nil {
+		return nil, err
+	}
+	return fromV1(hpaV1), nil
+}
@@ -0,0 +1,76 @@
+package hpa
+
+import (
+	autoscalingv2 "k8s.io/api/autoscaling/v2"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/fields"
+)
+
+// List list all hpas in the k8s cluster, it simply call `ListAll`.
+func (h *Handler) List() ([]*autoscalingv2.HorizontalPodAutoscaler, error) {
+	return h.ListAll()
+}
+
+// ListByLabel list hpas by labels.
+// Multiple labels separated by comma(",") eg: "name=myapp,role=devops",
+// and there is an "And" relationship between multiple labels.
+func (h *Handler) ListByLabel(labels string) ([]*autoscalingv2.HorizontalPodAutoscaler, error) {
+	listOptions := h.Options.ListOptions.DeepCopy()
+	listOptions.LabelSelector = labels
+	hpaList, err := h.listHPA(*listOptions)
+	if err != nil {
+		return nil, err
+	}
+	return extractList(hpaList), nil
+}
+
+// ListByField list hpas by field, work like `kubectl get xxx --field-selector=xxx`.
+func (h *Handler) ListByField(field string) ([]*autoscalingv2.HorizontalPodAutoscaler, error) {
+	fieldSelector, err := fields.ParseSelector(field)
+	if err != nil {
+		return nil, err
+	}
+	listOptions := h.Options.ListOptions.DeepCopy()
+	listOptions.FieldSelector = fieldSelector.String()
+
+	hpaList, err := h.listHPA(*listOptions)
+	if err != nil {
+		return nil, err
+	}
+	return extractList(hpaList), nil
+}
+
+// ListByNamespace list all hpas in the specified namespace.
+func (h *Handler) ListByNamespace(namespace string) ([]*autoscalingv2.HorizontalPodAutoscaler, error) {
+	return h.WithNamespace(namespace).ListByLabel("")
+}
+
+// ListAll list all hpas in the k8s cluster.
+func (h *Handler) ListAll() ([]*autoscalingv2.HorizontalPodAutoscaler, error) {
+	return h.WithNamespace(metav1.NamespaceAll).ListByLabel("")
+}
+
+// listHPA lists the hpas in the handler namespace.
+func (h *Handler) listHPA(listOptions metav1.ListOptions) (*autoscalingv2.HorizontalPodAutoscalerList, error) {
+	useV1, err := h.useV1()
+	if err != nil {
+		return nil, err
+	}
+	if !useV1 {
+		return h.clientset.AutoscalingV2().HorizontalPodAutoscalers(h.namespace).List(h.ctx, listOptions)
+	}
+	hpaListV1, err := h.clientset.AutoscalingV1().HorizontalPodAutoscalers(h.namespace).List(h.ctx, listOptions)
+	if err != nil {
+		return nil, err
+	}
+	return fromV1List(hpaListV1), nil
+}
+
+// extractList
+func extractList(hpaList *autoscalingv2.HorizontalPodAutoscalerList) []*autoscalingv2.HorizontalPodAutoscaler {
+	var objList []*autoscalingv2.HorizontalPodAutoscaler
+	for i := range hpaList.Items {
+		objList = append(objList, &hpaList.Items[i])
+	}
+	return objList
+}
@@ -0,0 +1,209 @@
+package hpa
+
+import (
+	"encoding/json"
+	"os"
+
+	jsonpatch "github.com/evanphx/json-patch"
+	autoscalingv2 "k8s.io/api/autoscaling/v2"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/strategicpatch"
+	"k8s.io/apimachinery/pkg/util/yaml"
+)
+
+// Patch use the default patch type(Strategic Merge Patch) to patch hpa.
+// Supported patch types are: "StrategicMergePatchType", "MergePatchType", "JSONPatchType".
+//
+// For further more Strategic Merge patch, see:
+//     https://kubernetes.io/docs/tasks/manage-kubernetes-objects/update-api-object-kubectl-patch/#before-you-begin
+// For a comparison of JSON patch and JSON merge patch, see:
+//     https://erosb.github.io/post/json-patch-vs-merge-patch/
+func (h *Handler) Patch(original *autoscalingv2.HorizontalPodAutoscaler, patch interface{}, patchOptions ...types.PatchType) (*autoscalingv2.HorizontalPodAutoscaler, error) {
+	switch val := patch.(type) {
+	case string:
+		var err error
+		var patchData []byte
+		var jsonData []byte
+
+		if patchData, err = os.ReadFile(val); err != nil {
+			return nil, err
+		}
+		if jsonData, err = yaml.ToJSON(patchData); err != nil {
+			return nil, err
+		}
+		if len(patchOptions) != 0 && patchOptions[0] == types.JSONPatchType {
+			return h.jsonPatch(original, jsonData)
+		}
+		if len(patchOptions) != 0 && patchOptions[0] == types.MergePatchType {
+			return h.jsonMergePatch(original, jsonData)
+		}
+		return h.strategicMergePatch(original, jsonData)
+
+	case []byte:
+		var err error
+		var jsonData []byte
+
+		if jsonData, err = yaml.ToJSON(val); err != nil {
+			return nil, err
+		}
+		if len(patchOptions) != 0 && patchOptions[0] == types.JSONPatchType {
+			return h.jsonPatch(original, jsonData)
+		}
+		if len(patchOptions) != 0 && patchOptions[0] == types.MergePatchType {
+			return h.jsonMergePatch(original, jsonData)
+		}
+		return h.strategicMergePatch(original, jsonData)
+
+	case *autoscalingv2.HorizontalPodAutoscaler:
+		return h.diffMergePatch(original, val, patchOptions...)
+
+	case autoscalingv2.HorizontalPodAutoscaler:
+		return h.diffMergePatch(original, &val, patchOptions...)
+
+	case map[string]interface{}:
+		modified := &autoscalingv2.HorizontalPodAutoscaler{}
+		if err := runtime.DefaultUnstructuredConverter.FromUnstructured(val, modified); err != nil {
+			return nil, err
+		}
+		return h.diffMergePatch(original, modified, patchOptions...)
+
+	case *unstructured.Unstructured:
+		modified := &autoscalingv2.HorizontalPodAutoscaler{}
+		if err := runtime.DefaultUnstructuredConverter.FromUnstructured(val.UnstructuredContent(), modified); err != nil {
+			return nil, err
+		}
+		return h.diffMergePatch(original, modified, patchOptions...)
+
+	case unstructured.Unstructured:
+		modified := &autoscalingv2.HorizontalPodAutoscaler{}
+		if err := runtime.DefaultUnstructuredConverter.FromUnstructured(val.UnstructuredContent(), modified); err != nil {
+			return nil, err
+		}
+		return h.diffMergePatch(original, modified, patchOptions...)
+
+	case metav1.Object, runtime.Object:
+		modified, ok := patch.(*autoscalingv2.HorizontalPodAutoscaler)
+		if !ok {
+			return nil, ErrInvalidObjectType
+		}
+		return h.diffMergePatch(original, modified, patchOptions...)
+
+	default:
+		return nil, ErrInvalidPatchType
+	}
+}
+
+// strategicMergePatch use the "Strategic Merge Patch" patch type to patch hpa.
+//
+// Notice that the patch did not replace the containers list. Instead it added
+// a new Container to the list. In other words, the list in the patch was merged
+// with the existing list.
+//
+// This is not always what happens when you use a strategic merge patch on a list.
+// In some cases, the list is replaced, not merged.
+//
+// Note: Strategic merge patch is not supported for custom resources.
+// For further more Strategic Merge patch, see:
+//     https://kubernetes.io/docs/tasks/manage-kubernetes-objects/update-api-object-kubectl-patch/#before-you-begin
+func (h *Handler) strategicMergePatch(original *autoscalingv2.HorizontalPodAutoscaler, patchData []byte) (*autoscalingv2.HorizontalPodAutoscaler, error) {
+	if len(patchData) == 0 || string(patchData) == "{}" {
+		return original, nil
+	}
+
+	return h.patch(original, types.StrategicMergePatchType, patchData)
+}
+
+// jsonMergePatch use the "JSON Merge Patch" patch type to patch hpa.
+// A JSON merge patch is different from strategic merge patch, With a JSON merge patch,
+// If you want to update a list, you have to specify the entire new list.
+// And the new list completely replicas the existing list.
+//
+// For a comparison of JSON patch and JSON merge patch, see:
+//     https://erosb.github.io/post/json-patch-vs-merge-patch/
+// For further more Json Patch see:
+//     https://kubernetes.io/docs/tasks/manage-kubernetes-objects/update-api-object-kubectl-patch/#before-you-begin
+//     https://tools.ietf.org/html/rfc6902
+func (h *Handler) jsonMergePatch(original *autoscalingv2.HorizontalPodAutoscaler, patchData []byte) (*autoscalingv2.HorizontalPodAutoscaler, error) {
+	if len(patchData) == 0 || string(patchData) == "{}" {
+		return original, nil
+	}
+
+	return h.patch(original, types.MergePatchType, patchData)
+}
+
+// jsonPatch use "JSON Patch" patch type to patch hpa.
+//
+// For a comparison of JSON patch and JSON merge patch, see:
+//     https://erosb.github.io/post/json-patch-vs-merge-patch/
+// For further more Json Merge Patch see:
+//     https://kubernetes.io/docs/tasks/manage-kubernetes-objects/update-api-object-kubectl-patch/#before-you-begin
+//     https://tools.ietf.org/html/rfc7386
+func (h *Handler) jsonPatch(original *autoscalingv2.HorizontalPodAutoscaler, patchData []byte) (*autoscalingv2.HorizontalPodAutoscaler, error) {
+	return h.patch(original, types.JSONPatchType, patchData)
+}
+
+// diffMergePatch will tak the difference data between original and modified hpa object,
+// and use the default patch type(Strategic Merge Patch) patch the differen hpa.
+// You can set patchOptions to MergePatchType to use the "JSON Merge Patch" to
+// patch hpa.
+func (h *Handler) diffMergePatch(original, modified *autoscalingv2.HorizontalPodAutoscaler, patchOptions ...types.PatchType) (*autoscalingv2.HorizontalPodAutoscaler, error) {
+	var (
+		err          error
+		originalJson []byte
+		modifiedJson []byte
+		patchData    []byte
+	)
+
+	if originalJson, err = json.Marshal(original); err != nil {
+		return nil, err
+	}
+	if modifiedJson, err = json.Marshal(modified); err != nil {
+		return nil, err
+	}
+	// the patch data of "JSON Merge Patch" must contain the entire new list,
+	// the patch data created by strategic merge patch only contains the
+	// changed list items, which would drop the other list items.
+	if len(patchOptions) != 0 && patchOptions[0] == types.MergePatchType {
+		patchData, err = jsonpatch.CreateMergePatch(originalJson, modifiedJson)
+	} else {
+		patchData, err = strategicpatch.CreateTwoWayMergePatch(originalJson, modifiedJson, autoscalingv2.HorizontalPodAutoscaler{})
+	}
+	if err != nil {
+		return nil, err
+	}
+	if len(patchData) == 0 || string(patchData) == "{}" {
+		return original, nil
+	}
+
+	if len(patchOptions) != 0 && patchOptions[0] == types.MergePatchType {
+		return h.patch(original, types.MergePatchType, patchData)
+	}
+	return h.patch(original, types.StrategicMergePatchType, patchData)
+}
+
+// patch sends the patch data to the kubernetes apiserver. If the apiserver
+// only serves autoscaling/v1, the patch data is applied to the original hpa
+// locally, and the difference converted to autoscaling/v1 is sent instead.
+func (h *Handler) patch(original *autoscalingv2.HorizontalPodAutoscaler, patchType types.PatchType, patchData []byte) (*autoscalingv2.HorizontalPodAutoscaler, error) {
+	useV1, err := h.useV1()
+	if err != nil {
+		return nil, err
+	}
+	namespace := original.GetNamespace()
+	if len(namespace) == 0 {
+		namespace = h.namespace
+	}
+	if !useV1 {
+		return h.clientset.AutoscalingV2().HorizontalPodAutoscalers(namespace).
+			Patch(h.ctx, original.Name, patchType, patchData, h.Options.PatchOptions)
+	}
+
+	modified, err := applyPatch(original, patchType, patchData)
+	if err != nil {
+		return nil, err
+	}
+	return h.patchV1(namespace, original, modified, patchType)
+}
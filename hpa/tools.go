@@ -0,0 +1,45 @@
+package hpa
+
+import (
+	"time"
+
+	autoscalingv2 "k8s.io/api/autoscaling/v2"
+)
+
+// GetAge get the hpa age.
+func (h *Handler) GetAge(object interface{}) (time.Duration, error) {
+	switch val := object.(type) {
+	case string:
+		hpa, err := h.Get(val)
+		if err != nil {
+			return time.Duration(0), err
+		}
+		return time.Now().Sub(hpa.CreationTimestamp.Time), nil
+	case *autoscalingv2.HorizontalPodAutoscaler:
+		return time.Now().Sub(val.CreationTimestamp.Time), nil
+	case autoscalingv2.HorizontalPodAutoscaler:
+		return time.Now().Sub(val.CreationTimestamp.Time), nil
+	default:
+		return time.Duration(0), ErrInvalidToolsType
+	}
+}
+
+// GetCurrentReplicas returns the current number of replicas of pods managed by the hpa,
+// as last seen by the autoscaler.
+func (h *Handler) GetCurrentReplicas(name string) (int32, error) {
+	hpa, err := h.Get(name)
+	if err != nil {
+		return 0, err
+	}
+	return hpa.Status.CurrentReplicas, nil
+}
+
+// GetScaleTarget returns the reference to the scaled resource of the hpa,
+// such as a deployment or a statefulset.
+func (h *Handler) GetScaleTarget(name string) (autoscalingv2.CrossVersionObjectReference, error) {
+	hpa, err := h.Get(name)
+	if err != nil {
+		return autoscalingv2.CrossVersionObjectReference{}, err
+	}
+	return hpa.Spec.ScaleTargetRef, nil
+}
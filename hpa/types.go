@@ -0,0 +1,15 @@
+package hpa
+
+import "errors"
+
+var (
+	ErrInvalidToolsType  = errors.New("type must be string, *autoscalingv2.HorizontalPodAutoscaler, autoscalingv2.HorizontalPodAutoscaler, metav1.Object or runtime.Object")
+	ErrInvalidCreateType = errors.New("type must be string, []byte, *autoscalingv2.HorizontalPodAutoscaler, autoscalingv2.HorizontalPodAutoscaler, metav1.Object, runtime.Object, *unstructured.Unstructured, unstructured.Unstructured or map[string]interface{}")
+	ErrInvalidUpdateType = ErrInvalidCreateType
+	ErrInvalidApplyType  = ErrInvalidCreateType
+	ErrInvalidDeleteType = ErrInvalidCreateType
+	ErrInvalidGetType    = ErrInvalidCreateType
+	ErrInvalidPatchType  = errors.New("patch data type must be string, []byte, *autoscalingv2.HorizontalPodAutoscaler, autoscalingv2.HorizontalPodAutoscaler, metav1.Object, runtime.Object, *unstructured.Unstructured, unstructured.Unstructured or map[string]interface{}")
+	ErrInvalidObjectType = errors.New("object type is not *autoscalingv2.HorizontalPodAutoscaler")
+	ErrUnsupportedByV1   = errors.New("hpa can't be represented in autoscaling/v1, only the cpu utilization target is supported")
+)
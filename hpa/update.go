@@ -0,0 +1,115 @@
+package hpa
+
+import (
+	"encoding/json"
+	"io/ioutil"
+
+	autoscalingv2 "k8s.io/api/autoscaling/v2"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/yaml"
+)
+
+// Update updates hpa from type string, []byte, *autoscalingv2.HorizontalPodAutoscaler,
+// autoscalingv2.HorizontalPodAutoscaler, metav1.Object, runtime.Object, *unstructured.Unstructured,
+// unstructured.Unstructured or map[string]interface{}.
+func (h *Handler) Update(obj interface{}) (*autoscalingv2.HorizontalPodAutoscaler, error) {
+	switch val := obj.(type) {
+	case string:
+		return h.UpdateFromFile(val)
+	case []byte:
+		return h.UpdateFromBytes(val)
+	case *autoscalingv2.HorizontalPodAutoscaler:
+		return h.UpdateFromObject(val)
+	case autoscalingv2.HorizontalPodAutoscaler:
+		return h.UpdateFromObject(&val)
+	case *unstructured.Unstructured:
+		return h.UpdateFromUnstructured(val)
+	case unstructured.Unstructured:
+		return h.UpdateFromUnstructured(&val)
+	case map[string]interface{}:
+		return h.UpdateFromMap(val)
+	case metav1.Object, runtime.Object:
+		return h.UpdateFromObject(val)
+	default:
+		return nil, ErrInvalidUpdateType
+	}
+}
+
+// UpdateFromFile updates hpa from yaml or json file.
+func (h *Handler) UpdateFromFile(filename string) (*autoscalingv2.HorizontalPodAutoscaler, error) {
+	data, err := ioutil.ReadFile(filename)
+	if err != nil {
+		return nil, err
+	}
+	return h.UpdateFromBytes(data)
+}
+
+// UpdateFromBytes updates hpa from bytes data.
+func (h *Handler) UpdateFromBytes(data []byte) (*autoscalingv2.HorizontalPodAutoscaler, error) {
+	hpaJson, err := yaml.ToJSON(data)
+	if err != nil {
+		return nil, err
+	}
+
+	hpa := &autoscalingv2.HorizontalPodAutoscaler{}
+	if err = json.Unmarshal(hpaJson, hpa); err != nil {
+		return nil, err
+	}
+	return h.updateHPA(hpa)
+}
+
+// UpdateFromObject updates hpa from metav1.Object or runtime.Object.
+func (h *Handler) UpdateFromObject(obj interface{}) (*autoscalingv2.HorizontalPodAutoscaler, error) {
+	hpa, ok := obj.(*autoscalingv2.HorizontalPodAutoscaler)
+	if !ok {
+		return nil, ErrInvalidObjectType
+	}
+	return h.updateHPA(hpa)
+}
+
+// UpdateFromUnstructured updates hpa from *unstructured.Unstructured.
+func (h *Handler) UpdateFromUnstructured(u *unstructured.Unstructured) (*autoscalingv2.HorizontalPodAutoscaler, error) {
+	hpa := &autoscalingv2.HorizontalPodAutoscaler{}
+	err := runtime.DefaultUnstructuredConverter.FromUnstructured(u.UnstructuredContent(), hpa)
+	if err != nil {
+		return nil, err
+	}
+	return h.updateHPA(hpa)
+}
+
+// UpdateFromMap updates hpa from map[string]interface{}.
+func (h *Handler) UpdateFromMap(u map[string]interface{}) (*autoscalingv2.HorizontalPodAutoscaler, error) {
+	hpa := &autoscalingv2.HorizontalPodAutoscaler{}
+	err := runtime.DefaultUnstructuredConverter.FromUnstructured(u, hpa)
+	if err != nil {
+		return nil, err
+	}
+	return h.updateHPA(hpa)
+}
+
+// updateHPA
+func (h *Handler) updateHPA(hpa *autoscalingv2.HorizontalPodAutoscaler) (*autoscalingv2.HorizontalPodAutoscaler, error) {
+	namespace := hpa.GetNamespace()
+	if len(namespace) == 0 {
+		namespace = h.namespace
+	}
+	hpa.ResourceVersion = ""
+	hpa.UID = ""
+	useV1, err := h.useV1()
+	if err != nil {
+		return nil, err
+	}
+	if !useV1 {
+		return h.clientset.AutoscalingV2().HorizontalPodAutoscalers(namespace).Update(h.ctx, hpa, h.Options.UpdateOptions)
+	}
+	hpaV1, err := toV1(hpa)
+	if err != nil {
+		return nil, err
+	}
+	if hpaV1, err = h.clientset.AutoscalingV1().HorizontalPodAutoscalers(namespace).Update(h.ctx, hpaV1, h.Options.UpdateOptions); err != nil {
+		return nil, err
+	}
+	return fromV1(hpaV1), nil
+}
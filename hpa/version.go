@@ -0,0 +1,206 @@
+package hpa
+
+import (
+	"encoding/json"
+
+	jsonpatch "github.com/evanphx/json-patch"
+	autoscalingv1 "k8s.io/api/autoscaling/v1"
+	autoscalingv2 "k8s.io/api/autoscaling/v2"
+	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/strategicpatch"
+)
+
+// useV1 returns true if the kubernetes apiserver doesn't serve the autoscaling/v2
+// HorizontalPodAutoscaler. The API version is detected by the discovery client
+// only once, and cached in the handler.
+func (h *Handler) useV1() (bool, error) {
+	h.l.RLock()
+	apiVersion := h.apiVersion
+	h.l.RUnlock()
+	if len(apiVersion) != 0 {
+		return apiVersion == autoscalingv1.SchemeGroupVersion.String(), nil
+	}
+
+	apiVersion = autoscalingv1.SchemeGroupVersion.String()
+	resources, err := h.discoveryClient.ServerResourcesForGroupVersion(autoscalingv2.SchemeGroupVersion.String())
+	switch {
+	case k8serrors.IsNotFound(err):
+	case err != nil:
+		return false, err
+	default:
+		for _, r := range resources.APIResources {
+			if r.Name == Resource {
+				apiVersion = autoscalingv2.SchemeGroupVersion.String()
+				break
+			}
+		}
+	}
+
+	h.l.Lock()
+	h.apiVersion = apiVersion
+	h.l.Unlock()
+	return apiVersion == autoscalingv1.SchemeGroupVersion.String(), nil
+}
+
+// toV1 converts the autoscaling/v2 hpa to autoscaling/v1 hpa. autoscaling/v1
+// only supports the cpu utilization target, ErrUnsupportedByV1 is returned if
+// the hpa has other metrics or the scaling behavior.
+func toV1(hpa *autoscalingv2.HorizontalPodAutoscaler) (*autoscalingv1.HorizontalPodAutoscaler, error) {
+	if hpa.Spec.Behavior != nil || len(hpa.Spec.Metrics) > 1 {
+		return nil, ErrUnsupportedByV1
+	}
+	hpaV1 := &autoscalingv1.HorizontalPodAutoscaler{
+		ObjectMeta: *hpa.ObjectMeta.DeepCopy(),
+		Spec: autoscalingv1.HorizontalPodAutoscalerSpec{
+			ScaleTargetRef: autoscalingv1.CrossVersionObjectReference{
+				Kind:       hpa.Spec.ScaleTargetRef.Kind,
+				Name:       hpa.Spec.ScaleTargetRef.Name,
+				APIVersion: hpa.Spec.ScaleTargetRef.APIVersion,
+			},
+			MinReplicas: hpa.Spec.MinReplicas,
+			MaxReplicas: hpa.Spec.MaxReplicas,
+		},
+	}
+	for _, metric := range hpa.Spec.Metrics {
+		if metric.Type != autoscalingv2.ResourceMetricSourceType ||
+			metric.Resource == nil ||
+			metric.Resource.Name != corev1.ResourceCPU ||
+			metric.Resource.Target.Type != autoscalingv2.UtilizationMetricType ||
+			metric.Resource.Target.AverageUtilization == nil {
+			return nil, ErrUnsupportedByV1
+		}
+		utilization := *metric.Resource.Target.AverageUtilization
+		hpaV1.Spec.TargetCPUUtilizationPercentage = &utilization
+	}
+	return hpaV1, nil
+}
+
+// fromV1 converts the autoscaling/v1 hpa to autoscaling/v2 hpa.
+func fromV1(hpaV1 *autoscalingv1.HorizontalPodAutoscaler) *autoscalingv2.HorizontalPodAutoscaler {
+	hpa := &autoscalingv2.HorizontalPodAutoscaler{
+		ObjectMeta: *hpaV1.ObjectMeta.DeepCopy(),
+		Spec: autoscalingv2.HorizontalPodAutoscalerSpec{
+			ScaleTargetRef: autoscalingv2.CrossVersionObjectReference{
+				Kind:       hpaV1.Spec.ScaleTargetRef.Kind,
+				Name:       hpaV1.Spec.ScaleTargetRef.Name,
+				APIVersion: hpaV1.Spec.ScaleTargetRef.APIVersion,
+			},
+			MinReplicas: hpaV1.Spec.MinReplicas,
+			MaxReplicas: hpaV1.Spec.MaxReplicas,
+		},
+		Status: autoscalingv2.HorizontalPodAutoscalerStatus{
+			ObservedGeneration: hpaV1.Status.ObservedGeneration,
+			LastScaleTime:      hpaV1.Status.LastScaleTime,
+			CurrentReplicas:    hpaV1.Status.CurrentReplicas,
+			DesiredReplicas:    hpaV1.Status.DesiredReplicas,
+		},
+	}
+	if hpaV1.Spec.TargetCPUUtilizationPercentage != nil {
+		utilization := *hpaV1.Spec.TargetCPUUtilizationPercentage
+		hpa.Spec.Metrics = []autoscalingv2.MetricSpec{{
+			Type: autoscalingv2.ResourceMetricSourceType,
+			Resource: &autoscalingv2.ResourceMetricSource{
+				Name: corev1.ResourceCPU,
+				Target: autoscalingv2.MetricTarget{
+					Type:               autoscalingv2.UtilizationMetricType,
+					AverageUtilization: &utilization,
+				},
+			},
+		}}
+	}
+	if hpaV1.Status.CurrentCPUUtilizationPercentage != nil {
+		utilization := *hpaV1.Status.CurrentCPUUtilizationPercentage
+		hpa.Status.CurrentMetrics = []autoscalingv2.MetricStatus{{
+			Type: autoscalingv2.ResourceMetricSourceType,
+			Resource: &autoscalingv2.ResourceMetricStatus{
+				Name:    corev1.ResourceCPU,
+				Current: autoscalingv2.MetricValueStatus{AverageUtilization: &utilization},
+			},
+		}}
+	}
+	return hpa
+}
+
+// fromV1List converts the autoscaling/v1 hpa list to autoscaling/v2 hpa list.
+func fromV1List(hpaListV1 *autoscalingv1.HorizontalPodAutoscalerList) *autoscalingv2.HorizontalPodAutoscalerList {
+	hpaList := &autoscalingv2.HorizontalPodAutoscalerList{ListMeta: hpaListV1.ListMeta}
+	for i := range hpaListV1.Items {
+		hpaList.Items = append(hpaList.Items, *fromV1(&hpaListV1.Items[i]))
+	}
+	return hpaList
+}
+
+// applyPatch applies the patch data of patchType to the original hpa locally
+// and returns the patched hpa.
+func applyPatch(original *autoscalingv2.HorizontalPodAutoscaler, patchType types.PatchType, patchData []byte) (*autoscalingv2.HorizontalPodAutoscaler, error) {
+	originalJson, err := json.Marshal(original)
+	if err != nil {
+		return nil, err
+	}
+	var modifiedJson []byte
+	switch patchType {
+	case types.JSONPatchType:
+		patch, err := jsonpatch.DecodePatch(patchData)
+		if err != nil {
+			return nil, err
+		}
+		modifiedJson, err = patch.Apply(originalJson)
+	case types.MergePatchType:
+		modifiedJson, err = jsonpatch.MergePatch(originalJson, patchData)
+	default:
+		modifiedJson, err = strategicpatch.StrategicMergePatch(originalJson, patchData, autoscalingv2.HorizontalPodAutoscaler{})
+	}
+	if err != nil {
+		return nil, err
+	}
+	modified := &autoscalingv2.HorizontalPodAutoscaler{}
+	if err := json.Unmarshal(modifiedJson, modified); err != nil {
+		return nil, err
+	}
+	return modified, nil
+}
+
+// patchV1 converts the original and modified hpa to autoscaling/v1, and
+// patches the autoscaling/v1 hpa with the difference between them.
+// The difference is sent as "JSON Merge Patch" if patchType is MergePatchType,
+// otherwise as "Strategic Merge Patch".
+func (h *Handler) patchV1(namespace string, original, modified *autoscalingv2.HorizontalPodAutoscaler, patchType types.PatchType) (*autoscalingv2.HorizontalPodAutoscaler, error) {
+	originalV1, err := toV1(original)
+	if err != nil {
+		return nil, err
+	}
+	modifiedV1, err := toV1(modified)
+	if err != nil {
+		return nil, err
+	}
+	originalJson, err := json.Marshal(originalV1)
+	if err != nil {
+		return nil, err
+	}
+	modifiedJson, err := json.Marshal(modifiedV1)
+	if err != nil {
+		return nil, err
+	}
+	var patchData []byte
+	if patchType == types.MergePatchType {
+		patchData, err = jsonpatch.CreateMergePatch(originalJson, modifiedJson)
+	} else {
+		patchType = types.StrategicMergePatchType
+		patchData, err = strategicpatch.CreateTwoWayMergePatch(originalJson, modifiedJson, autoscalingv1.HorizontalPodAutoscaler{})
+	}
+	if err != nil {
+		return nil, err
+	}
+	if len(patchData) == 0 || string(patchData) == "{}" {
+		return original, nil
+	}
+
+	hpaV1, err := h.clientset.AutoscalingV1().HorizontalPodAutoscalers(namespace).
+		Patch(h.ctx, original.Name, patchType, patchData, h.Options.PatchOptions)
+	if err != nil {
+		return nil, err
+	}
+	return fromV1(hpaV1), nil
+}
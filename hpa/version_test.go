@@ -0,0 +1,288 @@
+package hpa
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+
+	"github.com/forbearing/k8s/types"
+	autoscalingv1 "k8s.io/api/autoscaling/v1"
+	autoscalingv2 "k8s.io/api/autoscaling/v2"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	k8stypes "k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/discovery"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/rest"
+)
+
+// newTestHandler creates a hpa handler in namespace "test", whose clientset
+// and discovery client send the requests to the fake kubernetes API server serve.
+func newTestHandler(t *testing.T, serve http.HandlerFunc) *Handler {
+	srv := httptest.NewServer(serve)
+	t.Cleanup(srv.Close)
+	config := &rest.Config{Host: srv.URL}
+	clientset, err := kubernetes.NewForConfig(config)
+	if err != nil {
+		t.Fatal(err)
+	}
+	discoveryClient, err := discovery.NewDiscoveryClientForConfig(config)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return &Handler{
+		ctx:             context.Background(),
+		namespace:       "test",
+		clientset:       clientset,
+		discoveryClient: discoveryClient,
+		Options:         &types.HandlerOptions{},
+	}
+}
+
+// serveDiscovery serves the autoscaling/v2 discovery document if v2 is true,
+// otherwise autoscaling/v2 is not found. It returns false if the request is
+// not a discovery request.
+func serveDiscovery(w http.ResponseWriter, r *http.Request, v2 bool, discoveries *int32) bool {
+	if r.URL.Path != "/apis/autoscaling/v2" {
+		return false
+	}
+	atomic.AddInt32(discoveries, 1)
+	w.Header().Set("Content-Type", "application/json")
+	if !v2 {
+		w.WriteHeader(http.StatusNotFound)
+		fmt.Fprintln(w, `{"kind":"Status","apiVersion":"v1","status":"Failure","reason":"NotFound","code":404}`)
+		return true
+	}
+	fmt.Fprintln(w, `{"kind":"APIResourceList","apiVersion":"v1","groupVersion":"autoscaling/v2","resources":[`+
+		`{"name":"horizontalpodautoscalers","namespaced":true,"kind":"HorizontalPodAutoscaler","verbs":["get","list"]}]}`)
+	return true
+}
+
+func TestV2(t *testing.T) {
+	var discoveries int32
+	h := newTestHandler(t, func(w http.ResponseWriter, r *http.Request) {
+		if serveDiscovery(w, r, true, &discoveries) {
+			return
+		}
+		if r.URL.Path != "/apis/autoscaling/v2/namespaces/test/horizontalpodautoscalers/web" {
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprintln(w, `{"kind":"HorizontalPodAutoscaler","apiVersion":"autoscaling/v2","metadata":{"name":"web","namespace":"test"},`+
+			`"spec":{"scaleTargetRef":{"kind":"Deployment","name":"web","apiVersion":"apps/v1"},"maxReplicas":5},`+
+			`"status":{"currentReplicas":3,"desiredReplicas":3}}`)
+	})
+
+	replicas, err := h.GetCurrentReplicas("web")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if replicas != 3 {
+		t.Errorf("current replicas = %d, want 3", replicas)
+	}
+	target, err := h.GetScaleTarget("web")
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := autoscalingv2.CrossVersionObjectReference{Kind: "Deployment", Name: "web", APIVersion: "apps/v1"}
+	if target != want {
+		t.Errorf("scale target = %+v, want %+v", target, want)
+	}
+	if discoveries != 1 {
+		t.Errorf("discovered the API version %d times, want 1", discoveries)
+	}
+}
+
+func TestV1Fallback(t *testing.T) {
+	var (
+		discoveries int32
+		created     *autoscalingv1.HorizontalPodAutoscaler
+	)
+	h := newTestHandler(t, func(w http.ResponseWriter, r *http.Request) {
+		if serveDiscovery(w, r, false, &discoveries) {
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == "/apis/autoscaling/v1/namespaces/test/horizontalpodautoscalers/web":
+			fmt.Fprintln(w, `{"kind":"HorizontalPodAutoscaler","apiVersion":"autoscaling/v1","metadata":{"name":"web","namespace":"test"},`+
+				`"spec":{"scaleTargetRef":{"kind":"Deployment","name":"web","apiVersion":"apps/v1"},"maxReplicas":5,"targetCPUUtilizationPercentage":80},`+
+				`"status":{"currentReplicas":2,"desiredReplicas":2,"currentCPUUtilizationPercentage":60}}`)
+		case r.Method == http.MethodPost && r.URL.Path == "/apis/autoscaling/v1/namespaces/test/horizontalpodautoscalers":
+			data, _ := io.ReadAll(r.Body)
+			created = &autoscalingv1.HorizontalPodAutoscaler{}
+			if err := json.Unmarshal(data, created); err != nil {
+				t.Error(err)
+			}
+			w.Write(data)
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+	})
+
+	hpa, err := h.Get("web")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(hpa.Spec.Metrics) != 1 || hpa.Spec.Metrics[0].Resource.Name != corev1.ResourceCPU ||
+		*hpa.Spec.Metrics[0].Resource.Target.AverageUtilization != 80 {
+		t.Errorf("metrics = %+v, want cpu utilization 80", hpa.Spec.Metrics)
+	}
+	if len(hpa.Status.CurrentMetrics) != 1 || *hpa.Status.CurrentMetrics[0].Resource.Current.AverageUtilization != 60 {
+		t.Errorf("current metrics = %+v, want cpu utilization 60", hpa.Status.CurrentMetrics)
+	}
+	replicas, err := h.GetCurrentReplicas("web")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if replicas != 2 {
+		t.Errorf("current replicas = %d, want 2", replicas)
+	}
+
+	utilization := int32(50)
+	hpa = &autoscalingv2.HorizontalPodAutoscaler{
+		ObjectMeta: metav1.ObjectMeta{Name: "api"},
+		Spec: autoscalingv2.HorizontalPodAutoscalerSpec{
+			ScaleTargetRef: autoscalingv2.CrossVersionObjectReference{Kind: "Deployment", Name: "api", APIVersion: "apps/v1"},
+			MaxReplicas:    10,
+			Metrics: []autoscalingv2.MetricSpec{{
+				Type: autoscalingv2.ResourceMetricSourceType,
+				Resource: &autoscalingv2.ResourceMetricSource{
+					Name:   corev1.ResourceCPU,
+					Target: autoscalingv2.MetricTarget{Type: autoscalingv2.UtilizationMetricType, AverageUtilization: &utilization},
+				},
+			}},
+		},
+	}
+	if _, err := h.Create(hpa); err != nil {
+		t.Fatal(err)
+	}
+	if created == nil || created.Spec.TargetCPUUtilizationPercentage == nil || *created.Spec.TargetCPUUtilizationPercentage != 50 ||
+		created.Spec.MaxReplicas != 10 || created.Spec.ScaleTargetRef.Name != "api" {
+		t.Errorf("created autoscaling/v1 hpa %+v", created)
+	}
+
+	// autoscaling/v1 doesn't support the memory metrics.
+	hpa.Spec.Metrics[0].Resource.Name = corev1.ResourceMemory
+	if _, err := h.Create(hpa); !errors.Is(err, ErrUnsupportedByV1) {
+		t.Errorf("Create() = %v, want ErrUnsupportedByV1", err)
+	}
+	if discoveries != 1 {
+		t.Errorf("discovered the API version %d times, want 1", discoveries)
+	}
+}
+
+func TestV1Patch(t *testing.T) {
+	var patches []string
+	h := newTestHandler(t, func(w http.ResponseWriter, r *http.Request) {
+		if serveDiscovery(w, r, false, new(int32)) {
+			return
+		}
+		if r.Method != http.MethodPatch || r.URL.Path != "/apis/autoscaling/v1/namespaces/test/horizontalpodautoscalers/web" {
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+		data, _ := io.ReadAll(r.Body)
+		patches = append(patches, r.Header.Get("Content-Type")+" "+string(data))
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprintln(w, `{"kind":"HorizontalPodAutoscaler","apiVersion":"autoscaling/v1","metadata":{"name":"web","namespace":"test"},`+
+			`"spec":{"scaleTargetRef":{"kind":"Deployment","name":"web","apiVersion":"apps/v1"},"maxReplicas":8,"targetCPUUtilizationPercentage":50}}`)
+	})
+
+	newHPA := func(maxReplicas, utilization int32) *autoscalingv2.HorizontalPodAutoscaler {
+		return &autoscalingv2.HorizontalPodAutoscaler{
+			ObjectMeta: metav1.ObjectMeta{Name: "web", Namespace: "test"},
+			Spec: autoscalingv2.HorizontalPodAutoscalerSpec{
+				ScaleTargetRef: autoscalingv2.CrossVersionObjectReference{Kind: "Deployment", Name: "web", APIVersion: "apps/v1"},
+				MaxReplicas:    maxReplicas,
+				Metrics: []autoscalingv2.MetricSpec{{
+					Type: autoscalingv2.ResourceMetricSourceType,
+					Resource: &autoscalingv2.ResourceMetricSource{
+						Name:   corev1.ResourceCPU,
+						Target: autoscalingv2.MetricTarget{Type: autoscalingv2.UtilizationMetricType, AverageUtilization: &utilization},
+					},
+				}},
+			},
+		}
+	}
+	original := newHPA(5, 80)
+
+	hpa, err := h.Patch(original, newHPA(8, 50))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if hpa.Spec.MaxReplicas != 8 || len(hpa.Spec.Metrics) != 1 || *hpa.Spec.Metrics[0].Resource.Target.AverageUtilization != 50 {
+		t.Errorf("patched hpa spec = %+v", hpa.Spec)
+	}
+	if _, err := h.Patch(original, []byte(`{"spec":{"maxReplicas":8}}`), k8stypes.MergePatchType); err != nil {
+		t.Fatal(err)
+	}
+	// the patch data of autoscaling/v2 hpa must be converted to autoscaling/v1.
+	if _, err := h.Patch(original, []byte(`[{"op":"replace","path":"/spec/metrics/0/resource/target/averageUtilization","value":50}]`),
+		k8stypes.JSONPatchType); err != nil {
+		t.Fatal(err)
+	}
+	want := []string{
+		`application/strategic-merge-patch+json {"spec":{"maxReplicas":8,"targetCPUUtilizationPercentage":50}}`,
+		`application/merge-patch+json {"spec":{"maxReplicas":8}}`,
+		`application/strategic-merge-patch+json {"spec":{"targetCPUUtilizationPercentage":50}}`,
+	}
+	if len(patches) != len(want) {
+		t.Fatalf("got patches %q, want %q", patches, want)
+	}
+	for i := range want {
+		if patches[i] != want[i] {
+			t.Errorf("patches[%d] = %s, want %s", i, patches[i], want[i])
+		}
+	}
+
+	// autoscaling/v1 doesn't support the memory metrics.
+	if _, err := h.Patch(original, []byte(`{"spec":{"metrics":[{"type":"Resource","resource":{"name":"memory"}}]}}`),
+		k8stypes.MergePatchType); !errors.Is(err, ErrUnsupportedByV1) {
+		t.Errorf("Patch() = %v, want ErrUnsupportedByV1", err)
+	}
+}
+
+func TestV1Watch(t *testing.T) {
+	var connections int32
+	h := newTestHandler(t, func(w http.ResponseWriter, r *http.Request) {
+		if serveDiscovery(w, r, false, new(int32)) {
+			return
+		}
+		if r.URL.Path != "/apis/autoscaling/v1/namespaces/test/horizontalpodautoscalers" || r.URL.Query().Get("watch") != "true" {
+			t.Errorf("unexpected request %s %s", r.Method, r.URL)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		if atomic.AddInt32(&connections, 1) == 1 {
+			fmt.Fprintln(w, `{"type":"ADDED","object":{"kind":"HorizontalPodAutoscaler","apiVersion":"autoscaling/v1",`+
+				`"metadata":{"name":"web","namespace":"test"},"spec":{"maxReplicas":5,"targetCPUUtilizationPercentage":80}}}`)
+			return
+		}
+		<-r.Context().Done()
+	})
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	h.ctx = ctx
+
+	added := make(chan interface{}, 1)
+	errCh := make(chan error, 1)
+	go func() {
+		errCh <- h.WatchByLabel("", func(obj interface{}) { added <- obj }, func(interface{}) {}, func(interface{}) {})
+	}()
+	hpa, ok := (<-added).(*autoscalingv2.HorizontalPodAutoscaler)
+	if !ok {
+		t.Fatal("the event object is not converted to autoscaling/v2 hpa")
+	}
+	if hpa.Name != "web" || len(hpa.Spec.Metrics) != 1 || *hpa.Spec.Metrics[0].Resource.Target.AverageUtilization != 80 {
+		t.Errorf("added hpa = %+v", hpa)
+	}
+	cancel()
+	if err := <-errCh; !errors.Is(err, context.Canceled) {
+		t.Errorf("WatchByLabel() = %v, want context.Canceled", err)
+	}
+}
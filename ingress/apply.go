@@ -1,8 +1,6 @@
 package ingress
 
 import (
-	"fmt"
-
 	networkingv1 "k8s.io/api/networking/v1"
 	k8serrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -58,7 +56,7 @@ func (h *Handler) ApplyFromBytes(data []byte) (ing *networkingv1.Ingress, err er
 func (h *Handler) ApplyFromObject(obj interface{}) (*networkingv1.Ingress, error) {
 	ing, ok := obj.(*networkingv1.Ingress)
 	if !ok {
-		return nil, fmt.Errorf("object type is not *networkingv1.Ingress")
+		return nil, ErrInvalidObjectType
 	}
 	return h.applyIngress(ing)
 }
@@ -2,7 +2,6 @@ package ingress
 
 import (
 	"encoding/json"
-	"fmt"
 	"io/ioutil"
 
 	networkingv1 "k8s.io/api/networking/v1"
@@ -73,7 +72,7 @@ func (h *Handler) DeleteFromBytes(data []byte) error {
 func (h *Handler) DeleteFromObject(obj interface{}) error {
 	ing, ok := obj.(*networkingv1.Ingress)
 	if !ok {
-		return fmt.Errorf("object type is not *networkingv1.Ingress")
+		return ErrInvalidObjectType
 	}
 	return h.deleteIngress(ing)
 }
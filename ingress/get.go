@@ -2,10 +2,10 @@ package ingress
 
 import (
 	"encoding/json"
-	"fmt"
 	"io/ioutil"
 
 	networkingv1 "k8s.io/api/networking/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime"
@@ -46,6 +46,20 @@ func (h *Handler) GetByName(name string) (*networkingv1.Ingress, error) {
 	return h.clientset.NetworkingV1().Ingresses(h.namespace).Get(h.ctx, name, h.Options.GetOptions)
 }
 
+// Exists returns true if the ingress exists. It returns false and nil error if
+// the ingress is not found, the other errors, such as forbidden, are returned.
+func (h *Handler) Exists(name string) (bool, error) {
+	_, err := h.GetByName(name)
+	switch {
+	case err == nil:
+		return true, nil
+	case k8serrors.IsNotFound(err):
+		return false, nil
+	default:
+		return false, err
+	}
+}
+
 // GetFromFile gets ingress from yaml or json file.
 func (h *Handler) GetFromFile(filename string) (*networkingv1.Ingress, error) {
 	data, err := ioutil.ReadFile(filename)
@@ -73,7 +87,7 @@ func (h *Handler) GetFromBytes(data []byte) (*networkingv1.Ingress, error) {
 func (h *Handler) GetFromObject(obj interface{}) (*networkingv1.Ingress, error) {
 	ing, ok := obj.(*networkingv1.Ingress)
 	if !ok {
-		return nil, fmt.Errorf("object type is not *networkingv1.Ingress")
+		return nil, ErrInvalidObjectType
 	}
 	return h.getIngress(ing)
 }
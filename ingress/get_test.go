@@ -0,0 +1,64 @@
+package ingress
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/forbearing/k8s/types"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/rest"
+)
+
+func TestExists(t *testing.T) {
+	tests := []struct {
+		name string
+		// reason and code is the response of kubernetes API server,
+		// an empty reason means the ingress is returned.
+		reason     string
+		code       int
+		wantExists bool
+		wantErr    func(error) bool
+	}{
+		{"exists", "", http.StatusOK, true, nil},
+		{"not found", "NotFound", http.StatusNotFound, false, nil},
+		{"forbidden", "Forbidden", http.StatusForbidden, false, k8serrors.IsForbidden},
+		{"timeout", "Timeout", http.StatusGatewayTimeout, false, k8serrors.IsTimeout},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if r.Method != http.MethodGet || r.URL.Path != "/apis/networking.k8s.io/v1/namespaces/test/ingresses/nginx" {
+					t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+				}
+				w.Header().Set("Content-Type", "application/json")
+				if test.reason == "" {
+					fmt.Fprintln(w, `{"kind":"Ingress","apiVersion":"networking.k8s.io/v1","metadata":{"name":"nginx","namespace":"test"}}`)
+					return
+				}
+				w.WriteHeader(test.code)
+				fmt.Fprintf(w, `{"kind":"Status","apiVersion":"v1","status":"Failure","reason":%q,"code":%d}`+"\n", test.reason, test.code)
+			}))
+			defer srv.Close()
+			clientset, err := kubernetes.NewForConfig(&rest.Config{Host: srv.URL})
+			if err != nil {
+				t.Fatal(err)
+			}
+			h := &Handler{ctx: context.Background(), namespace: "test", clientset: clientset, Options: &types.HandlerOptions{}}
+
+			exists, err := h.Exists("nginx")
+			if exists != test.wantExists {
+				t.Errorf("Exists() = %v, want %v", exists, test.wantExists)
+			}
+			switch {
+			case test.wantErr == nil && err != nil:
+				t.Errorf("Exists() error = %v, want nil", err)
+			case test.wantErr != nil && !test.wantErr(err):
+				t.Errorf("Exists() error = %v, want %s error", err, test.reason)
+			}
+		})
+	}
+}
@@ -0,0 +1,42 @@
+package ingress
+
+import (
+	"errors"
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+)
+
+func TestInvalidTypeErrors(t *testing.T) {
+	h := &Handler{}
+	// a pod is a metav1.Object but not a *networkingv1.Ingress.
+	pod := &corev1.Pod{}
+	original := &networkingv1.Ingress{}
+
+	tests := []struct {
+		name string
+		call func() error
+		want error
+	}{
+		{"Create", func() error { _, err := h.Create(42); return err }, ErrInvalidCreateType},
+		{"CreateFromObject", func() error { _, err := h.Create(pod); return err }, ErrInvalidObjectType},
+		{"Update", func() error { _, err := h.Update(42); return err }, ErrInvalidUpdateType},
+		{"UpdateFromObject", func() error { _, err := h.Update(pod); return err }, ErrInvalidObjectType},
+		{"Apply", func() error { _, err := h.Apply(42); return err }, ErrInvalidApplyType},
+		{"ApplyFromObject", func() error { _, err := h.Apply(pod); return err }, ErrInvalidObjectType},
+		{"Get", func() error { _, err := h.Get(42); return err }, ErrInvalidGetType},
+		{"GetFromObject", func() error { _, err := h.Get(pod); return err }, ErrInvalidObjectType},
+		{"Delete", func() error { return h.Delete(42) }, ErrInvalidDeleteType},
+		{"DeleteFromObject", func() error { return h.Delete(pod) }, ErrInvalidObjectType},
+		{"Patch", func() error { _, err := h.Patch(original, 42); return err }, ErrInvalidPatchType},
+		{"PatchFromObject", func() error { _, err := h.Patch(original, pod); return err }, ErrInvalidObjectType},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if err := test.call(); !errors.Is(err, test.want) {
+				t.Errorf("got error %v, want %v", err, test.want)
+			}
+		})
+	}
+}
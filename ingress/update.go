@@ -2,7 +2,6 @@ package ingress
 
 import (
 	"encoding/json"
-	"fmt"
 	"io/ioutil"
 
 	networkingv1 "k8s.io/api/networking/v1"
@@ -65,7 +64,7 @@ func (h *Handler) UpdateFromBytes(data []byte) (*networkingv1.Ingress, error) {
 func (h *Handler) UpdateFromObject(obj interface{}) (*networkingv1.Ingress, error) {
 	ing, ok := obj.(*networkingv1.Ingress)
 	if !ok {
-		return nil, fmt.Errorf("object type is not *networkingv1.Ingress")
+		return nil, ErrInvalidObjectType
 	}
 	return h.updateIngress(ing)
 }
@@ -0,0 +1,187 @@
+package ingress
+
+import (
+	"context"
+	"crypto/tls"
+	"fmt"
+	"net"
+	"net/http"
+	"strconv"
+	"time"
+
+	networkingv1 "k8s.io/api/networking/v1"
+	"k8s.io/apimachinery/pkg/util/wait"
+)
+
+// pollInterval is the interval between two checks of the ingress.
+const pollInterval = 2 * time.Second
+
+// ServingProbe is the http probe used by WaitServing.
+type ServingProbe struct {
+	// Scheme is "http" or "https", default to "https" if the ingress has tls
+	// for the host, otherwise "http".
+	Scheme string
+	// Port is the port of the ingress address, default to 80 for "http" and
+	// 443 for "https". It's useful if the ingress controller is exposed by
+	// NodePort.
+	Port int
+	// Path is the request path, default to "/".
+	Path string
+	// ExpectedStatus returns true if the response status code means the
+	// ingress is serving, default to any non-5xx status code.
+	ExpectedStatus func(code int) bool
+	// InsecureSkipVerify skips the verification of the server certificate,
+	// it's useful when the certificate is not issued yet.
+	InsecureSkipVerify bool
+}
+
+// WaitAddress waits until the ingress controller assigns an address to the
+// ingress, and returns the addresses(ip or hostname).
+// A zero timeout means waiting until the handler context is done.
+func (h *Handler) WaitAddress(name string, timeout time.Duration) ([]string, error) {
+	var addresses []string
+	err := wait.PollImmediateWithContext(h.ctx, pollInterval, timeout, func(ctx context.Context) (bool, error) {
+		ing, err := h.clientset.NetworkingV1().Ingresses(h.namespace).Get(ctx, name, h.Options.GetOptions)
+		if err != nil {
+			return false, err
+		}
+		addresses = lbAddresses(ing)
+		return len(addresses) != 0, nil
+	})
+	if err == wait.ErrWaitTimeout {
+		return nil, fmt.Errorf("ingress/%s has no address assigned: %w", name, err)
+	}
+	return addresses, err
+}
+
+// WaitServing waits until the ingress is actually routing the traffic.
+//
+// After an address is assigned to the ingress, it sends http request to the
+// address with the host of the first ingress rule as the "Host" header(and
+// the TLS server name), and waits for the expected response status code.
+// It catches the gap between "address assigned" and "actually serving".
+// A zero timeout means waiting until the handler context is done.
+func (h *Handler) WaitServing(name string, timeout time.Duration, probe ...ServingProbe) error {
+	ctx := h.ctx
+	if timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(h.ctx, timeout)
+		defer cancel()
+	}
+	handler := h.DeepCopy()
+	handler.ctx = ctx
+	addresses, err := handler.WaitAddress(name, 0)
+	if err != nil {
+		return err
+	}
+	ing, err := handler.Get(name)
+	if err != nil {
+		return err
+	}
+
+	p := ServingProbe{}
+	if len(probe) != 0 {
+		p = probe[0]
+	}
+	req, client, err := newServingRequest(ctx, ing, addresses[0], p)
+	if err != nil {
+		return err
+	}
+	// the transport is created for each call, release its connections.
+	defer client.CloseIdleConnections()
+	expected := p.ExpectedStatus
+	if expected == nil {
+		expected = func(code int) bool { return code < http.StatusInternalServerError }
+	}
+
+	var lastErr error
+	err = wait.PollImmediateUntilWithContext(ctx, pollInterval, func(ctx context.Context) (bool, error) {
+		resp, err := client.Do(req)
+		if err != nil {
+			lastErr = err
+			return false, nil
+		}
+		resp.Body.Close()
+		if !expected(resp.StatusCode) {
+			lastErr = fmt.Errorf("unexpected status code %d", resp.StatusCode)
+			return false, nil
+		}
+		return true, nil
+	})
+	if err != nil && lastErr != nil {
+		return fmt.Errorf("ingress/%s is not serving %s: %v: %w", name, req.URL, lastErr, err)
+	}
+	return err
+}
+
+// newServingRequest creates the probe request and the http client that
+// connects to the ingress address instead of resolving the ingress host.
+func newServingRequest(ctx context.Context, ing *networkingv1.Ingress, address string, p ServingProbe) (*http.Request, *http.Client, error) {
+	var host string
+	for _, rule := range ing.Spec.Rules {
+		if len(rule.Host) != 0 {
+			host = rule.Host
+			break
+		}
+	}
+	if len(host) == 0 {
+		host = address
+	}
+	scheme := p.Scheme
+	if len(scheme) == 0 {
+		scheme = "http"
+		for _, t := range ing.Spec.TLS {
+			for _, h := range t.Hosts {
+				if h == host {
+					scheme = "https"
+				}
+			}
+		}
+	}
+	path := p.Path
+	if len(path) == 0 {
+		path = "/"
+	}
+	urlHost := host
+	if p.Port > 0 {
+		urlHost = net.JoinHostPort(host, strconv.Itoa(p.Port))
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, fmt.Sprintf("%s://%s%s", scheme, urlHost, path), nil)
+	if err != nil {
+		return nil, nil, err
+	}
+	// the "Host" header never includes the port, the ingress rules match the host only.
+	req.Host = host
+
+	dialer := &net.Dialer{Timeout: 5 * time.Second}
+	client := &http.Client{
+		Timeout: 10 * time.Second,
+		Transport: &http.Transport{
+			// always connect to the ingress address, the host may be not resolvable yet.
+			DialContext: func(ctx context.Context, network, addr string) (net.Conn, error) {
+				_, port, err := net.SplitHostPort(addr)
+				if err != nil {
+					return nil, err
+				}
+				return dialer.DialContext(ctx, network, net.JoinHostPort(address, port))
+			},
+			TLSClientConfig: &tls.Config{ServerName: host, InsecureSkipVerify: p.InsecureSkipVerify},
+		},
+		// the redirect response means the ingress is serving.
+		CheckRedirect: func(*http.Request, []*http.Request) error { return http.ErrUseLastResponse },
+	}
+	return req, client, nil
+}
+
+// lbAddresses returns the ip or hostname of the ingress load balancer.
+func lbAddresses(ing *networkingv1.Ingress) []string {
+	var addresses []string
+	for _, lb := range ing.Status.LoadBalancer.Ingress {
+		if len(lb.IP) != 0 {
+			addresses = append(addresses, lb.IP)
+		} else if len(lb.Hostname) != 0 {
+			addresses = append(addresses, lb.Hostname)
+		}
+	}
+	return addresses
+}
@@ -0,0 +1,165 @@
+package ingress
+
+import (
+	"context"
+	"encoding/json"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/forbearing/k8s/types"
+	corev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/rest"
+)
+
+// newServingHandler creates an ingress handler, whose kubernetes API server
+// returns the ingress "web" routing the host "example.com" with the load
+// balancer address 127.0.0.1.
+func newServingHandler(t *testing.T, tlsHosts ...string) *Handler {
+	ing := &networkingv1.Ingress{
+		TypeMeta:   metav1.TypeMeta{Kind: "Ingress", APIVersion: "networking.k8s.io/v1"},
+		ObjectMeta: metav1.ObjectMeta{Name: "web", Namespace: "test"},
+		Spec:       networkingv1.IngressSpec{Rules: []networkingv1.IngressRule{{Host: "example.com"}}},
+		Status: networkingv1.IngressStatus{LoadBalancer: corev1.LoadBalancerStatus{
+			Ingress: []corev1.LoadBalancerIngress{{IP: "127.0.0.1"}},
+		}},
+	}
+	if len(tlsHosts) != 0 {
+		ing.Spec.TLS = []networkingv1.IngressTLS{{Hosts: tlsHosts}}
+	}
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet || r.URL.Path != "/apis/networking.k8s.io/v1/namespaces/test/ingresses/web" {
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(ing)
+	}))
+	t.Cleanup(srv.Close)
+	clientset, err := kubernetes.NewForConfig(&rest.Config{Host: srv.URL})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return &Handler{ctx: context.Background(), namespace: "test", clientset: clientset, Options: &types.HandlerOptions{}}
+}
+
+// serverPort returns the port of the httptest server.
+func serverPort(t *testing.T, srv *httptest.Server) int {
+	_, port, err := net.SplitHostPort(srv.Listener.Addr().String())
+	if err != nil {
+		t.Fatal(err)
+	}
+	p, err := strconv.Atoi(port)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return p
+}
+
+func TestWaitServing(t *testing.T) {
+	tests := []struct {
+		name     string
+		tls      bool
+		tlsHosts []string
+		status   int
+		expected func(code int) bool
+		wantErr  string
+	}{
+		{"http", false, nil, http.StatusOK, nil, ""},
+		{"https for the tls host", true, []string{"example.com"}, http.StatusOK, nil, ""},
+		{"http for the other tls host", false, []string{"other.example.com"}, http.StatusOK, nil, ""},
+		{"not found is serving by default", false, nil, http.StatusNotFound, nil, ""},
+		{"bad gateway is not serving", false, nil, http.StatusBadGateway, nil, "unexpected status code 502"},
+		{"expected status", false, nil, http.StatusNotFound,
+			func(code int) bool { return code == http.StatusOK }, "unexpected status code 404"},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			// the ingress controller, the "example.com" is not resolvable, so
+			// the request reaching it is sent to the load balancer address.
+			var served int32
+			serve := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				atomic.AddInt32(&served, 1)
+				if r.Host != "example.com" {
+					t.Errorf("Host = %q, want example.com", r.Host)
+				}
+				if test.tls && r.TLS.ServerName != "example.com" {
+					t.Errorf("TLS server name = %q, want example.com", r.TLS.ServerName)
+				}
+				if r.URL.Path != "/healthz" {
+					t.Errorf("path = %q, want /healthz", r.URL.Path)
+				}
+				w.WriteHeader(test.status)
+			})
+			var srv *httptest.Server
+			if test.tls {
+				srv = httptest.NewTLSServer(serve)
+			} else {
+				srv = httptest.NewServer(serve)
+			}
+			defer srv.Close()
+
+			h := newServingHandler(t, test.tlsHosts...)
+			probe := ServingProbe{
+				Port:               serverPort(t, srv),
+				Path:               "/healthz",
+				ExpectedStatus:     test.expected,
+				InsecureSkipVerify: true,
+			}
+			err := h.WaitServing("web", 500*time.Millisecond, probe)
+			if test.wantErr == "" && err != nil {
+				t.Fatal(err)
+			}
+			if test.wantErr != "" && (err == nil || !strings.Contains(err.Error(), test.wantErr)) {
+				t.Fatalf("WaitServing() = %v, want error %q", err, test.wantErr)
+			}
+			if atomic.LoadInt32(&served) == 0 {
+				t.Error("the probe request isn't served by the ingress controller")
+			}
+		})
+	}
+}
+
+func TestNewServingRequest(t *testing.T) {
+	ing := &networkingv1.Ingress{Spec: networkingv1.IngressSpec{
+		Rules: []networkingv1.IngressRule{{}, {Host: "example.com"}},
+		TLS:   []networkingv1.IngressTLS{{Hosts: []string{"example.com"}}},
+	}}
+	tests := []struct {
+		name          string
+		ing           *networkingv1.Ingress
+		probe         ServingProbe
+		wantURL       string
+		wantHost      string
+		wantTLSServer string
+	}{
+		{"tls host", ing, ServingProbe{}, "https://example.com/", "example.com", "example.com"},
+		{"scheme and port", ing, ServingProbe{Scheme: "http", Port: 8080, Path: "/ping"}, "http://example.com:8080/ping", "example.com", "example.com"},
+		{"no host", &networkingv1.Ingress{}, ServingProbe{}, "http://10.0.0.1/", "10.0.0.1", "10.0.0.1"},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			req, client, err := newServingRequest(context.Background(), test.ing, "10.0.0.1", test.probe)
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer client.CloseIdleConnections()
+			if req.URL.String() != test.wantURL {
+				t.Errorf("URL = %s, want %s", req.URL, test.wantURL)
+			}
+			if req.Host != test.wantHost {
+				t.Errorf("Host = %q, want %q", req.Host, test.wantHost)
+			}
+			if serverName := client.Transport.(*http.Transport).TLSClientConfig.ServerName; serverName != test.wantTLSServer {
+				t.Errorf("TLS server name = %q, want %q", serverName, test.wantTLSServer)
+			}
+		})
+	}
+}
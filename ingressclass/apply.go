@@ -1,8 +1,6 @@
 package ingressclass
 
 import (
-	"fmt"
-
 	networkingv1 "k8s.io/api/networking/v1"
 	k8serrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -58,7 +56,7 @@ func (h *Handler) ApplyFromBytes(data []byte) (ingc *networkingv1.IngressClass,
 func (h *Handler) ApplyFromObject(obj interface{}) (*networkingv1.IngressClass, error) {
 	ingc, ok := obj.(*networkingv1.IngressClass)
 	if !ok {
-		return nil, fmt.Errorf("object type is not *networkingv1.IngressClass")
+		return nil, ErrInvalidObjectType
 	}
 	return h.applyIngressclass(ingc)
 }
@@ -2,7 +2,6 @@ package ingressclass
 
 import (
 	"encoding/json"
-	"fmt"
 	"io/ioutil"
 
 	networkingv1 "k8s.io/api/networking/v1"
@@ -73,7 +72,7 @@ func (h *Handler) DeleteFromBytes(data []byte) error {
 func (h *Handler) DeleteFromObject(obj interface{}) error {
 	ingc, ok := obj.(*networkingv1.IngressClass)
 	if !ok {
-		return fmt.Errorf("object type is not *networkingv1.IngressClass")
+		return ErrInvalidObjectType
 	}
 	return h.deleteIngressclass(ingc)
 }
@@ -0,0 +1,13 @@
+package ingressclass
+
+import (
+	"github.com/forbearing/k8s/types"
+	networkingv1 "k8s.io/api/networking/v1"
+)
+
+// Generic returns the handler as a types.Handler, whose methods return
+// runtime.Object instead of *networkingv1.IngressClass, so it can be used together with
+// the handlers of other resources.
+func (h *Handler) Generic() types.Handler {
+	return types.NewGeneric[*networkingv1.IngressClass](h, GVK)
+}
@@ -2,7 +2,6 @@ package ingressclass
 
 import (
 	"encoding/json"
-	"fmt"
 	"io/ioutil"
 
 	networkingv1 "k8s.io/api/networking/v1"
@@ -65,7 +64,7 @@ func (h *Handler) UpdateFromBytes(data []byte) (*networkingv1.IngressClass, erro
 func (h *Handler) UpdateFromObject(obj interface{}) (*networkingv1.IngressClass, error) {
 	ingc, ok := obj.(*networkingv1.IngressClass)
 	if !ok {
-		return nil, fmt.Errorf("object type is not *networkingv1.IngressClass")
+		return nil, ErrInvalidObjectType
 	}
 	return h.updateIngressclass(ingc)
 }
@@ -1,8 +1,6 @@
 package job
 
 import (
-	"fmt"
-
 	batchv1 "k8s.io/api/batch/v1"
 	k8serrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -58,7 +56,7 @@ func (h *Handler) ApplyFromBytes(data []byte) (job *batchv1.Job, err error) {
 func (h *Handler) ApplyFromObject(obj interface{}) (*batchv1.Job, error) {
 	job, ok := obj.(*batchv1.Job)
 	if !ok {
-		return nil, fmt.Errorf("object type is not *batchv1.Job")
+		return nil, ErrInvalidObjectType
 	}
 	return h.applyJob(job)
 }
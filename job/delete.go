@@ -2,7 +2,6 @@ package job
 
 import (
 	"encoding/json"
-	"fmt"
 	"io/ioutil"
 
 	batchv1 "k8s.io/api/batch/v1"
@@ -73,7 +72,7 @@ func (h *Handler) DeleteFromBytes(data []byte) error {
 func (h *Handler) DeleteFromObject(obj interface{}) error {
 	job, ok := obj.(*batchv1.Job)
 	if !ok {
-		return fmt.Errorf("object type is not *batchv1.Job")
+		return ErrInvalidObjectType
 	}
 	return h.deleteJob(job)
 }
@@ -2,7 +2,6 @@ package job
 
 import (
 	"encoding/json"
-	"fmt"
 	"io/ioutil"
 
 	batchv1 "k8s.io/api/batch/v1"
@@ -73,7 +72,7 @@ func (h *Handler) GetFromBytes(data []byte) (*batchv1.Job, error) {
 func (h *Handler) GetFromObject(obj interface{}) (*batchv1.Job, error) {
 	job, ok := obj.(*batchv1.Job)
 	if !ok {
-		return nil, fmt.Errorf("object type is not *batchv1.Job")
+		return nil, ErrInvalidObjectType
 	}
 	return h.getJob(job)
 }
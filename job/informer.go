@@ -3,7 +3,6 @@ package job
 import (
 	"time"
 
-	"github.com/sirupsen/logrus"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/client-go/informers"
 	informersbatch "k8s.io/client-go/informers/batch/v1"
@@ -56,6 +55,36 @@ func (h *Handler) SetInformerFactoryTweakListOptions(tweakListOptions internalin
 		informers.WithTweakListOptions(h.tweakListOptions))
 }
 
+// SetInformerLabelSelector makes the informer list-and-watch only the jobs
+// selected by the label selector, such as "app=nginx,env!=dev". The tweak
+// list options function set before is kept and applied first.
+func (h *Handler) SetInformerLabelSelector(labelSelector string) {
+	h.l.RLock()
+	tweakListOptions := h.tweakListOptions
+	h.l.RUnlock()
+	h.SetInformerFactoryTweakListOptions(func(options *metav1.ListOptions) {
+		if tweakListOptions != nil {
+			tweakListOptions(options)
+		}
+		options.LabelSelector = labelSelector
+	})
+}
+
+// SetInformerFieldSelector makes the informer list-and-watch only the jobs
+// selected by the field selector, such as "status.successful=1". The tweak
+// list options function set before is kept and applied first.
+func (h *Handler) SetInformerFieldSelector(fieldSelector string) {
+	h.l.RLock()
+	tweakListOptions := h.tweakListOptions
+	h.l.RUnlock()
+	h.SetInformerFactoryTweakListOptions(func(options *metav1.ListOptions) {
+		if tweakListOptions != nil {
+			tweakListOptions(options)
+		}
+		options.FieldSelector = fieldSelector
+	})
+}
+
 // InformerFactory returns underlying SharedInformerFactory which provides
 // shared informer for resources in all known API group version.
 func (h *Handler) InformerFactory() informers.SharedInformerFactory {
@@ -79,6 +108,19 @@ func (h *Handler) Lister() listersbatch.JobLister {
 	return h.informerFactory.Batch().V1().Jobs().Lister()
 }
 
+// AddIndexers adds more indexers to the underlying job informer, so you can
+// look up jobs from the informer cache by the index, for example, by a label value.
+// Indexers must be added before the informer starts, otherwise an error is returned.
+func (h *Handler) AddIndexers(indexers cache.Indexers) error {
+	return h.Informer().AddIndexers(indexers)
+}
+
+// GetIndexer returns the underlying Indexer of the job informer, which
+// helps query jobs by the indexers added with AddIndexers.
+func (h *Handler) GetIndexer() cache.Indexer {
+	return h.Informer().GetIndexer()
+}
+
 // RunInformer start and run the shared informer, returning after it stops.
 // The informer will be stopped when stopCh is closed.
 //
@@ -98,18 +140,18 @@ func (h *Handler) RunInformer(
 
 	// method 1, recommended
 	h.InformerFactory().Start(stopCh)
-	logrus.Info("Waiting for informer caches to sync")
+	h.log().Info("Waiting for informer caches to sync")
 	if ok := cache.WaitForCacheSync(stopCh, h.Informer().HasSynced); !ok {
-		logrus.Error("failed to wait for caches to sync")
+		h.log().Error("failed to wait for caches to sync")
 	}
 
 	//// method 2
 	//h.InformerFactory().Start(stopCh)
-	//logrus.Info("Waiting for informer caches to sync")
+	//h.log().Info("Waiting for informer caches to sync")
 	//h.InformerFactory().WaitForCacheSync(stopCh)
 
 	//// method 3
-	//logrus.Info("Waiting for informer caches to sync")
+	//h.log().Info("Waiting for informer caches to sync")
 	//h.informerFactory.WaitForCacheSync(stopCh)
 	//h.Informer().Run(stopCh)
 }
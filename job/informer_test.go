@@ -0,0 +1,34 @@
+package job
+
+import (
+	"context"
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/rest"
+)
+
+func TestSetInformerSelector(t *testing.T) {
+	clientset, err := kubernetes.NewForConfig(&rest.Config{Host: "http://127.0.0.1:0"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	h := &Handler{ctx: context.Background(), clientset: clientset}
+
+	h.SetInformerFactoryTweakListOptions(func(options *metav1.ListOptions) { options.Limit = 100 })
+	h.SetInformerLabelSelector("app=nginx")
+	h.SetInformerFieldSelector("metadata.name=nginx")
+	// the latest label selector replaces the former one.
+	h.SetInformerLabelSelector("app=redis")
+
+	options := metav1.ListOptions{}
+	h.tweakListOptions(&options)
+	want := metav1.ListOptions{LabelSelector: "app=redis", FieldSelector: "metadata.name=nginx", Limit: 100}
+	if options != want {
+		t.Errorf("tweaked list options = %+v, want %+v", options, want)
+	}
+	if h.informerFactory == nil {
+		t.Error("informer factory isn't rebuilt")
+	}
+}
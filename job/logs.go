@@ -0,0 +1,94 @@
+package job
+
+import (
+	"bytes"
+	"fmt"
+	"sort"
+
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	// jobNameLabel is the label set on the pods by the job controller, its
+	// value is the job name.
+	jobNameLabel = "job-name"
+	// controllerUIDLabel is the label set on the pods by the job controller,
+	// its value is the job uid, it tells the pods of the job from the pods of
+	// a deleted job with the same name.
+	controllerUIDLabel = "controller-uid"
+)
+
+// GetLogs gets the logs of the most recent pod of the job, it's the pod of
+// the last retry if the job has been retried. The pods are the pods labeled
+// with "job-name=<jobName>" and "controller-uid=<job uid>".
+// If opts is nil, the default log options are used.
+// ErrNoPods is returned if the job has no pods.
+func (h *Handler) GetLogs(jobName string, opts *corev1.PodLogOptions) ([]byte, error) {
+	job, err := h.GetByName(jobName)
+	if err != nil {
+		return nil, err
+	}
+	pods, err := h.listPods(job)
+	if err != nil {
+		return nil, err
+	}
+	return h.podLogs(&pods[len(pods)-1], opts)
+}
+
+// GetAllLogs gets the logs of all the pods of the job, from the oldest pod
+// to the most recent pod, the logs of every pod are preceded by a header
+// line "==> pod/<name> <==", like `tail` does for multiple files.
+// If opts is nil, the default log options are used.
+// ErrNoPods is returned if the job has no pods.
+func (h *Handler) GetAllLogs(jobName string, opts *corev1.PodLogOptions) ([]byte, error) {
+	job, err := h.GetByName(jobName)
+	if err != nil {
+		return nil, err
+	}
+	pods, err := h.listPods(job)
+	if err != nil {
+		return nil, err
+	}
+	var buf bytes.Buffer
+	for i := range pods {
+		logs, err := h.podLogs(&pods[i], opts)
+		if err != nil {
+			return nil, err
+		}
+		fmt.Fprintf(&buf, "==> pod/%s <==\n", pods[i].Name)
+		buf.Write(logs)
+		if len(logs) != 0 && logs[len(logs)-1] != '\n' {
+			buf.WriteByte('\n')
+		}
+	}
+	return buf.Bytes(), nil
+}
+
+// listPods lists the pods of the job sorted by the creation timestamp in
+// ascending order, the pods are selected by both the job name and the job uid.
+func (h *Handler) listPods(job *batchv1.Job) ([]corev1.Pod, error) {
+	labelSelector := fmt.Sprintf("%s=%s,%s=%s", jobNameLabel, job.Name, controllerUIDLabel, job.UID)
+	podList, err := h.clientset.CoreV1().Pods(job.Namespace).
+		List(h.ctx, metav1.ListOptions{LabelSelector: labelSelector})
+	if err != nil {
+		return nil, err
+	}
+	if len(podList.Items) == 0 {
+		return nil, fmt.Errorf("job/%s: %w", job.Name, ErrNoPods)
+	}
+	pods := podList.Items
+	sort.SliceStable(pods, func(i, j int) bool {
+		return pods[i].CreationTimestamp.Before(&pods[j].CreationTimestamp)
+	})
+	return pods, nil
+}
+
+// podLogs gets the logs of the pod.
+func (h *Handler) podLogs(pod *corev1.Pod, opts *corev1.PodLogOptions) ([]byte, error) {
+	if opts == nil {
+		opts = &corev1.PodLogOptions{}
+	}
+	return h.clientset.CoreV1().Pods(pod.Namespace).GetLogs(pod.Name, opts).DoRaw(h.ctx)
+}
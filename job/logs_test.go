@@ -0,0 +1,119 @@
+package job
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/forbearing/k8s/types"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/rest"
+)
+
+func TestGetLogs(t *testing.T) {
+	// pi-retry is the pod of the retry, created after the failed pod pi-first.
+	// pi-stale is the pod of a deleted job with the same name.
+	pods := []string{
+		`{"metadata":{"name":"pi-retry","namespace":"test","labels":{"job-name":"pi","controller-uid":"uid-pi"},"creationTimestamp":"2022-08-01T00:01:00Z"}}`,
+		`{"metadata":{"name":"pi-first","namespace":"test","labels":{"job-name":"pi","controller-uid":"uid-pi"},"creationTimestamp":"2022-08-01T00:00:00Z"}}`,
+		`{"metadata":{"name":"pi-stale","namespace":"test","labels":{"job-name":"pi","controller-uid":"uid-old"},"creationTimestamp":"2022-08-01T00:02:00Z"}}`,
+	}
+	jobs := map[string]string{"pi": "uid-pi", "empty": "uid-empty"}
+	logs := map[string]string{
+		"pi-first": "computing\nOOMKilled",
+		"pi-retry": "computing\n3.14159\n",
+		"pi-stale": "stale\n",
+	}
+	var tailLines string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case strings.HasPrefix(r.URL.Path, "/apis/batch/v1/namespaces/test/jobs/"):
+			name := strings.TrimPrefix(r.URL.Path, "/apis/batch/v1/namespaces/test/jobs/")
+			uid, ok := jobs[name]
+			if !ok {
+				w.WriteHeader(http.StatusNotFound)
+				fmt.Fprintln(w, `{"kind":"Status","apiVersion":"v1","status":"Failure","reason":"NotFound","code":404}`)
+				return
+			}
+			fmt.Fprintf(w, `{"kind":"Job","apiVersion":"batch/v1","metadata":{"name":%q,"namespace":"test","uid":%q}}`+"\n", name, uid)
+		case r.URL.Path == "/api/v1/namespaces/test/pods":
+			selector, err := labels.Parse(r.URL.Query().Get("labelSelector"))
+			if err != nil {
+				t.Error(err)
+				return
+			}
+			var items []string
+			for _, pod := range pods {
+				var p corev1.Pod
+				json.Unmarshal([]byte(pod), &p)
+				if selector.Matches(labels.Set(p.Labels)) {
+					items = append(items, pod)
+				}
+			}
+			fmt.Fprintf(w, `{"kind":"PodList","apiVersion":"v1","metadata":{},"items":[%s]}`+"\n", strings.Join(items, ","))
+		case strings.HasPrefix(r.URL.Path, "/api/v1/namespaces/test/pods/") && strings.HasSuffix(r.URL.Path, "/log"):
+			tailLines = r.URL.Query().Get("tailLines")
+			name := strings.TrimSuffix(strings.TrimPrefix(r.URL.Path, "/api/v1/namespaces/test/pods/"), "/log")
+			fmt.Fprint(w, logs[name])
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+	}))
+	defer srv.Close()
+	clientset, err := kubernetes.NewForConfig(&rest.Config{Host: srv.URL})
+	if err != nil {
+		t.Fatal(err)
+	}
+	h := &Handler{ctx: context.Background(), namespace: "test", clientset: clientset, Options: &types.HandlerOptions{}}
+
+	data, err := h.GetLogs("pi", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(data) != logs["pi-retry"] {
+		t.Errorf("GetLogs() = %q, want the logs of the most recent pod %q", data, logs["pi-retry"])
+	}
+	lines := int64(10)
+	if _, err := h.GetLogs("pi", &corev1.PodLogOptions{TailLines: &lines}); err != nil {
+		t.Fatal(err)
+	}
+	if tailLines != "10" {
+		t.Errorf("tailLines = %q, want 10", tailLines)
+	}
+
+	data, err = h.GetAllLogs("pi", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := "==> pod/pi-first <==\ncomputing\nOOMKilled\n==> pod/pi-retry <==\ncomputing\n3.14159\n"
+	if string(data) != want {
+		t.Errorf("GetAllLogs() = %q, want %q", data, want)
+	}
+
+	var buf bytes.Buffer
+	if err := h.writeLogs(&batchv1.Job{ObjectMeta: metav1.ObjectMeta{Name: "pi", Namespace: "test", UID: "uid-pi"}}, &buf); err != nil {
+		t.Fatal(err)
+	}
+	if want := "computing\nOOMKilled" + "computing\n3.14159\n"; buf.String() != want {
+		t.Errorf("writeLogs() = %q, want %q", buf.String(), want)
+	}
+
+	if _, err := h.GetLogs("empty", nil); !errors.Is(err, ErrNoPods) {
+		t.Errorf("GetLogs(empty) = %v, want ErrNoPods", err)
+	}
+	if _, err := h.GetLogs("missing", nil); !k8serrors.IsNotFound(err) {
+		t.Errorf("GetLogs(missing) = %v, want NotFound", err)
+	}
+}
@@ -0,0 +1,99 @@
+package job
+
+import (
+	"errors"
+	"io"
+	"time"
+
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// pollInterval is the interval between two checks of the job status.
+const pollInterval = time.Second
+
+// RunOptions is the options for RunCommand.
+type RunOptions struct {
+	// BackoffLimit is the number of retries before marking the job failed.
+	// Default to 0, the job pod will not be retried.
+	BackoffLimit int32
+	// Wait waits for the job to be completed or failed before RunCommand returns.
+	Wait bool
+	// Timeout is the max duration to wait for the job to be finished.
+	// Zero means waiting until the handler context is done.
+	Timeout time.Duration
+	// LogWriter receives the logs of the job pods after the job is finished,
+	// it only takes effect if Wait is true.
+	LogWriter io.Writer
+}
+
+// RunCommand creates a job that runs the command once in a container with
+// the image, it works like `kubectl create job name --image=image -- command`.
+//
+// The job has only one container and the pod restartPolicy is "Never".
+// If opts.Wait is true, RunCommand waits for the job to be finished and returns
+// an error wrapping ErrJobFailed if the job failed. If opts.LogWriter is not nil, the logs of the
+// job pods are written to it after the job is finished.
+func (h *Handler) RunCommand(name, image string, command []string, opts RunOptions) (*batchv1.Job, error) {
+	backoffLimit := opts.BackoffLimit
+	job := &batchv1.Job{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: h.namespace},
+		Spec: batchv1.JobSpec{
+			BackoffLimit: &backoffLimit,
+			Template: corev1.PodTemplateSpec{
+				Spec: corev1.PodSpec{
+					RestartPolicy: corev1.RestartPolicyNever,
+					Containers: []corev1.Container{{
+						Name:    name,
+						Image:   image,
+						Command: command,
+					}},
+				},
+			},
+		},
+	}
+	job, err := h.createJob(job)
+	if err != nil {
+		return nil, err
+	}
+	// the job is not persisted in dry run mode, there is nothing to wait.
+	if !opts.Wait || len(h.Options.CreateOptions.DryRun) != 0 {
+		return job, nil
+	}
+
+	if job, err = h.waitFinished(name, opts.Timeout); err != nil {
+		return job, err
+	}
+	if opts.LogWriter != nil {
+		if err := h.writeLogs(job, opts.LogWriter); err != nil {
+			return job, err
+		}
+	}
+	if _, _, err := jobCompletion(job); err != nil {
+		return job, err
+	}
+	return job, nil
+}
+
+// writeLogs writes the logs of all the job pods to w, from the oldest pod to
+// the most recent pod.
+func (h *Handler) writeLogs(job *batchv1.Job, w io.Writer) error {
+	pods, err := h.listPods(job)
+	if errors.Is(err, ErrNoPods) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	for i := range pods {
+		logs, err := h.podLogs(&pods[i], nil)
+		if err != nil {
+			return err
+		}
+		if _, err := w.Write(logs); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,131 @@
+package job
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/forbearing/k8s/types"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/rest"
+)
+
+func TestRunCommand(t *testing.T) {
+	tests := []struct {
+		name string
+		opts RunOptions
+		// dryRun runs the job in dry run mode.
+		dryRun bool
+		// condition is the condition of the finished job.
+		condition    batchv1.JobConditionType
+		wantRequests []string
+		wantLogs     string
+		wantErr      error
+	}{
+		{"no wait", RunOptions{}, false, batchv1.JobComplete,
+			[]string{"POST /apis/batch/v1/namespaces/test/jobs"}, "", nil},
+		{"dry run", RunOptions{Wait: true}, true, batchv1.JobComplete,
+			[]string{"POST /apis/batch/v1/namespaces/test/jobs"}, "", nil},
+		{"succeeded", RunOptions{Wait: true, Timeout: 10 * time.Second}, false, batchv1.JobComplete,
+			[]string{
+				"POST /apis/batch/v1/namespaces/test/jobs",
+				"GET /apis/batch/v1/namespaces/test/jobs/pi",
+				"GET /api/v1/namespaces/test/pods",
+				"GET /api/v1/namespaces/test/pods/pi-1/log",
+			}, "3.14159\n", nil},
+		{"failed", RunOptions{Wait: true, Timeout: 10 * time.Second}, false, batchv1.JobFailed,
+			[]string{
+				"POST /apis/batch/v1/namespaces/test/jobs",
+				"GET /apis/batch/v1/namespaces/test/jobs/pi",
+				"GET /api/v1/namespaces/test/pods",
+				"GET /api/v1/namespaces/test/pods/pi-1/log",
+			}, "3.14159\n", ErrJobFailed},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var (
+				mu       sync.Mutex
+				requests []string
+			)
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				mu.Lock()
+				defer mu.Unlock()
+				requests = append(requests, r.Method+" "+r.URL.Path)
+				w.Header().Set("Content-Type", "application/json")
+				switch {
+				case r.Method == http.MethodPost && r.URL.Path == "/apis/batch/v1/namespaces/test/jobs":
+					if dryRun := r.URL.Query().Get("dryRun"); (dryRun == metav1.DryRunAll) != test.dryRun {
+						t.Errorf("dryRun = %q, want dry run %v", dryRun, test.dryRun)
+					}
+					job := &batchv1.Job{}
+					if err := json.NewDecoder(r.Body).Decode(job); err != nil {
+						t.Error(err)
+					}
+					spec := job.Spec.Template.Spec
+					if spec.RestartPolicy != corev1.RestartPolicyNever || len(spec.Containers) != 1 ||
+						spec.Containers[0].Image != "perl" || !reflect.DeepEqual(spec.Containers[0].Command, []string{"perl", "-e", "print 3.14159"}) {
+						t.Errorf("unexpected job spec %+v", spec)
+					}
+					if job.Spec.BackoffLimit == nil || *job.Spec.BackoffLimit != 0 {
+						t.Errorf("backoffLimit = %v, want 0", job.Spec.BackoffLimit)
+					}
+					job.TypeMeta = metav1.TypeMeta{Kind: "Job", APIVersion: "batch/v1"}
+					job.UID, job.ResourceVersion = "uid-pi", "1"
+					w.WriteHeader(http.StatusCreated)
+					json.NewEncoder(w).Encode(job)
+				case r.Method == http.MethodGet && r.URL.Path == "/apis/batch/v1/namespaces/test/jobs/pi":
+					fmt.Fprintf(w, `{"kind":"Job","apiVersion":"batch/v1","metadata":{"name":"pi","namespace":"test","uid":"uid-pi","resourceVersion":"2"},`+
+						`"status":{"conditions":[{"type":%q,"status":"True","reason":"BackoffLimitExceeded"}]}}`+"\n", test.condition)
+				case r.Method == http.MethodGet && r.URL.Path == "/api/v1/namespaces/test/pods":
+					if selector := r.URL.Query().Get("labelSelector"); selector != "job-name=pi,controller-uid=uid-pi" {
+						t.Errorf("labelSelector = %q, want the pods of job pi", selector)
+					}
+					fmt.Fprintln(w, `{"kind":"PodList","apiVersion":"v1","metadata":{},"items":[{"metadata":{"name":"pi-1","namespace":"test"}}]}`)
+				case r.Method == http.MethodGet && r.URL.Path == "/api/v1/namespaces/test/pods/pi-1/log":
+					fmt.Fprint(w, "3.14159\n")
+				default:
+					t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+				}
+			}))
+			defer srv.Close()
+			clientset, err := kubernetes.NewForConfig(&rest.Config{Host: srv.URL})
+			if err != nil {
+				t.Fatal(err)
+			}
+			h := &Handler{ctx: context.Background(), namespace: "test", clientset: clientset, Options: &types.HandlerOptions{}}
+			if test.dryRun {
+				h = h.WithDryRun()
+			}
+
+			var logs bytes.Buffer
+			opts := test.opts
+			opts.LogWriter = &logs
+			job, err := h.RunCommand("pi", "perl", []string{"perl", "-e", "print 3.14159"}, opts)
+			if !errors.Is(err, test.wantErr) {
+				t.Fatalf("RunCommand() error = %v, want %v", err, test.wantErr)
+			}
+			if job == nil || job.Name != "pi" {
+				t.Errorf("RunCommand() = %v, want job pi", job)
+			}
+			if logs.String() != test.wantLogs {
+				t.Errorf("logs = %q, want %q", logs.String(), test.wantLogs)
+			}
+			mu.Lock()
+			defer mu.Unlock()
+			if !reflect.DeepEqual(requests, test.wantRequests) {
+				t.Errorf("requests = %q, want %q", requests, test.wantRequests)
+			}
+		})
+	}
+}
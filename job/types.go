@@ -2,6 +2,7 @@ package job
 
 import "errors"
 
+// ErrJobFailed and ErrNoPods are wrapped with the job name, use errors.Is to check them.
 var (
 	ErrInvalidToolsType  = errors.New("type must be string, *batchv1.Job, batchv1.Job, metav1.Object or runtime.Object")
 	ErrInvalidCreateType = errors.New("type must be string, []byte, *batchv1.Job, batchv1.Job, metav1.Object, runtime.Object, *unstructured.Unstructured, unstructured.Unstructured or map[string]interface{}")
@@ -10,4 +11,7 @@ var (
 	ErrInvalidDeleteType = ErrInvalidCreateType
 	ErrInvalidGetType    = ErrInvalidCreateType
 	ErrInvalidPatchType  = errors.New("patch data type must be string, []byte, *batchv1.Job, batchv1.Job, metav1.Object, runtime.Object, *unstructured.Unstructured, unstructured.Unstructured or map[string]interface{}")
+	ErrInvalidObjectType = errors.New("object type is not *batchv1.Job")
+	ErrJobFailed         = errors.New("job failed")
+	ErrNoPods            = errors.New("job has no pods")
 )
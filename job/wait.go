@@ -0,0 +1,151 @@
+package job
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/apimachinery/pkg/watch"
+)
+
+// WaitForCompletion watches the job until it's completed or failed.
+// The job is completed once status.succeeded reaches spec.completions (1 if
+// unset) or its Complete condition is true, then true and nil error are
+// returned. The job is failed once its Failed condition is true, then false
+// and an error wrapping ErrJobFailed with the failure reason are returned.
+//
+// A zero timeout means waiting until the handler context is done, the timeout
+// error wraps wait.ErrWaitTimeout and includes the last observed job status.
+// It returns a NotFound error if the job doesn't exist or is deleted while waiting.
+func (h *Handler) WaitForCompletion(name string, timeout time.Duration) (succeeded bool, err error) {
+	job, err := h.waitFinished(name, timeout)
+	if err != nil {
+		return false, err
+	}
+	_, succeeded, err = jobCompletion(job)
+	return succeeded, err
+}
+
+// waitFinished waits for the job to be completed or failed, see jobCompletion,
+// and returns the finished job, or the last observed job if the error is not nil.
+//
+// waitFinished watches the job to get notified of the changes, if the watch
+// fails, it falls back to polling every pollInterval.
+func (h *Handler) waitFinished(name string, timeout time.Duration) (*batchv1.Job, error) {
+	ctx := h.ctx
+	if timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(h.ctx, timeout)
+		defer cancel()
+	}
+	// ctxErr returns the timeout error if the timeout is reached, otherwise
+	// returns the error of handler context.
+	var last *batchv1.Job
+	ctxErr := func() error {
+		if h.ctx.Err() != nil {
+			return h.ctx.Err()
+		}
+		if last == nil {
+			return fmt.Errorf("job/%s is not completed: %w", name, wait.ErrWaitTimeout)
+		}
+		return fmt.Errorf("job/%s is not completed: %d of %d succeeded, %d failed: %w",
+			name, last.Status.Succeeded, completions(last), last.Status.Failed, wait.ErrWaitTimeout)
+	}
+
+	for {
+		job, err := h.clientset.BatchV1().Jobs(h.namespace).Get(ctx, name, h.Options.GetOptions)
+		if ctx.Err() != nil {
+			return last, ctxErr()
+		}
+		if err != nil {
+			return last, err
+		}
+		last = job
+		if done, _, _ := jobCompletion(job); done {
+			return job, nil
+		}
+
+		// watch the changes since the job we just got.
+		listOptions := metav1.ListOptions{
+			FieldSelector:   fields.OneTermEqualSelector("metadata.name", name).String(),
+			ResourceVersion: job.ResourceVersion,
+		}
+		watcher, err := h.clientset.BatchV1().Jobs(h.namespace).Watch(ctx, listOptions)
+		if err != nil {
+			// fall back to polling.
+			h.log().Debug(fmt.Sprintf("watch job/%s: %v, poll it", name, err))
+			select {
+			case <-ctx.Done():
+				return last, ctxErr()
+			case <-time.After(pollInterval):
+			}
+			continue
+		}
+	events:
+		for {
+			select {
+			case <-ctx.Done():
+				watcher.Stop()
+				return last, ctxErr()
+			case event, ok := <-watcher.ResultChan():
+				if !ok {
+					break events
+				}
+				switch event.Type {
+				case watch.Added, watch.Modified:
+					job, ok := event.Object.(*batchv1.Job)
+					if !ok {
+						continue
+					}
+					last = job
+					if done, _, _ := jobCompletion(job); done {
+						watcher.Stop()
+						return job, nil
+					}
+				case watch.Deleted:
+					watcher.Stop()
+					return last, k8serrors.NewNotFound(GVR.GroupResource(), name)
+				}
+			}
+		}
+		// If event channel is closed, it means the server has closed the
+		// connection, get the job again and re-watch it.
+		h.log().Debug("watch job: reconnect to kubernetes")
+		watcher.Stop()
+	}
+}
+
+// jobCompletion checks whether the job is done, and whether it's succeeded.
+// The error wrapping ErrJobFailed with the failure reason is returned if the
+// job is failed.
+func jobCompletion(job *batchv1.Job) (done, succeeded bool, err error) {
+	for _, cond := range job.Status.Conditions {
+		if cond.Status != corev1.ConditionTrue {
+			continue
+		}
+		switch cond.Type {
+		case batchv1.JobFailed:
+			return true, false, fmt.Errorf("job/%s: %w: %s: %s", job.Name, ErrJobFailed, cond.Reason, cond.Message)
+		case batchv1.JobComplete:
+			return true, true, nil
+		}
+	}
+	if job.Status.Succeeded >= completions(job) {
+		return true, true, nil
+	}
+	return false, false, nil
+}
+
+// completions returns the job spec.completions, default to 1.
+func completions(job *batchv1.Job) int32 {
+	if job.Spec.Completions == nil {
+		return 1
+	}
+	return *job.Spec.Completions
+}
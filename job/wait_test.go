@@ -0,0 +1,86 @@
+package job
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/forbearing/k8s/types"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/apimachinery/pkg/watch"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/rest"
+	"k8s.io/utils/pointer"
+)
+
+func TestWaitForCompletion(t *testing.T) {
+	newJob := func(resourceVersion string, succeeded int32, conditions ...batchv1.JobCondition) *batchv1.Job {
+		return &batchv1.Job{
+			TypeMeta:   metav1.TypeMeta{Kind: "Job", APIVersion: "batch/v1"},
+			ObjectMeta: metav1.ObjectMeta{Name: "pi", Namespace: "test", ResourceVersion: resourceVersion},
+			Spec:       batchv1.JobSpec{Completions: pointer.Int32(2)},
+			Status:     batchv1.JobStatus{Succeeded: succeeded, Conditions: conditions},
+		}
+	}
+	failed := batchv1.JobCondition{Type: batchv1.JobFailed, Status: corev1.ConditionTrue,
+		Reason: "BackoffLimitExceeded", Message: "Job has reached the specified backoff limit"}
+
+	tests := []struct {
+		name          string
+		events        []*batchv1.Job
+		timeout       time.Duration
+		wantSucceeded bool
+		wantErr       error
+		wantReason    string
+	}{
+		{"complete", []*batchv1.Job{newJob("2", 1), newJob("3", 2)}, 0, true, nil, ""},
+		{"failed", []*batchv1.Job{newJob("2", 1), newJob("3", 1, failed)}, 0, false, ErrJobFailed, "BackoffLimitExceeded"},
+		{"timeout", []*batchv1.Job{newJob("2", 1)}, 100 * time.Millisecond, false, wait.ErrWaitTimeout, "1 of 2 succeeded"},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if r.URL.Path != "/apis/batch/v1/namespaces/test/jobs/pi" && r.URL.Path != "/apis/batch/v1/namespaces/test/jobs" {
+					t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+				}
+				w.Header().Set("Content-Type", "application/json")
+				if r.URL.Query().Get("watch") != "true" {
+					json.NewEncoder(w).Encode(newJob("1", 0))
+					return
+				}
+				if resourceVersion := r.URL.Query().Get("resourceVersion"); resourceVersion != "1" {
+					t.Errorf("watch from resourceVersion %q, want 1", resourceVersion)
+				}
+				for _, job := range test.events {
+					data, _ := json.Marshal(job)
+					json.NewEncoder(w).Encode(metav1.WatchEvent{Type: string(watch.Modified), Object: runtime.RawExtension{Raw: data}})
+				}
+				w.(http.Flusher).Flush()
+				<-r.Context().Done()
+			}))
+			defer srv.Close()
+			clientset, err := kubernetes.NewForConfig(&rest.Config{Host: srv.URL})
+			if err != nil {
+				t.Fatal(err)
+			}
+			h := &Handler{ctx: context.Background(), namespace: "test", clientset: clientset, Options: &types.HandlerOptions{}}
+
+			succeeded, err := h.WaitForCompletion("pi", test.timeout)
+			if succeeded != test.wantSucceeded || !errors.Is(err, test.wantErr) {
+				t.Fatalf("WaitForCompletion() = %v, %v, want %v, %v", succeeded, err, test.wantSucceeded, test.wantErr)
+			}
+			if err != nil && !strings.Contains(err.Error(), test.wantReason) {
+				t.Errorf("WaitForCompletion() error %q doesn't contain %q", err, test.wantReason)
+			}
+		})
+	}
+}
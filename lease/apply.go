@@ -0,0 +1,91 @@
+package lease
+
+import (
+	coordinationv1 "k8s.io/api/coordination/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+// Apply applies lease from type string, []byte, *coordinationv1.Lease,
+// coordinationv1.Lease, metav1.Object, runtime.Object, *unstructured.Unstructured,
+// unstructured.Unstructured or map[string]interface{}.
+func (h *Handler) Apply(obj interface{}) (*coordinationv1.Lease, error) {
+	switch val := obj.(type) {
+	case string:
+		return h.ApplyFromFile(val)
+	case []byte:
+		return h.ApplyFromBytes(val)
+	case *coordinationv1.Lease:
+		return h.ApplyFromObject(val)
+	case coordinationv1.Lease:
+		return h.ApplyFromObject(&val)
+	case *unstructured.Unstructured:
+		return h.ApplyFromUnstructured(val)
+	case unstructured.Unstructured:
+		return h.ApplyFromUnstructured(&val)
+	case map[string]interface{}:
+		return h.ApplyFromMap(val)
+	case metav1.Object, runtime.Object:
+		return h.ApplyFromObject(val)
+	default:
+		return nil, ErrInvalidApplyType
+	}
+}
+
+// ApplyFromFile applies lease from yaml or json file.
+func (h *Handler) ApplyFromFile(filename string) (lease *coordinationv1.Lease, err error) {
+	lease, err = h.CreateFromFile(filename)
+	if k8serrors.IsAlreadyExists(err) { // if lease already exist, update it.
+		lease, err = h.UpdateFromFile(filename)
+	}
+	return
+}
+
+// ApplyFromBytes pply lease from bytes data.
+func (h *Handler) ApplyFromBytes(data []byte) (lease *coordinationv1.Lease, err error) {
+	lease, err = h.CreateFromBytes(data)
+	if k8serrors.IsAlreadyExists(err) {
+		lease, err = h.UpdateFromBytes(data)
+	}
+	return
+}
+
+// ApplyFromObject applies lease from metav1.Object or runtime.Object.
+func (h *Handler) ApplyFromObject(obj interface{}) (*coordinationv1.Lease, error) {
+	lease, ok := obj.(*coordinationv1.Lease)
+	if !ok {
+		return nil, ErrInvalidObjectType
+	}
+	return h.applyLease(lease)
+}
+
+// ApplyFromUnstructured applies lease from *unstructured.Unstructured.
+func (h *Handler) ApplyFromUnstructured(u *unstructured.Unstructured) (*coordinationv1.Lease, error) {
+	lease := &coordinationv1.Lease{}
+	err := runtime.DefaultUnstructuredConverter.FromUnstructured(u.UnstructuredContent(), lease)
+	if err != nil {
+		return nil, err
+	}
+	return h.applyLease(lease)
+}
+
+// ApplyFromMap applies lease from map[string]interface{}.
+func (h *Handler) ApplyFromMap(u map[string]interface{}) (*coordinationv1.Lease, error) {
+	lease := &coordinationv1.Lease{}
+	err := runtime.DefaultUnstructuredConverter.FromUnstructured(u, lease)
+	if err != nil {
+		return nil, err
+	}
+	return h.applyLease(lease)
+}
+
+// applyLease
+func (h *Handler) applyLease(lease *coordinationv1.Lease) (*coordinationv1.Lease, error) {
+	_, err := h.createLease(lease)
+	if k8serrors.IsAlreadyExists(err) {
+		return h.updateLease(lease)
+	}
+	return lease, err
+}
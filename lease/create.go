@@ -0,0 +1,101 @@
+package lease
+
+import (
+	"encoding/json"
+	"io/ioutil"
+
+	coordinationv1 "k8s.io/api/coordination/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/yaml"
+)
+
+// Create creates lease from type string, []byte, *coordinationv1.Lease,
+// coordinationv1.Lease, metav1.Object, runtime.Object, *unstructured.Unstructured,
+// unstructured.Unstructured or map[string]interface{}.
+func (h *Handler) Create(obj interface{}) (*coordinationv1.Lease, error) {
+	switch val := obj.(type) {
+	case string:
+		return h.CreateFromFile(val)
+	case []byte:
+		return h.CreateFromBytes(val)
+	case *coordinationv1.Lease:
+		return h.CreateFromObject(val)
+	case coordinationv1.Lease:
+		return h.CreateFromObject(&val)
+	case *unstructured.Unstructured:
+		return h.CreateFromUnstructured(val)
+	case unstructured.Unstructured:
+		return h.CreateFromUnstructured(&val)
+	case map[string]interface{}:
+		return h.CreateFromMap(val)
+	case metav1.Object, runtime.Object:
+		return h.CreateFromObject(val)
+	default:
+		return nil, ErrInvalidCreateType
+	}
+}
+
+// CreateFromFile creates lease from yaml or json file.
+func (h *Handler) CreateFromFile(filename string) (*coordinationv1.Lease, error) {
+	data, err := ioutil.ReadFile(filename)
+	if err != nil {
+		return nil, err
+	}
+	return h.CreateFromBytes(data)
+}
+
+// CreateFromBytes creates lease from bytes data.
+func (h *Handler) CreateFromBytes(data []byte) (*coordinationv1.Lease, error) {
+	leaseJson, err := yaml.ToJSON(data)
+	if err != nil {
+		return nil, err
+	}
+
+	lease := &coordinationv1.Lease{}
+	if err = json.Unmarshal(leaseJson, lease); err != nil {
+		return nil, err
+	}
+	return h.createLease(lease)
+}
+
+// CreateFromObject creates lease from metav1.Object or runtime.Object.
+func (h *Handler) CreateFromObject(obj interface{}) (*coordinationv1.Lease, error) {
+	lease, ok := obj.(*coordinationv1.Lease)
+	if !ok {
+		return nil, ErrInvalidObjectType
+	}
+	return h.createLease(lease)
+}
+
+// CreateFromUnstructured creates lease from *unstructured.Unstructured.
+func (h *Handler) CreateFromUnstructured(u *unstructured.Unstructured) (*coordinationv1.Lease, error) {
+	lease := &coordinationv1.Lease{}
+	err := runtime.DefaultUnstructuredConverter.FromUnstructured(u.UnstructuredContent(), lease)
+	if err != nil {
+		return nil, err
+	}
+	return h.createLease(lease)
+}
+
+// CreateFromMap creates lease from map[string]interface{}.
+func (h *Handler) CreateFromMap(u map[string]interface{}) (*coordinationv1.Lease, error) {
+	lease := &coordinationv1.Lease{}
+	err := runtime.DefaultUnstructuredConverter.FromUnstructured(u, lease)
+	if err != nil {
+		return nil, err
+	}
+	return h.createLease(lease)
+}
+
+// createLease
+func (h *Handler) createLease(lease *coordinationv1.Lease) (*coordinationv1.Lease, error) {
+	namespace := lease.GetNamespace()
+	if len(namespace) == 0 {
+		namespace = h.namespace
+	}
+	lease.ResourceVersion = ""
+	lease.UID = ""
+	return h.clientset.CoordinationV1().Leases(namespace).Create(h.ctx, lease, h.Options.CreateOptions)
+}
@@ -0,0 +1,107 @@
+package lease
+
+import (
+	"encoding/json"
+	"io/ioutil"
+
+	coordinationv1 "k8s.io/api/coordination/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/yaml"
+)
+
+// Delete deletes lease from type string, []byte, *coordinationv1.Lease,
+// coordinationv1.Lease, metav1.Object, runtime.Object, *unstructured.Unstructured,
+// unstructured.Unstructured or map[string]interface{}.
+//
+// If passed parameter type is string, it will simply call DeleteByName instead of DeleteFromFile.
+// You should always explicitly call DeleteFromFile to delete a lease from file path.
+func (h *Handler) Delete(obj interface{}) error {
+	switch val := obj.(type) {
+	case string:
+		return h.DeleteByName(val)
+	case []byte:
+		return h.DeleteFromBytes(val)
+	case *coordinationv1.Lease:
+		return h.DeleteFromObject(val)
+	case coordinationv1.Lease:
+		return h.DeleteFromObject(&val)
+	case *unstructured.Unstructured:
+		return h.DeleteFromUnstructured(val)
+	case unstructured.Unstructured:
+		return h.DeleteFromUnstructured(&val)
+	case map[string]interface{}:
+		return h.DeleteFromMap(val)
+	case metav1.Object, runtime.Object:
+		return h.DeleteFromObject(val)
+	default:
+		return ErrInvalidDeleteType
+	}
+}
+
+// DeleteByName deletes lease by name.
+func (h *Handler) DeleteByName(name string) error {
+	return h.clientset.CoordinationV1().Leases(h.namespace).Delete(h.ctx, name, h.Options.DeleteOptions)
+}
+
+// DeleteFromFile deletes lease from yaml or json file.
+func (h *Handler) DeleteFromFile(filename string) error {
+	data, err := ioutil.ReadFile(filename)
+	if err != nil {
+		return err
+	}
+	return h.DeleteFromBytes(data)
+}
+
+// DeleteFromBytes deletes lease from bytes data.
+func (h *Handler) DeleteFromBytes(data []byte) error {
+	leaseJson, err := yaml.ToJSON(data)
+	if err != nil {
+		return err
+	}
+
+	lease := &coordinationv1.Lease{}
+	if err = json.Unmarshal(leaseJson, lease); err != nil {
+		return err
+	}
+	return h.deleteLease(lease)
+}
+
+// DeleteFromObject deletes lease from metav1.Object or runtime.Object.
+func (h *Handler) DeleteFromObject(obj interface{}) error {
+	lease, ok := obj.(*coordinationv1.Lease)
+	if !ok {
+		return ErrInvalidObjectType
+	}
+	return h.deleteLease(lease)
+}
+
+// DeleteFromUnstructured deletes lease from *unstructured.Unstructured.
+func (h *Handler) DeleteFromUnstructured(u *unstructured.Unstructured) error {
+	lease := &coordinationv1.Lease{}
+	err := runtime.DefaultUnstructuredConverter.FromUnstructured(u.UnstructuredContent(), lease)
+	if err != nil {
+		return err
+	}
+	return h.deleteLease(lease)
+}
+
+// DeleteFromMap deletes lease from map[string]interface{}.
+func (h *Handler) DeleteFromMap(u map[string]interface{}) error {
+	lease := &coordinationv1.Lease{}
+	err := runtime.DefaultUnstructuredConverter.FromUnstructured(u, lease)
+	if err != nil {
+		return err
+	}
+	return h.deleteLease(lease)
+}
+
+// deleteLease
+func (h *Handler) deleteLease(lease *coordinationv1.Lease) error {
+	namespace := lease.GetNamespace()
+	if len(namespace) == 0 {
+		namespace = h.namespace
+	}
+	return h.clientset.CoordinationV1().Leases(namespace).Delete(h.ctx, lease.Name, h.Options.DeleteOptions)
+}
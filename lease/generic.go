@@ -0,0 +1,13 @@
+package lease
+
+import (
+	"github.com/forbearing/k8s/types"
+	coordinationv1 "k8s.io/api/coordination/v1"
+)
+
+// Generic returns the handler as a types.Handler, whose methods return
+// runtime.Object instead of *coordinationv1.Lease, so it can be used together with
+// the handlers of other resources.
+func (h *Handler) Generic() types.Handler {
+	return types.NewGeneric[*coordinationv1.Lease](h, GVK)
+}
@@ -0,0 +1,109 @@
+package lease
+
+import (
+	"encoding/json"
+	"io/ioutil"
+
+	coordinationv1 "k8s.io/api/coordination/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/yaml"
+)
+
+// Get gets lease from type string, []byte, *coordinationv1.Lease,
+// coordinationv1.Lease, metav1.Object, runtime.Object, *unstructured.Unstructured,
+// unstructured.Unstructured or map[string]interface{}.
+//
+// If passed parameter type is string, it will simply call GetByName instead of GetFromFile.
+// You should always explicitly call GetFromFile to get a lease from file path.
+func (h *Handler) Get(obj interface{}) (*coordinationv1.Lease, error) {
+	switch val := obj.(type) {
+	case string:
+		return h.GetByName(val)
+	case []byte:
+		return h.GetFromBytes(val)
+	case *coordinationv1.Lease:
+		return h.GetFromObject(val)
+	case coordinationv1.Lease:
+		return h.GetFromObject(&val)
+	case *unstructured.Unstructured:
+		return h.GetFromUnstructured(val)
+	case unstructured.Unstructured:
+		return h.GetFromUnstructured(&val)
+	case map[string]interface{}:
+		return h.GetFromMap(val)
+	case metav1.Object, runtime.Object:
+		return h.GetFromObject(val)
+	default:
+		return nil, ErrInvalidGetType
+	}
+}
+
+// GetByName gets lease by name.
+func (h *Handler) GetByName(name string) (*coordinationv1.Lease, error) {
+	return h.clientset.CoordinationV1().Leases(h.namespace).Get(h.ctx, name, h.Options.GetOptions)
+}
+
+// GetFromFile gets lease from yaml or json file.
+func (h *Handler) GetFromFile(filename string) (*coordinationv1.Lease, error) {
+	data, err := ioutil.ReadFile(filename)
+	if err != nil {
+		return nil, err
+	}
+	return h.GetFromBytes(data)
+}
+
+// GetFromBytes gets lease from bytes data.
+func (h *Handler) GetFromBytes(data []byte) (*coordinationv1.Lease, error) {
+	leaseJson, err := yaml.ToJSON(data)
+	if err != nil {
+		return nil, err
+	}
+
+	lease := &coordinationv1.Lease{}
+	if err = json.Unmarshal(leaseJson, lease); err != nil {
+		return nil, err
+	}
+	return h.getLease(lease)
+}
+
+// GetFromObject gets lease from metav1.Object or runtime.Object.
+func (h *Handler) GetFromObject(obj interface{}) (*coordinationv1.Lease, error) {
+	lease, ok := obj.(*coordinationv1.Lease)
+	if !ok {
+		return nil, ErrInvalidObjectType
+	}
+	return h.getLease(lease)
+}
+
+// GetFromUnstructured gets lease from *unstructured.Unstructured.
+func (h *Handler) GetFromUnstructured(u *unstructured.Unstructured) (*coordinationv1.Lease, error) {
+	lease := &coordinationv1.Lease{}
+	err := runtime.DefaultUnstructuredConverter.FromUnstructured(u.UnstructuredContent(), lease)
+	if err != nil {
+		return nil, err
+	}
+	return h.getLease(lease)
+}
+
+// GetFromMap gets lease from map[string]interface{}.
+func (h *Handler) GetFromMap(u map[string]interface{}) (*coordinationv1.Lease, error) {
+	lease := &coordinationv1.Lease{}
+	err := runtime.DefaultUnstructuredConverter.FromUnstructured(u, lease)
+	if err != nil {
+		return nil, err
+	}
+	return h.getLease(lease)
+}
+
+// getLease
+// It's necessary to get a new lease resource from a old lease resource,
+// because old lease usually don't have lease.Status field.
+func (h *Handler) getLease(lease *coordinationv1.Lease) (*coordinationv1.Lease, error) {
+	namespace := lease.GetNamespace()
+	if len(namespace) == 0 {
+		namespace = h.namespace
+	}
+	return h.clientset.CoordinationV1().Leases(namespace).Get(h.ctx, lease.Name, h.Options.GetOptions)
+}
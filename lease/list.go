@@ -0,0 +1,60 @@
+package lease
+
+import (
+	coordinationv1 "k8s.io/api/coordination/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/fields"
+)
+
+// List list all leases in the k8s cluster, it simply call `ListAll`.
+func (h *Handler) List() ([]*coordinationv1.Lease, error) {
+	return h.ListAll()
+}
+
+// ListByLabel list leases by labels.
+// Multiple labels separated by comma(",") eg: "name=myapp,role=devops",
+// and there is an "And" relationship between multiple labels.
+func (h *Handler) ListByLabel(labels string) ([]*coordinationv1.Lease, error) {
+	listOptions := h.Options.ListOptions.DeepCopy()
+	listOptions.LabelSelector = labels
+	leaseList, err := h.clientset.CoordinationV1().Leases(h.namespace).List(h.ctx, *listOptions)
+	if err != nil {
+		return nil, err
+	}
+	return extractList(leaseList), nil
+}
+
+// ListByField list leases by field, work like `kubectl get xxx --field-selector=xxx`.
+func (h *Handler) ListByField(field string) ([]*coordinationv1.Lease, error) {
+	fieldSelector, err := fields.ParseSelector(field)
+	if err != nil {
+		return nil, err
+	}
+	listOptions := h.Options.ListOptions.DeepCopy()
+	listOptions.FieldSelector = fieldSelector.String()
+
+	leaseList, err := h.clientset.CoordinationV1().Leases(h.namespace).List(h.ctx, *listOptions)
+	if err != nil {
+		return nil, err
+	}
+	return extractList(leaseList), nil
+}
+
+// ListByNamespace list all leases in the specified namespace.
+func (h *Handler) ListByNamespace(namespace string) ([]*coordinationv1.Lease, error) {
+	return h.WithNamespace(namespace).ListByLabel("")
+}
+
+// ListAll list all leases in the k8s cluster.
+func (h *Handler) ListAll() ([]*coordinationv1.Lease, error) {
+	return h.WithNamespace(metav1.NamespaceAll).ListByLabel("")
+}
+
+// extractList
+func extractList(leaseList *coordinationv1.LeaseList) []*coordinationv1.Lease {
+	var objList []*coordinationv1.Lease
+	for i := range leaseList.Items {
+		objList = append(objList, &leaseList.Items[i])
+	}
+	return objList
+}
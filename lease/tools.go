@@ -0,0 +1,58 @@
+package lease
+
+import (
+	"time"
+
+	coordinationv1 "k8s.io/api/coordination/v1"
+)
+
+// GetAge get the lease age.
+func (h *Handler) GetAge(object interface{}) (time.Duration, error) {
+	switch val := object.(type) {
+	case string:
+		ns, err := h.Get(val)
+		if err != nil {
+			return time.Duration(0), err
+		}
+		return time.Now().Sub(ns.CreationTimestamp.Time), nil
+	case *coordinationv1.Lease:
+		return time.Now().Sub(val.CreationTimestamp.Time), nil
+	case coordinationv1.Lease:
+		return time.Now().Sub(val.CreationTimestamp.Time), nil
+	default:
+		return time.Duration(0), ErrInvalidToolsType
+	}
+}
+
+// Holder gets the identity of the lease holder. ErrNoHolder is returned if
+// the lease is not held by anyone.
+func (h *Handler) Holder(name string) (string, error) {
+	lease, err := h.Get(name)
+	if err != nil {
+		return "", err
+	}
+	if lease.Spec.HolderIdentity == nil || len(*lease.Spec.HolderIdentity) == 0 {
+		return "", ErrNoHolder
+	}
+	return *lease.Spec.HolderIdentity, nil
+}
+
+// IsExpired checks whether the lease is expired, the lease expires when it's
+// not renewed within leaseDurationSeconds since the last renewTime. The lease
+// without renewTime or leaseDurationSeconds is considered expired.
+func (h *Handler) IsExpired(name string) (bool, error) {
+	lease, err := h.Get(name)
+	if err != nil {
+		return false, err
+	}
+	return isExpired(lease, time.Now()), nil
+}
+
+// isExpired checks whether the lease is expired at now.
+func isExpired(lease *coordinationv1.Lease, now time.Time) bool {
+	if lease.Spec.RenewTime == nil || lease.Spec.LeaseDurationSeconds == nil {
+		return true
+	}
+	duration := time.Duration(*lease.Spec.LeaseDurationSeconds) * time.Second
+	return !now.Before(lease.Spec.RenewTime.Add(duration))
+}
@@ -0,0 +1,78 @@
+package lease
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"path"
+	"testing"
+	"time"
+
+	"github.com/forbearing/k8s/types"
+	coordinationv1 "k8s.io/api/coordination/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/rest"
+	"k8s.io/utils/pointer"
+)
+
+func TestLease(t *testing.T) {
+	newLease := func(name string, holder *string, renewTime time.Time) coordinationv1.Lease {
+		return coordinationv1.Lease{
+			TypeMeta:   metav1.TypeMeta{Kind: "Lease", APIVersion: "coordination.k8s.io/v1"},
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "test"},
+			Spec: coordinationv1.LeaseSpec{
+				HolderIdentity:       holder,
+				LeaseDurationSeconds: pointer.Int32(15),
+				RenewTime:            &metav1.MicroTime{Time: renewTime},
+			},
+		}
+	}
+	leases := map[string]coordinationv1.Lease{
+		"active":   newLease("active", pointer.String("node1"), time.Now()),
+		"expired":  newLease("expired", pointer.String("node2"), time.Now().Add(-time.Minute)),
+		"released": newLease("released", nil, time.Now().Add(-time.Minute)),
+	}
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		lease, ok := leases[path.Base(r.URL.Path)]
+		if !ok || path.Dir(r.URL.Path) != "/apis/coordination.k8s.io/v1/namespaces/test/leases" {
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(lease)
+	}))
+	defer srv.Close()
+	clientset, err := kubernetes.NewForConfig(&rest.Config{Host: srv.URL})
+	if err != nil {
+		t.Fatal(err)
+	}
+	h := &Handler{ctx: context.Background(), namespace: "test", clientset: clientset, Options: &types.HandlerOptions{}}
+
+	tests := []struct {
+		name        string
+		wantHolder  string
+		wantErr     error
+		wantExpired bool
+	}{
+		{"active", "node1", nil, false},
+		{"expired", "node2", nil, true},
+		{"released", "", ErrNoHolder, true},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			holder, err := h.Holder(test.name)
+			if holder != test.wantHolder || !errors.Is(err, test.wantErr) {
+				t.Errorf("Holder() = %q, %v, want %q, %v", holder, err, test.wantHolder, test.wantErr)
+			}
+			expired, err := h.IsExpired(test.name)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if expired != test.wantExpired {
+				t.Errorf("IsExpired() = %v, want %v", expired, test.wantExpired)
+			}
+		})
+	}
+}
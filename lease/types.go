@@ -0,0 +1,15 @@
+package lease
+
+import "errors"
+
+var (
+	ErrInvalidToolsType  = errors.New("type must be string, *coordinationv1.Lease, coordinationv1.Lease, metav1.Object or runtime.Object")
+	ErrInvalidCreateType = errors.New("type must be string, []byte, *coordinationv1.Lease, coordinationv1.Lease, metav1.Object, runtime.Object, *unstructured.Unstructured, unstructured.Unstructured or map[string]interface{}")
+	ErrInvalidUpdateType = ErrInvalidCreateType
+	ErrInvalidApplyType  = ErrInvalidCreateType
+	ErrInvalidDeleteType = ErrInvalidCreateType
+	ErrInvalidGetType    = ErrInvalidCreateType
+	ErrInvalidPatchType  = errors.New("patch data type must be string, []byte, *coordinationv1.Lease, coordinationv1.Lease, metav1.Object, runtime.Object, *unstructured.Unstructured, unstructured.Unstructured or map[string]interface{}")
+	ErrInvalidObjectType = errors.New("object type is not *coordinationv1.Lease")
+	ErrNoHolder          = errors.New("lease is not held by anyone")
+)
@@ -0,0 +1,101 @@
+package lease
+
+import (
+	"encoding/json"
+	"io/ioutil"
+
+	coordinationv1 "k8s.io/api/coordination/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/yaml"
+)
+
+// Update updates lease from type string, []byte, *coordinationv1.Lease,
+// coordinationv1.Lease, metav1.Object, runtime.Object, *unstructured.Unstructured,
+// unstructured.Unstructured or map[string]interface{}.
+func (h *Handler) Update(obj interface{}) (*coordinationv1.Lease, error) {
+	switch val := obj.(type) {
+	case string:
+		return h.UpdateFromFile(val)
+	case []byte:
+		return h.UpdateFromBytes(val)
+	case *coordinationv1.Lease:
+		return h.UpdateFromObject(val)
+	case coordinationv1.Lease:
+		return h.UpdateFromObject(&val)
+	case *unstructured.Unstructured:
+		return h.UpdateFromUnstructured(val)
+	case unstructured.Unstructured:
+		return h.UpdateFromUnstructured(&val)
+	case map[string]interface{}:
+		return h.UpdateFromMap(val)
+	case metav1.Object, runtime.Object:
+		return h.UpdateFromObject(val)
+	default:
+		return nil, ErrInvalidUpdateType
+	}
+}
+
+// UpdateFromFile updates lease from yaml or json file.
+func (h *Handler) UpdateFromFile(filename string) (*coordinationv1.Lease, error) {
+	data, err := ioutil.ReadFile(filename)
+	if err != nil {
+		return nil, err
+	}
+	return h.UpdateFromBytes(data)
+}
+
+// UpdateFromBytes updates lease from bytes data.
+func (h *Handler) UpdateFromBytes(data []byte) (*coordinationv1.Lease, error) {
+	leaseJson, err := yaml.ToJSON(data)
+	if err != nil {
+		return nil, err
+	}
+
+	lease := &coordinationv1.Lease{}
+	if err = json.Unmarshal(leaseJson, lease); err != nil {
+		return nil, err
+	}
+	return h.updateLease(lease)
+}
+
+// UpdateFromObject updates lease from metav1.Object or runtime.Object.
+func (h *Handler) UpdateFromObject(obj interface{}) (*coordinationv1.Lease, error) {
+	lease, ok := obj.(*coordinationv1.Lease)
+	if !ok {
+		return nil, ErrInvalidObjectType
+	}
+	return h.updateLease(lease)
+}
+
+// UpdateFromUnstructured updates lease from *unstructured.Unstructured.
+func (h *Handler) UpdateFromUnstructured(u *unstructured.Unstructured) (*coordinationv1.Lease, error) {
+	lease := &coordinationv1.Lease{}
+	err := runtime.DefaultUnstructuredConverter.FromUnstructured(u.UnstructuredContent(), lease)
+	if err != nil {
+		return nil, err
+	}
+	return h.updateLease(lease)
+}
+
+// UpdateFromMap updates lease from map[string]interface{}.
+func (h *Handler) UpdateFromMap(u map[string]interface{}) (*coordinationv1.Lease, error) {
+	lease := &coordinationv1.Lease{}
+	err := runtime.DefaultUnstructuredConverter.FromUnstructured(u, lease)
+	if err != nil {
+		return nil, err
+	}
+	return h.updateLease(lease)
+}
+
+// updateLease
+func (h *Handler) updateLease(lease *coordinationv1.Lease) (*coordinationv1.Lease, error) {
+	namespace := lease.GetNamespace()
+	if len(namespace) == 0 {
+		namespace = h.namespace
+	}
+	lease.ResourceVersion = ""
+	lease.UID = ""
+	return h.clientset.CoordinationV1().Leases(namespace).Update(h.ctx, lease, h.Options.UpdateOptions)
+}
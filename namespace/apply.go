@@ -1,8 +1,6 @@
 package namespace
 
 import (
-	"fmt"
-
 	corev1 "k8s.io/api/core/v1"
 	k8serrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -58,7 +56,7 @@ func (h *Handler) ApplyFromBytes(data []byte) (ns *corev1.Namespace, err error)
 func (h *Handler) ApplyFromObject(obj interface{}) (*corev1.Namespace, error) {
 	ns, ok := obj.(*corev1.Namespace)
 	if !ok {
-		return nil, fmt.Errorf("object type is not *corev1.Namespace")
+		return nil, ErrInvalidObjectType
 	}
 	return h.applyNamespace(ns)
 }
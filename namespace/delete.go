@@ -2,7 +2,6 @@ package namespace
 
 import (
 	"encoding/json"
-	"fmt"
 	"io/ioutil"
 
 	corev1 "k8s.io/api/core/v1"
@@ -73,7 +72,7 @@ func (h *Handler) DeleteFromBytes(data []byte) error {
 func (h *Handler) DeleteFromObject(obj interface{}) error {
 	ns, ok := obj.(*corev1.Namespace)
 	if !ok {
-		return fmt.Errorf("object type is not *corev1.Namespace")
+		return ErrInvalidObjectType
 	}
 	return h.deleteNamespace(ns)
 }
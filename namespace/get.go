@@ -2,7 +2,6 @@ package namespace
 
 import (
 	"encoding/json"
-	"fmt"
 	"io/ioutil"
 
 	corev1 "k8s.io/api/core/v1"
@@ -73,7 +72,7 @@ func (h *Handler) GetFromBytes(data []byte) (*corev1.Namespace, error) {
 func (h *Handler) GetFromObject(obj interface{}) (*corev1.Namespace, error) {
 	ns, ok := obj.(*corev1.Namespace)
 	if !ok {
-		return nil, fmt.Errorf("object type is not *corev1.Namespace")
+		return nil, ErrInvalidObjectType
 	}
 	return h.getNamespace(ns)
 }
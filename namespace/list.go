@@ -44,6 +44,30 @@ func (h *Handler) ListAll() ([]*corev1.Namespace, error) {
 	return h.ListByLabel("")
 }
 
+// ListExcludeTerminating list namespaces by labels, but the namespaces that are
+// being deleted(.metadata.deletionTimestamp is not nil) are dropped.
+//
+// Field selector can't select objects by deletionTimestamp, so the terminating
+// namespaces are filtered out on the client side after listing.
+func (h *Handler) ListExcludeTerminating(labels string) ([]*corev1.Namespace, error) {
+	objList, err := h.ListByLabel(labels)
+	if err != nil {
+		return nil, err
+	}
+	return excludeTerminating(objList), nil
+}
+
+// excludeTerminating drops the namespaces whose deletionTimestamp is not nil.
+func excludeTerminating(objList []*corev1.Namespace) []*corev1.Namespace {
+	var live []*corev1.Namespace
+	for _, obj := range objList {
+		if obj.DeletionTimestamp == nil {
+			live = append(live, obj)
+		}
+	}
+	return live
+}
+
 // extractList
 func extractList(nsList *corev1.NamespaceList) []*corev1.Namespace {
 	var objList []*corev1.Namespace
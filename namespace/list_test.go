@@ -0,0 +1,47 @@
+package namespace
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+
+	"github.com/forbearing/k8s/types"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/rest"
+)
+
+func TestListExcludeTerminating(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet || r.URL.Path != "/api/v1/namespaces" {
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+		if selector := r.URL.Query().Get("labelSelector"); selector != "env=test" {
+			t.Errorf("labelSelector = %q, want env=test", selector)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprintln(w, `{"kind":"NamespaceList","apiVersion":"v1","metadata":{},"items":[
+{"metadata":{"name":"ns-1","deletionTimestamp":"2022-01-01T00:00:00Z"},"status":{"phase":"Terminating"}},
+{"metadata":{"name":"ns-2"},"status":{"phase":"Active"}}]}`)
+	}))
+	defer srv.Close()
+	clientset, err := kubernetes.NewForConfig(&rest.Config{Host: srv.URL})
+	if err != nil {
+		t.Fatal(err)
+	}
+	h := &Handler{ctx: context.Background(), clientset: clientset, Options: &types.HandlerOptions{}}
+
+	namespaces, err := h.ListExcludeTerminating("env=test")
+	if err != nil {
+		t.Fatal(err)
+	}
+	var names []string
+	for _, ns := range namespaces {
+		names = append(names, ns.Name)
+	}
+	if want := []string{"ns-2"}; !reflect.DeepEqual(names, want) {
+		t.Errorf("ListExcludeTerminating() = %v, want %v", names, want)
+	}
+}
@@ -2,7 +2,6 @@ package namespace
 
 import (
 	"encoding/json"
-	"fmt"
 	"io/ioutil"
 
 	corev1 "k8s.io/api/core/v1"
@@ -65,7 +64,7 @@ func (h *Handler) UpdateFromBytes(data []byte) (*corev1.Namespace, error) {
 func (h *Handler) UpdateFromObject(obj interface{}) (*corev1.Namespace, error) {
 	ns, ok := obj.(*corev1.Namespace)
 	if !ok {
-		return nil, fmt.Errorf("object type is not *corev1.Namespace")
+		return nil, ErrInvalidObjectType
 	}
 	return h.updateNamespace(ns)
 }
@@ -1,9 +1,14 @@
 package namespace
 
 import (
-	log "github.com/sirupsen/logrus"
+	"context"
+
+	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/watch"
 )
 
@@ -19,7 +24,7 @@ import (
 //  * If Event.Type is Error: *api.Status is recommended; other types may make sense
 //    depending on context.
 func (h *Handler) Watch(addFunc, modifyFunc, deleteFunc func(obj interface{})) error {
-	return h.WatchByLabel("", addFunc, deleteFunc, modifyFunc)
+	return h.WatchByLabel("", addFunc, modifyFunc, deleteFunc)
 }
 
 // WatchByName watch a single namespace reseource.
@@ -36,7 +41,7 @@ func (h *Handler) Watch(addFunc, modifyFunc, deleteFunc func(obj interface{})) e
 func (h *Handler) WatchByName(name string, addFunc, modifyFunc, deleteFunc func(obj interface{})) error {
 	listOptions := metav1.SingleObject(metav1.ObjectMeta{Name: name})
 	listOptions.TimeoutSeconds = new(int64)
-	return h.watchNamespace(listOptions, addFunc, modifyFunc, deleteFunc)
+	return h.watchNamespace(listOptions, addFunc, onlyNew(modifyFunc), deleteFunc)
 }
 
 // WatchByLabel watch a single or multiple Namespace resources selected by the label.
@@ -53,7 +58,7 @@ func (h *Handler) WatchByName(name string, addFunc, modifyFunc, deleteFunc func(
 //    depending on context.
 func (h *Handler) WatchByLabel(labels string, addFunc, modifyFunc, deleteFunc func(obj interface{})) error {
 	return h.watchNamespace(metav1.ListOptions{LabelSelector: labels, TimeoutSeconds: new(int64)},
-		addFunc, modifyFunc, deleteFunc)
+		addFunc, onlyNew(modifyFunc), deleteFunc)
 }
 
 // WatchByField watch a single or multiple Namespace resources selected by the field.
@@ -73,40 +78,293 @@ func (h *Handler) WatchByField(field string, addFunc, modifyFunc, deleteFunc fun
 		return err
 	}
 	listOptions := metav1.ListOptions{FieldSelector: fieldSelector.String(), TimeoutSeconds: new(int64)}
-	return h.watchNamespace(listOptions, addFunc, modifyFunc, deleteFunc)
+	return h.watchNamespace(listOptions, addFunc, onlyNew(modifyFunc), deleteFunc)
+}
+
+// WatchWithOld watch all namespace resources, the modifyFunc receives both
+// the previous and the current state of the modified namespace, just like the
+// UpdateFunc of informer does, so you can detect which field changed.
+//
+// The previous state is the last seen state of the namespace in this watch.
+// oldObj is nil if the namespace hasn't been seen before it's modified.
+func (h *Handler) WatchWithOld(addFunc func(obj interface{}), modifyFunc func(oldObj, newObj interface{}), deleteFunc func(obj interface{})) error {
+	return h.watchNamespace(metav1.ListOptions{TimeoutSeconds: new(int64)}, addFunc, modifyFunc, deleteFunc)
 }
 
 // watchNamespace watch namespace resources according to listOptions.
 func (h *Handler) watchNamespace(listOptions metav1.ListOptions,
-	addFunc, modifyFunc, deleteFunc func(obj interface{})) (err error) {
+	addFunc func(obj interface{}), modifyFunc func(oldObj, newObj interface{}), deleteFunc func(obj interface{})) error {
+
+	// lastSeen caches the last seen state of namespaces, it's the oldObj passed to modifyFunc.
+	lastSeen := make(map[string]interface{})
+	// kubernetes retains the resource event history, which includes this
+	// initial event, so that when our program first start, we are automatically
+	// notified of the namespace existence and current state.
+	// There we will not ignore the first resource added event.
+	return h.watchEvents(h.ctx, listOptions, make(map[string]*corev1.Namespace), nil, func(event watch.Event) {
+		name := objectName(event.Object)
+		switch event.Type {
+		case watch.Added:
+			lastSeen[name] = event.Object
+			addFunc(event.Object)
+		case watch.Modified:
+			oldObj := lastSeen[name]
+			lastSeen[name] = event.Object
+			modifyFunc(oldObj, event.Object)
+		case watch.Deleted:
+			delete(lastSeen, name)
+			deleteFunc(event.Object)
+		case watch.Bookmark:
+			h.log().Debug("watch namespace: bookmark")
+		case watch.Error:
+			h.log().Debug("watch namespace: error")
+		}
+	})
+}
+
+// WatchChan watch all namespace resources and returns the events through
+// a channel instead of callbacks, the Object of the Added, Modified, Deleted
+// and Bookmark events is *corev1.Namespace.
+//
+// The watch reconnects and resumes from the last seen resourceVersion just
+// like Watch does. Call the returned stop function to stop watching, the
+// channel is closed after the watch stopped. If reconnecting fails, the error
+// is sent as an Error event with *metav1.Status before the channel is closed.
+func (h *Handler) WatchChan() (<-chan watch.Event, func(), error) {
+	listOptions := metav1.ListOptions{TimeoutSeconds: new(int64), AllowWatchBookmarks: true}
+	watcher, err := h.clientset.CoreV1().Namespaces().Watch(h.ctx, listOptions)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	ctx, cancel := context.WithCancel(h.ctx)
+	eventCh := make(chan watch.Event)
+	go func() {
+		defer close(eventCh)
+		err := h.watchEvents(ctx, listOptions, make(map[string]*corev1.Namespace), watcher, func(event watch.Event) {
+			select {
+			case eventCh <- event:
+			case <-ctx.Done():
+			}
+		})
+		if err != nil && ctx.Err() == nil {
+			select {
+			case eventCh <- watch.Event{Type: watch.Error, Object: errorStatus(err)}:
+			case <-ctx.Done():
+			}
+		}
+	}()
+	return eventCh, cancel, nil
+}
 
-	var watcher watch.Interface
+// watchEvents watch namespace resources according to listOptions and passes
+// every event to handle, it starts from the watcher if it's not nil.
+//
+// The watch is resumed from the last seen resourceVersion(including the one
+// from bookmark events) after reconnecting, so no event is replayed or missed.
+// If the resourceVersion is too old(410 Gone), the namespaces are listed again
+// and compared with the known namespaces, only the changes missed in the gap
+// are passed to handle as Added, Modified or Deleted events, see syncList.
+// known is kept up to date with the events.
+func (h *Handler) watchEvents(ctx context.Context, listOptions metav1.ListOptions,
+	known map[string]*corev1.Namespace, watcher watch.Interface, handle func(event watch.Event)) (err error) {
+
+	listOptions.AllowWatchBookmarks = true
 	// if event channel is closed, it means the server has closed the connection,
 	// reconnect to kubernetes API server.
 	for {
-		if watcher, err = h.clientset.CoreV1().Namespaces().Watch(h.ctx, listOptions); err != nil {
-			return err
+		expired := false
+		if watcher == nil {
+			if watcher, err = h.clientset.CoreV1().Namespaces().Watch(ctx, listOptions); err != nil {
+				// the context is done, stop watching.
+				if ctx.Err() != nil {
+					return ctx.Err()
+				}
+				if !isExpired(err) || listOptions.ResourceVersion == "" {
+					return err
+				}
+				expired = true
+			}
+		}
+	events:
+		for !expired {
+			select {
+			case <-ctx.Done():
+				watcher.Stop()
+				return ctx.Err()
+			case event, ok := <-watcher.ResultChan():
+				if !ok {
+					break events
+				}
+				if event.Type == watch.Error && isExpired(k8serrors.FromObject(event.Object)) {
+					expired = true
+					break events
+				}
+				if event.Type != watch.Error {
+					if rv := objectResourceVersion(event.Object); rv != "" {
+						listOptions.ResourceVersion = rv
+					}
+				}
+				if ns, ok := event.Object.(*corev1.Namespace); ok {
+					switch event.Type {
+					case watch.Added, watch.Modified:
+						known[ns.Name] = ns
+					case watch.Deleted:
+						delete(known, ns.Name)
+					}
+				}
+				handle(event)
+			}
+		}
+		if watcher != nil {
+			// If event channel is closed, it means the server has closed the connection
+			h.log().Debug("watch namespace: reconnect to kubernetes")
+			watcher.Stop()
+			watcher = nil
 		}
-		// kubernetes retains the resource event history, which includes this
-		// initial event, so that when our program first start, we are automatically
-		// notified of the namespace existence and current state.
-		// There we will not ignore the first resource added event.
-		for event := range watcher.ResultChan() {
-			switch event.Type {
-			case watch.Added:
-				addFunc(event.Object)
-			case watch.Modified:
-				modifyFunc(event.Object)
-			case watch.Deleted:
-				deleteFunc(event.Object)
-			case watch.Bookmark:
-				log.Debug("watch namespace: bookmark")
-			case watch.Error:
-				log.Debug("watch namespace: error")
+		if expired {
+			h.log().Debug("watch namespace: resource version expired, relist")
+			if listOptions.ResourceVersion, err = h.relist(ctx, listOptions, known, handle); err != nil {
+				if ctx.Err() != nil {
+					return ctx.Err()
+				}
+				return err
 			}
 		}
-		// If event channel is closed, it means the server has closed the connection
-		log.Debug("watch namespace: reconnect to kubernetes")
-		watcher.Stop()
 	}
 }
+
+// relist lists all pages of the namespaces selected by listOptions, passes
+// the changes since the known namespaces to handle, see syncList, and returns
+// the resourceVersion of the list to resume the watch from.
+func (h *Handler) relist(ctx context.Context, listOptions metav1.ListOptions,
+	known map[string]*corev1.Namespace, handle func(event watch.Event)) (string, error) {
+
+	listOptions.ResourceVersion = ""
+	listOptions.TimeoutSeconds = nil
+	listOptions.AllowWatchBookmarks = false
+	listOptions.Continue = ""
+	// follow the continue token to list all pages, otherwise the namespaces
+	// beyond the first page would be taken as deleted.
+	nsList, err := h.clientset.CoreV1().Namespaces().List(ctx, listOptions)
+	if err != nil {
+		return "", err
+	}
+	for len(nsList.Continue) != 0 {
+		listOptions.Continue = nsList.Continue
+		next, err := h.clientset.CoreV1().Namespaces().List(ctx, listOptions)
+		if err != nil {
+			return "", err
+		}
+		nsList.Items = append(nsList.Items, next.Items...)
+		nsList.Continue = next.Continue
+	}
+	emit := func(eventType watch.EventType) func(obj interface{}) {
+		return func(obj interface{}) {
+			handle(watch.Event{Type: eventType, Object: obj.(*corev1.Namespace)})
+		}
+	}
+	syncList(known, nsList.Items, emit(watch.Added), emit(watch.Modified), emit(watch.Deleted))
+	return nsList.ResourceVersion, nil
+}
+
+// WatchWithInitialList watch all namespace resources, but before streaming
+// the live events, it lists all namespaces and emits them as Added events,
+// so the caller sees the current state exactly once before the deltas.
+//
+// The live events are watched from the resourceVersion of the list, and the
+// watch is resumed from the last seen resourceVersion after reconnecting.
+// If the resourceVersion is too old(410 Gone), the namespaces are listed again,
+// only the changed namespaces are emitted as Added, Modified or Deleted events.
+func (h *Handler) WatchWithInitialList(addFunc, modifyFunc, deleteFunc func(obj interface{})) error {
+	handle := func(event watch.Event) {
+		switch event.Type {
+		case watch.Added:
+			addFunc(event.Object)
+		case watch.Modified:
+			modifyFunc(event.Object)
+		case watch.Deleted:
+			deleteFunc(event.Object)
+		case watch.Bookmark:
+			h.log().Debug("watch namespace: bookmark")
+		case watch.Error:
+			h.log().Debug("watch namespace: error")
+		}
+	}
+	known := make(map[string]*corev1.Namespace)
+	listOptions := *h.Options.ListOptions.DeepCopy()
+	resourceVersion, err := h.relist(h.ctx, listOptions, known, handle)
+	if err != nil {
+		return err
+	}
+	listOptions.ResourceVersion = resourceVersion
+	listOptions.TimeoutSeconds = new(int64)
+	return h.watchEvents(h.ctx, listOptions, known, nil, handle)
+}
+
+// syncList compares the listed namespaces with the known namespaces, emits
+// the new namespaces as Added events, the changed namespaces as Modified events
+// and the disappeared namespaces as Deleted events, then updates the known namespaces.
+func syncList(known map[string]*corev1.Namespace, items []corev1.Namespace,
+	addFunc, modifyFunc, deleteFunc func(obj interface{})) {
+
+	listed := make(map[string]bool, len(items))
+	for i := range items {
+		ns := &items[i]
+		listed[ns.Name] = true
+		old, ok := known[ns.Name]
+		known[ns.Name] = ns
+		switch {
+		case !ok:
+			addFunc(ns)
+		case old.ResourceVersion != ns.ResourceVersion:
+			modifyFunc(ns)
+		}
+	}
+	for name, ns := range known {
+		if !listed[name] {
+			delete(known, name)
+			deleteFunc(ns)
+		}
+	}
+}
+
+// onlyNew adapts the modifyFunc that only receives the current state of the
+// namespace to the one receives both the previous and current state.
+func onlyNew(modifyFunc func(obj interface{})) func(oldObj, newObj interface{}) {
+	return func(_, newObj interface{}) {
+		modifyFunc(newObj)
+	}
+}
+
+// objectName returns the name of the namespace object in the watch event.
+func objectName(obj runtime.Object) string {
+	accessor, err := meta.Accessor(obj)
+	if err != nil {
+		return ""
+	}
+	return accessor.GetName()
+}
+
+// objectResourceVersion returns the resourceVersion of the namespace object in the watch event.
+func objectResourceVersion(obj runtime.Object) string {
+	accessor, err := meta.Accessor(obj)
+	if err != nil {
+		return ""
+	}
+	return accessor.GetResourceVersion()
+}
+
+// isExpired returns true if the err means the resourceVersion is too old.
+func isExpired(err error) bool {
+	return k8serrors.IsResourceExpired(err) || k8serrors.IsGone(err)
+}
+
+// errorStatus converts the err to *metav1.Status, which is the Object of watch Error event.
+func errorStatus(err error) *metav1.Status {
+	if status, ok := err.(k8serrors.APIStatus); ok {
+		s := status.Status()
+		return &s
+	}
+	return &metav1.Status{Status: metav1.StatusFailure, Message: err.Error()}
+}
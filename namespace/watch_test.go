@@ -0,0 +1,473 @@
+package namespace
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"sort"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/forbearing/k8s/types"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/rest"
+)
+
+func TestWatchCallbacks(t *testing.T) {
+	// the first watch request streams an Added, a Modified and a Deleted event,
+	// the reconnecting watch request fails, so Watch returns.
+	var requests int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if atomic.AddInt32(&requests, 1) > 1 {
+			http.Error(w, "stop watching", http.StatusForbidden)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		for _, event := range []string{"ADDED", "MODIFIED", "DELETED"} {
+			fmt.Fprintf(w, `{"type":%q,"object":{"kind":"Namespace","apiVersion":"v1","metadata":{"name":%q}}}`+"\n",
+				event, event)
+		}
+	}))
+	defer srv.Close()
+	clientset, err := kubernetes.NewForConfig(&rest.Config{Host: srv.URL})
+	if err != nil {
+		t.Fatal(err)
+	}
+	h := &Handler{ctx: context.Background(), clientset: clientset, Options: &types.HandlerOptions{}}
+
+	var added, modified, deleted []string
+	record := func(events *[]string) func(obj interface{}) {
+		return func(obj interface{}) {
+			*events = append(*events, obj.(*corev1.Namespace).Name)
+		}
+	}
+	if err := h.Watch(record(&added), record(&modified), record(&deleted)); err == nil {
+		t.Fatal("expected the reconnecting watch to fail")
+	}
+	if !reflect.DeepEqual(added, []string{"ADDED"}) ||
+		!reflect.DeepEqual(modified, []string{"MODIFIED"}) ||
+		!reflect.DeepEqual(deleted, []string{"DELETED"}) {
+		t.Errorf("added=%v modified=%v deleted=%v", added, modified, deleted)
+	}
+}
+
+func TestWatchWithOld(t *testing.T) {
+	// the first watch request streams the events, the reconnecting watch
+	// request fails, so WatchWithOld returns.
+	var requests int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if atomic.AddInt32(&requests, 1) > 1 {
+			http.Error(w, "stop watching", http.StatusForbidden)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		for _, event := range []struct{ typ, name, rv string }{
+			{"ADDED", "a", "1"},
+			{"MODIFIED", "a", "2"},
+			// "b" is modified before it's seen.
+			{"MODIFIED", "b", "3"},
+			{"MODIFIED", "a", "4"},
+			{"DELETED", "a", "5"},
+			// "a" is created again after it's deleted.
+			{"MODIFIED", "a", "6"},
+		} {
+			fmt.Fprintf(w, `{"type":%q,"object":{"kind":"Namespace","apiVersion":"v1","metadata":{"name":%q,"resourceVersion":%q}}}`+"\n",
+				event.typ, event.name, event.rv)
+		}
+	}))
+	defer srv.Close()
+	clientset, err := kubernetes.NewForConfig(&rest.Config{Host: srv.URL})
+	if err != nil {
+		t.Fatal(err)
+	}
+	h := &Handler{ctx: context.Background(), clientset: clientset, Options: &types.HandlerOptions{}}
+
+	nameRV := func(obj interface{}) string {
+		if obj == nil {
+			return "nil"
+		}
+		ns := obj.(*corev1.Namespace)
+		return ns.Name + "@" + ns.ResourceVersion
+	}
+	var added, modified, deleted []string
+	err = h.WatchWithOld(
+		func(obj interface{}) { added = append(added, nameRV(obj)) },
+		func(oldObj, newObj interface{}) { modified = append(modified, nameRV(oldObj)+" -> "+nameRV(newObj)) },
+		func(obj interface{}) { deleted = append(deleted, nameRV(obj)) },
+	)
+	if err == nil {
+		t.Fatal("expected the reconnecting watch to fail")
+	}
+	if want := []string{"a@1"}; !reflect.DeepEqual(added, want) {
+		t.Errorf("added = %v, want %v", added, want)
+	}
+	if want := []string{"a@1 -> a@2", "nil -> b@3", "a@2 -> a@4", "nil -> a@6"}; !reflect.DeepEqual(modified, want) {
+		t.Errorf("modified = %q, want %q", modified, want)
+	}
+	if want := []string{"a@5"}; !reflect.DeepEqual(deleted, want) {
+		t.Errorf("deleted = %v, want %v", deleted, want)
+	}
+}
+
+func TestWatchCancel(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprintln(w, `{"type":"ADDED","object":{"kind":"Namespace","apiVersion":"v1","metadata":{"name":"default"}}}`)
+		w.(http.Flusher).Flush()
+		// keep the watch connection open until the client goes away.
+		<-r.Context().Done()
+	}))
+	defer srv.Close()
+	clientset, err := kubernetes.NewForConfig(&rest.Config{Host: srv.URL})
+	if err != nil {
+		t.Fatal(err)
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	h := &Handler{ctx: ctx, clientset: clientset, Options: &types.HandlerOptions{}}
+
+	added := make(chan struct{})
+	errCh := make(chan error, 1)
+	go func() {
+		errCh <- h.Watch(func(interface{}) { close(added) }, func(interface{}) {}, func(interface{}) {})
+	}()
+	<-added
+	cancel()
+	select {
+	case err := <-errCh:
+		if err != context.Canceled {
+			t.Errorf("Watch() = %v, want context.Canceled", err)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("Watch() didn't return after the context is cancelled")
+	}
+}
+
+func TestSyncList(t *testing.T) {
+	newNS := func(name, rv string) corev1.Namespace {
+		return corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: name, ResourceVersion: rv}}
+	}
+	var added, modified, deleted []string
+	record := func(events *[]string) func(obj interface{}) {
+		return func(obj interface{}) {
+			*events = append(*events, obj.(*corev1.Namespace).Name)
+		}
+	}
+	known := make(map[string]*corev1.Namespace)
+
+	// initial list: every namespace is emitted as Added exactly once.
+	syncList(known, []corev1.Namespace{newNS("default", "1"), newNS("kube-system", "2")},
+		record(&added), record(&modified), record(&deleted))
+	sort.Strings(added)
+	if !reflect.DeepEqual(added, []string{"default", "kube-system"}) || len(modified) != 0 || len(deleted) != 0 {
+		t.Fatalf("initial list: added=%v modified=%v deleted=%v", added, modified, deleted)
+	}
+
+	// relist: only the changed namespaces are emitted.
+	added, modified, deleted = nil, nil, nil
+	syncList(known, []corev1.Namespace{newNS("default", "3"), newNS("test", "4")},
+		record(&added), record(&modified), record(&deleted))
+	if !reflect.DeepEqual(added, []string{"test"}) ||
+		!reflect.DeepEqual(modified, []string{"default"}) ||
+		!reflect.DeepEqual(deleted, []string{"kube-system"}) {
+		t.Fatalf("relist: added=%v modified=%v deleted=%v", added, modified, deleted)
+	}
+	if len(known) != 2 {
+		t.Errorf("known namespaces = %d, want 2", len(known))
+	}
+}
+
+func TestWatchResume(t *testing.T) {
+	// the first watch request streams an Added and a Bookmark event then closes,
+	// the second one must resume from the bookmark and answers 410 Gone, then
+	// the namespaces are listed again, the third watch request must resume from
+	// the resourceVersion of the list, then the watch is stopped by 403.
+	var requests int32
+	var resourceVersions []string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		query := r.URL.Query()
+		w.Header().Set("Content-Type", "application/json")
+		if query.Get("watch") != "true" {
+			fmt.Fprintln(w, `{"kind":"NamespaceList","apiVersion":"v1","metadata":{"resourceVersion":"20"},"items":[`+
+				`{"metadata":{"name":"default","resourceVersion":"10"}}]}`)
+			return
+		}
+		n := atomic.AddInt32(&requests, 1)
+		if query.Get("allowWatchBookmarks") != "true" {
+			t.Errorf("request %d: allowWatchBookmarks = %q, want true", n, query.Get("allowWatchBookmarks"))
+		}
+		resourceVersions = append(resourceVersions, query.Get("resourceVersion"))
+		switch n {
+		case 1:
+			fmt.Fprintln(w, `{"type":"ADDED","object":{"kind":"Namespace","apiVersion":"v1","metadata":{"name":"default","resourceVersion":"10"}}}`)
+			fmt.Fprintln(w, `{"type":"BOOKMARK","object":{"kind":"Namespace","apiVersion":"v1","metadata":{"resourceVersion":"15"}}}`)
+		case 2:
+			fmt.Fprintln(w, `{"type":"ERROR","object":{"kind":"Status","apiVersion":"v1","status":"Failure","reason":"Expired","code":410}}`)
+		default:
+			http.Error(w, "stop watching", http.StatusForbidden)
+		}
+	}))
+	defer srv.Close()
+	clientset, err := kubernetes.NewForConfig(&rest.Config{Host: srv.URL})
+	if err != nil {
+		t.Fatal(err)
+	}
+	h := &Handler{ctx: context.Background(), clientset: clientset, Options: &types.HandlerOptions{}}
+
+	var added, modified int
+	if err := h.Watch(func(interface{}) { added++ }, func(interface{}) { modified++ }, func(interface{}) {}); err == nil {
+		t.Fatal("expected the last watch to fail")
+	}
+	// the unchanged namespace is not emitted again after relisting.
+	if added != 1 || modified != 0 {
+		t.Errorf("added %d and modified %d namespaces, want 1 and 0", added, modified)
+	}
+	if want := []string{"", "15", "20"}; !reflect.DeepEqual(resourceVersions, want) {
+		t.Errorf("resourceVersions = %q, want %q", resourceVersions, want)
+	}
+}
+
+func TestWatchRelist(t *testing.T) {
+	// "a" is deleted, "b" is modified and "c" is created while the watch is
+	// disconnected, the reconnecting watch answers 410 Gone, the changes in
+	// the gap are found by listing the namespaces again.
+	var requests int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if r.URL.Query().Get("watch") != "true" {
+			if selector := r.URL.Query().Get("labelSelector"); selector != "team=dev" {
+				t.Errorf("list labelSelector = %q, want team=dev", selector)
+			}
+			fmt.Fprintln(w, `{"kind":"NamespaceList","apiVersion":"v1","metadata":{"resourceVersion":"30"},"items":[`+
+				`{"metadata":{"name":"b","resourceVersion":"25"}},{"metadata":{"name":"c","resourceVersion":"26"}}]}`)
+			return
+		}
+		switch atomic.AddInt32(&requests, 1) {
+		case 1:
+			fmt.Fprintln(w, `{"type":"ADDED","object":{"kind":"Namespace","apiVersion":"v1","metadata":{"name":"a","resourceVersion":"1"}}}`)
+			fmt.Fprintln(w, `{"type":"ADDED","object":{"kind":"Namespace","apiVersion":"v1","metadata":{"name":"b","resourceVersion":"2"}}}`)
+		case 2:
+			fmt.Fprintln(w, `{"type":"ERROR","object":{"kind":"Status","apiVersion":"v1","status":"Failure","reason":"Expired","code":410}}`)
+		default:
+			http.Error(w, "stop watching", http.StatusForbidden)
+		}
+	}))
+	defer srv.Close()
+	clientset, err := kubernetes.NewForConfig(&rest.Config{Host: srv.URL})
+	if err != nil {
+		t.Fatal(err)
+	}
+	h := &Handler{ctx: context.Background(), clientset: clientset, Options: &types.HandlerOptions{}}
+
+	var events []string
+	record := func(eventType string) func(obj interface{}) {
+		return func(obj interface{}) {
+			ns := obj.(*corev1.Namespace)
+			events = append(events, eventType+" "+ns.Name+"@"+ns.ResourceVersion)
+		}
+	}
+	if err := h.WatchByLabel("team=dev", record("ADDED"), record("MODIFIED"), record("DELETED")); err == nil {
+		t.Fatal("expected the last watch to fail")
+	}
+	want := []string{"ADDED a@1", "ADDED b@2", "MODIFIED b@25", "ADDED c@26", "DELETED a@1"}
+	if !reflect.DeepEqual(events, want) {
+		t.Errorf("events = %q, want %q", events, want)
+	}
+}
+
+func TestWatchWithInitialListPaged(t *testing.T) {
+	// the namespaces are listed in pages of one namespace, the initial list
+	// has "a" and "b", the watch modifies "a" then answers 410 Gone, "d" is
+	// created in the gap. Every page must be listed, or the namespaces beyond
+	// the first page would be emitted as deleted.
+	pages := [][]string{
+		{`{"metadata":{"name":"a","resourceVersion":"1"}}`, `{"metadata":{"name":"b","resourceVersion":"2"}}`},
+		{`{"metadata":{"name":"a","resourceVersion":"11"}}`, `{"metadata":{"name":"b","resourceVersion":"2"}}`, `{"metadata":{"name":"d","resourceVersion":"20"}}`},
+	}
+	listRVs := []string{"10", "30"}
+	var (
+		mu        sync.Mutex
+		lists     int
+		continues []string
+		watchRVs  []string
+	)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+		query := r.URL.Query()
+		w.Header().Set("Content-Type", "application/json")
+		if query.Get("watch") != "true" {
+			if query.Get("limit") != "1" {
+				t.Errorf("list limit = %q, want 1", query.Get("limit"))
+			}
+			continues = append(continues, query.Get("continue"))
+			page := 0
+			if token := query.Get("continue"); token != "" {
+				fmt.Sscanf(token, "page%d", &page)
+			} else {
+				lists++
+			}
+			items := pages[lists-1]
+			next := ""
+			if page+1 < len(items) {
+				next = fmt.Sprintf("page%d", page+1)
+			}
+			fmt.Fprintf(w, `{"kind":"NamespaceList","apiVersion":"v1","metadata":{"resourceVersion":%q,"continue":%q},"items":[%s]}`+"\n",
+				listRVs[lists-1], next, items[page])
+			return
+		}
+		watchRVs = append(watchRVs, query.Get("resourceVersion"))
+		switch len(watchRVs) {
+		case 1:
+			fmt.Fprintln(w, `{"type":"MODIFIED","object":{"kind":"Namespace","apiVersion":"v1","metadata":{"name":"a","resourceVersion":"11"}}}`)
+			fmt.Fprintln(w, `{"type":"ERROR","object":{"kind":"Status","apiVersion":"v1","status":"Failure","reason":"Expired","code":410}}`)
+		default:
+			http.Error(w, "stop watching", http.StatusForbidden)
+		}
+	}))
+	defer srv.Close()
+	clientset, err := kubernetes.NewForConfig(&rest.Config{Host: srv.URL})
+	if err != nil {
+		t.Fatal(err)
+	}
+	h := &Handler{ctx: context.Background(), clientset: clientset, Options: &types.HandlerOptions{}}
+	h.SetLimit(1)
+
+	var events []string
+	record := func(eventType string) func(obj interface{}) {
+		return func(obj interface{}) {
+			ns := obj.(*corev1.Namespace)
+			events = append(events, eventType+" "+ns.Name+"@"+ns.ResourceVersion)
+		}
+	}
+	if err := h.WatchWithInitialList(record("ADDED"), record("MODIFIED"), record("DELETED")); err == nil {
+		t.Fatal("expected the last watch to fail")
+	}
+	want := []string{"ADDED a@1", "ADDED b@2", "MODIFIED a@11", "ADDED d@20"}
+	if !reflect.DeepEqual(events, want) {
+		t.Errorf("events = %q, want %q", events, want)
+	}
+	if want := []string{"", "page1", "", "page1", "page2"}; !reflect.DeepEqual(continues, want) {
+		t.Errorf("list continue tokens = %q, want %q", continues, want)
+	}
+	if want := []string{"10", "30"}; !reflect.DeepEqual(watchRVs, want) {
+		t.Errorf("watch resourceVersions = %q, want %q", watchRVs, want)
+	}
+}
+
+func TestWatchChan(t *testing.T) {
+	// the first watch request streams two events then closes, the reconnecting
+	// watch request streams one more event and stays open until stopped.
+	var requests int32
+	stopped := make(chan struct{})
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if atomic.AddInt32(&requests, 1) == 1 {
+			fmt.Fprintln(w, `{"type":"ADDED","object":{"kind":"Namespace","apiVersion":"v1","metadata":{"name":"a","resourceVersion":"1"}}}`)
+			fmt.Fprintln(w, `{"type":"MODIFIED","object":{"kind":"Namespace","apiVersion":"v1","metadata":{"name":"a","resourceVersion":"2"}}}`)
+			return
+		}
+		if rv := r.URL.Query().Get("resourceVersion"); rv != "2" {
+			t.Errorf("reconnect resourceVersion = %q, want 2", rv)
+		}
+		fmt.Fprintln(w, `{"type":"DELETED","object":{"kind":"Namespace","apiVersion":"v1","metadata":{"name":"a","resourceVersion":"3"}}}`)
+		w.(http.Flusher).Flush()
+		<-r.Context().Done()
+		close(stopped)
+	}))
+	defer srv.Close()
+	clientset, err := kubernetes.NewForConfig(&rest.Config{Host: srv.URL})
+	if err != nil {
+		t.Fatal(err)
+	}
+	h := &Handler{ctx: context.Background(), clientset: clientset, Options: &types.HandlerOptions{}}
+
+	eventCh, stop, err := h.WatchChan()
+	if err != nil {
+		t.Fatal(err)
+	}
+	var got []string
+	for _, want := range []string{"ADDED", "MODIFIED", "DELETED"} {
+		event := <-eventCh
+		ns, ok := event.Object.(*corev1.Namespace)
+		if !ok {
+			t.Fatalf("event object is %T, want *corev1.Namespace", event.Object)
+		}
+		if string(event.Type) != want {
+			t.Errorf("event type = %s, want %s", event.Type, want)
+		}
+		got = append(got, ns.ResourceVersion)
+	}
+	if want := []string{"1", "2", "3"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("resourceVersions = %v, want %v", got, want)
+	}
+
+	stop()
+	select {
+	case _, ok := <-eventCh:
+		if ok {
+			t.Error("expected the channel to be closed after stop")
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("the channel isn't closed after stop")
+	}
+	select {
+	case <-stopped:
+	case <-time.After(5 * time.Second):
+		t.Fatal("the watch connection isn't closed after stop")
+	}
+}
+
+// captureLogger is a types.Logger recording the debug messages.
+type captureLogger struct {
+	mu     sync.Mutex
+	debugs []string
+}
+
+func (l *captureLogger) Debug(args ...interface{}) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.debugs = append(l.debugs, fmt.Sprint(args...))
+}
+func (l *captureLogger) Info(args ...interface{})  {}
+func (l *captureLogger) Error(args ...interface{}) {}
+
+func TestSetLogger(t *testing.T) {
+	// the first watch request streams a Bookmark event, the reconnecting watch
+	// request fails, so Watch returns.
+	var requests int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if atomic.AddInt32(&requests, 1) > 1 {
+			http.Error(w, "stop watching", http.StatusForbidden)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprintln(w, `{"type":"BOOKMARK","object":{"kind":"Namespace","apiVersion":"v1","metadata":{"resourceVersion":"10"}}}`)
+	}))
+	defer srv.Close()
+	clientset, err := kubernetes.NewForConfig(&rest.Config{Host: srv.URL})
+	if err != nil {
+		t.Fatal(err)
+	}
+	h := &Handler{ctx: context.Background(), clientset: clientset, Options: &types.HandlerOptions{}}
+	logger := &captureLogger{}
+	h.SetLogger(logger)
+
+	noop := func(obj interface{}) {}
+	if err := h.Watch(noop, noop, noop); err == nil {
+		t.Fatal("expected the reconnecting watch to fail")
+	}
+	want := []string{"watch namespace: bookmark", "watch namespace: reconnect to kubernetes"}
+	if !reflect.DeepEqual(logger.debugs, want) {
+		t.Errorf("debug messages = %q, want %q", logger.debugs, want)
+	}
+	// the logger is kept by the copied handler.
+	if h.DeepCopy().log() != logger {
+		t.Error("DeepCopy() doesn't keep the logger")
+	}
+}
@@ -1,8 +1,6 @@
 package networkpolicy
 
 import (
-	"fmt"
-
 	networkingv1 "k8s.io/api/networking/v1"
 	k8serrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -58,7 +56,7 @@ func (h *Handler) ApplyFromBytes(data []byte) (netpol *networkingv1.NetworkPolic
 func (h *Handler) ApplyFromObject(obj interface{}) (*networkingv1.NetworkPolicy, error) {
 	netpol, ok := obj.(*networkingv1.NetworkPolicy)
 	if !ok {
-		return nil, fmt.Errorf("object type is not *networkingv1.NetworkPolicy")
+		return nil, ErrInvalidObjectType
 	}
 	return h.applyNetpol(netpol)
 }
@@ -2,7 +2,6 @@ package networkpolicy
 
 import (
 	"encoding/json"
-	"fmt"
 	"io/ioutil"
 
 	networkingv1 "k8s.io/api/networking/v1"
@@ -73,7 +72,7 @@ func (h *Handler) DeleteFromBytes(data []byte) error {
 func (h *Handler) DeleteFromObject(obj interface{}) error {
 	netpol, ok := obj.(*networkingv1.NetworkPolicy)
 	if !ok {
-		return fmt.Errorf("object type is not *networkingv1.NetworkPolicy")
+		return ErrInvalidObjectType
 	}
 	return h.deleteNetpol(netpol)
 }
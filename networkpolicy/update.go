@@ -2,7 +2,6 @@ package networkpolicy
 
 import (
 	"encoding/json"
-	"fmt"
 	"io/ioutil"
 
 	networkingv1 "k8s.io/api/networking/v1"
@@ -65,7 +64,7 @@ func (h *Handler) UpdateFromBytes(data []byte) (*networkingv1.NetworkPolicy, err
 func (h *Handler) UpdateFromObject(obj interface{}) (*networkingv1.NetworkPolicy, error) {
 	netpol, ok := obj.(*networkingv1.NetworkPolicy)
 	if !ok {
-		return nil, fmt.Errorf("object type is not *networkingv1.NetworkPolicy")
+		return nil, ErrInvalidObjectType
 	}
 	return h.updateNetpol(netpol)
 }
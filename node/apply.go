@@ -1,8 +1,6 @@
 package node
 
 import (
-	"fmt"
-
 	corev1 "k8s.io/api/core/v1"
 	k8serrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -58,7 +56,7 @@ func (h *Handler) ApplyFromBytes(data []byte) (node *corev1.Node, err error) {
 func (h *Handler) ApplyFromObject(obj interface{}) (*corev1.Node, error) {
 	node, ok := obj.(*corev1.Node)
 	if !ok {
-		return nil, fmt.Errorf("object type is not *corev1.Node")
+		return nil, ErrInvalidObjectType
 	}
 	return h.applyNode(node)
 }
@@ -2,7 +2,6 @@ package node
 
 import (
 	"encoding/json"
-	"fmt"
 	"io/ioutil"
 
 	corev1 "k8s.io/api/core/v1"
@@ -65,7 +64,7 @@ func (h *Handler) CreateFromBytes(data []byte) (*corev1.Node, error) {
 func (h *Handler) CreateFromObject(obj interface{}) (*corev1.Node, error) {
 	node, ok := obj.(*corev1.Node)
 	if !ok {
-		return nil, fmt.Errorf("object type is not *corev1.Node")
+		return nil, ErrInvalidObjectType
 	}
 	return h.createNode(node)
 }
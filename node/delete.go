@@ -2,7 +2,6 @@ package node
 
 import (
 	"encoding/json"
-	"fmt"
 	"io/ioutil"
 
 	corev1 "k8s.io/api/core/v1"
@@ -46,6 +45,14 @@ func (h *Handler) DeleteByName(name string) error {
 	return h.clientset.CoreV1().Nodes().Delete(h.ctx, name, h.Options.DeleteOptions)
 }
 
+// DeleteWithOptions deletes the node by name with the delete options, such
+// as the propagation policy and the grace period, instead of the delete options
+// of the handler. It doesn't modify the handler, so it's safe to be called
+// concurrently with the different options.
+func (h *Handler) DeleteWithOptions(name string, opts metav1.DeleteOptions) error {
+	return h.clientset.CoreV1().Nodes().Delete(h.ctx, name, opts)
+}
+
 // DeleteFromFile deletes node from yaml or json file.
 func (h *Handler) DeleteFromFile(filename string) error {
 	data, err := ioutil.ReadFile(filename)
@@ -73,7 +80,7 @@ func (h *Handler) DeleteFromBytes(data []byte) error {
 func (h *Handler) DeleteFromObject(obj interface{}) error {
 	node, ok := obj.(*corev1.Node)
 	if !ok {
-		return fmt.Errorf("object type is not *corev1.Node")
+		return ErrInvalidObjectType
 	}
 	return h.deleteNode(node)
 }
@@ -0,0 +1,40 @@
+package node
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestDeleteWithOptions(t *testing.T) {
+	var deleteOptions metav1.DeleteOptions
+	h := newTestHandler(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodDelete || r.URL.Path != "/api/v1/nodes/node1" {
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+		if err := json.NewDecoder(r.Body).Decode(&deleteOptions); err != nil {
+			t.Error(err)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"kind":"Status","apiVersion":"v1","status":"Success"}`))
+	})
+	background := metav1.DeletePropagationBackground
+	h.Options.DeleteOptions.PropagationPolicy = &background
+
+	foreground := metav1.DeletePropagationForeground
+	gracePeriodSeconds := int64(0)
+	opts := metav1.DeleteOptions{PropagationPolicy: &foreground, GracePeriodSeconds: &gracePeriodSeconds}
+	if err := h.DeleteWithOptions("node1", opts); err != nil {
+		t.Fatal(err)
+	}
+	if deleteOptions.PropagationPolicy == nil || *deleteOptions.PropagationPolicy != foreground ||
+		deleteOptions.GracePeriodSeconds == nil || *deleteOptions.GracePeriodSeconds != 0 {
+		t.Errorf("delete options = %+v, want %+v", deleteOptions, opts)
+	}
+	// the handler delete options are untouched.
+	if *h.Options.DeleteOptions.PropagationPolicy != background || h.Options.DeleteOptions.GracePeriodSeconds != nil {
+		t.Errorf("handler delete options are modified: %+v", h.Options.DeleteOptions)
+	}
+}
@@ -0,0 +1,214 @@
+package node
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/forbearing/k8s/pod"
+	utildiscovery "github.com/forbearing/k8s/util/discovery"
+	corev1 "k8s.io/api/core/v1"
+	policyv1 "k8s.io/api/policy/v1"
+	policyv1beta1 "k8s.io/api/policy/v1beta1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/wait"
+)
+
+const (
+	// pollInterval is the interval between two evictions of a pod blocked by
+	// PodDisruptionBudget, and between two checks of whether the pods are deleted.
+	pollInterval = 2 * time.Second
+
+	// mirrorPodAnnotation is the annotation of the mirror pods created by kubelet
+	// for the static pods, they can't be deleted through kubernetes API server.
+	mirrorPodAnnotation = "kubernetes.io/config.mirror"
+)
+
+// evictionGVR is the pod eviction subresource, kubernetes API server reports
+// the group version of the Eviction object it accepts, policy/v1 or policy/v1beta1.
+var evictionGVR = schema.GroupVersionResource{Version: "v1", Resource: "pods/eviction"}
+
+// DrainOptions is the options for Drain.
+type DrainOptions struct {
+	// GracePeriodSeconds is the time given to each pod to terminate gracefully.
+	// If nil, the default grace period of the pod is used.
+	GracePeriodSeconds *int64
+	// Force continues even if there are pods not managed by a controller,
+	// such as ReplicaSet, Job, DaemonSet or StatefulSet, these pods are deleted
+	// forever.
+	Force bool
+	// IgnoreDaemonSets ignores the DaemonSet-managed pods, they are recreated
+	// by the DaemonSet controller immediately even if evicted.
+	IgnoreDaemonSets bool
+	// DeleteLocalData continues even if there are pods using emptyDir, the
+	// emptyDir data is deleted when the pod is evicted.
+	DeleteLocalData bool
+	// Timeout is the max duration to wait for the pods to be evicted and deleted.
+	// Zero means waiting until the handler context is done.
+	Timeout time.Duration
+}
+
+// Cordon marks the node as unschedulable, it works like `kubectl cordon`.
+func (h *Handler) Cordon(name string) error {
+	return h.setUnschedulable(name, true)
+}
+
+// Uncordon marks the node as schedulable, it works like `kubectl uncordon`.
+func (h *Handler) Uncordon(name string) error {
+	return h.setUnschedulable(name, false)
+}
+
+// setUnschedulable patches the node spec.unschedulable.
+func (h *Handler) setUnschedulable(name string, unschedulable bool) error {
+	patchData := `{"spec":{"unschedulable":true}}`
+	if !unschedulable {
+		patchData = `{"spec":{"unschedulable":null}}`
+	}
+	_, err := h.clientset.CoreV1().Nodes().
+		Patch(h.ctx, name, types.StrategicMergePatchType, []byte(patchData), h.Options.PatchOptions)
+	return err
+}
+
+// Drain cordons the node and evicts all pods on it, it works like `kubectl drain`.
+//
+// The eviction respects the PodDisruptionBudgets, the pods blocked by them are
+// retried until the timeout, then the returned error wraps pod.ErrEvictionBlocked.
+// The mirror pods are skipped. The DaemonSet-managed pods, the pods not managed
+// by a controller and the pods using emptyDir make Drain fail before any pod is
+// evicted, unless they are allowed by opts. Drain returns after all the evicted
+// pods are deleted.
+func (h *Handler) Drain(name string, opts DrainOptions) error {
+	if err := h.Cordon(name); err != nil {
+		return err
+	}
+	pods, err := h.getPods(&corev1.Node{ObjectMeta: metav1.ObjectMeta{Name: name}})
+	if err != nil {
+		return err
+	}
+	pods, err = podsToEvict(pods, opts)
+	if err != nil {
+		return fmt.Errorf("drain node/%s: %w", name, err)
+	}
+
+	ctx := h.ctx
+	if opts.Timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(h.ctx, opts.Timeout)
+		defer cancel()
+	}
+	// the policy/v1beta1 Eviction is used on the older kubernetes API server.
+	resource, err := utildiscovery.APIResource(h.discoveryClient, evictionGVR)
+	if err != nil {
+		return err
+	}
+	v1beta1 := resource.Group == policyv1beta1.GroupName && resource.Version == policyv1beta1.SchemeGroupVersion.Version
+	for _, p := range pods {
+		if err := h.evictPod(ctx, v1beta1, p, opts.GracePeriodSeconds); err != nil {
+			return fmt.Errorf("drain node/%s: evict pod %s/%s: %w", name, p.Namespace, p.Name, err)
+		}
+	}
+	for _, p := range pods {
+		if err := h.waitPodDeleted(ctx, p); err != nil {
+			return fmt.Errorf("drain node/%s: wait pod %s/%s deleted: %w", name, p.Namespace, p.Name, err)
+		}
+	}
+	return nil
+}
+
+// podsToEvict filters the pods that should be evicted, and returns an error
+// listing all the pods that block the drain.
+func podsToEvict(pods []*corev1.Pod, opts DrainOptions) ([]*corev1.Pod, error) {
+	var (
+		toEvict []*corev1.Pod
+		errs    []string
+	)
+	for _, pod := range pods {
+		// the finished pods can always be deleted.
+		if pod.Status.Phase == corev1.PodSucceeded || pod.Status.Phase == corev1.PodFailed {
+			toEvict = append(toEvict, pod)
+			continue
+		}
+		if _, ok := pod.Annotations[mirrorPodAnnotation]; ok {
+			continue
+		}
+		controller := metav1.GetControllerOf(pod)
+		switch {
+		case controller != nil && controller.Kind == "DaemonSet":
+			if !opts.IgnoreDaemonSets {
+				errs = append(errs, fmt.Sprintf("%s/%s is managed by DaemonSet", pod.Namespace, pod.Name))
+			}
+			continue
+		case controller == nil && !opts.Force:
+			errs = append(errs, fmt.Sprintf("%s/%s is not managed by a controller", pod.Namespace, pod.Name))
+			continue
+		}
+		if hasLocalData(pod) && !opts.DeleteLocalData {
+			errs = append(errs, fmt.Sprintf("%s/%s uses emptyDir", pod.Namespace, pod.Name))
+			continue
+		}
+		toEvict = append(toEvict, pod)
+	}
+	if len(errs) != 0 {
+		return nil, fmt.Errorf("cannot evict pods: %s", strings.Join(errs, ", "))
+	}
+	return toEvict, nil
+}
+
+// hasLocalData returns true if the pod uses emptyDir.
+func hasLocalData(pod *corev1.Pod) bool {
+	for _, volume := range pod.Spec.Volumes {
+		if volume.EmptyDir != nil {
+			return true
+		}
+	}
+	return false
+}
+
+// evictPod evicts the pod through the eviction API, the policy/v1beta1 Eviction
+// is used if v1beta1 is true. It retries if the eviction is blocked by
+// PodDisruptionBudget.
+func (h *Handler) evictPod(ctx context.Context, v1beta1 bool, p *corev1.Pod, gracePeriodSeconds *int64) error {
+	objectMeta := metav1.ObjectMeta{Name: p.Name, Namespace: p.Namespace}
+	deleteOptions := &metav1.DeleteOptions{GracePeriodSeconds: gracePeriodSeconds}
+	for {
+		var err error
+		if v1beta1 {
+			err = h.clientset.PolicyV1beta1().Evictions(p.Namespace).
+				Evict(ctx, &policyv1beta1.Eviction{ObjectMeta: objectMeta, DeleteOptions: deleteOptions})
+		} else {
+			err = h.clientset.PolicyV1().Evictions(p.Namespace).
+				Evict(ctx, &policyv1.Eviction{ObjectMeta: objectMeta, DeleteOptions: deleteOptions})
+		}
+		switch {
+		case err == nil, k8serrors.IsNotFound(err):
+			return nil
+		case !k8serrors.IsTooManyRequests(err):
+			return err
+		}
+		// the eviction is blocked by PodDisruptionBudget, retry it later.
+		select {
+		case <-ctx.Done():
+			return fmt.Errorf("%w: %s", &pod.EvictionBlockedError{Err: err}, ctx.Err().Error())
+		case <-time.After(pollInterval):
+		}
+	}
+}
+
+// waitPodDeleted waits until the pod is deleted, or replaced by a new pod with
+// the same name.
+func (h *Handler) waitPodDeleted(ctx context.Context, pod *corev1.Pod) error {
+	return wait.PollImmediateUntilWithContext(ctx, pollInterval, func(ctx context.Context) (bool, error) {
+		p, err := h.clientset.CoreV1().Pods(pod.Namespace).Get(ctx, pod.Name, metav1.GetOptions{})
+		if k8serrors.IsNotFound(err) {
+			return true, nil
+		}
+		if err != nil {
+			return false, err
+		}
+		return p.UID != pod.UID, nil
+	})
+}
@@ -0,0 +1,184 @@
+package node
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"sort"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/forbearing/k8s/pod"
+	"github.com/forbearing/k8s/types"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/client-go/discovery"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/rest"
+)
+
+// newTestHandler creates a node handler, whose clientset and discovery client
+// send the requests to the fake kubernetes API server serve.
+func newTestHandler(t *testing.T, serve http.HandlerFunc) *Handler {
+	srv := httptest.NewServer(serve)
+	t.Cleanup(srv.Close)
+	config := &rest.Config{Host: srv.URL}
+	clientset, err := kubernetes.NewForConfig(config)
+	if err != nil {
+		t.Fatal(err)
+	}
+	discoveryClient, err := discovery.NewDiscoveryClientForConfig(config)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return &Handler{ctx: context.Background(), clientset: clientset, discoveryClient: discoveryClient, Options: &types.HandlerOptions{}}
+}
+
+func TestCordon(t *testing.T) {
+	tests := []struct {
+		name      string
+		cordon    func(h *Handler) error
+		wantPatch string
+	}{
+		{"cordon", func(h *Handler) error { return h.Cordon("node1") }, `{"spec":{"unschedulable":true}}`},
+		{"uncordon", func(h *Handler) error { return h.Uncordon("node1") }, `{"spec":{"unschedulable":null}}`},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			h := newTestHandler(t, func(w http.ResponseWriter, r *http.Request) {
+				if r.Method != http.MethodPatch || r.URL.Path != "/api/v1/nodes/node1" {
+					t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+				}
+				if contentType := r.Header.Get("Content-Type"); contentType != "application/strategic-merge-patch+json" {
+					t.Errorf("Content-Type = %q, want strategic merge patch", contentType)
+				}
+				if data, _ := io.ReadAll(r.Body); string(data) != test.wantPatch {
+					t.Errorf("patch = %s, want %s", data, test.wantPatch)
+				}
+				w.Header().Set("Content-Type", "application/json")
+				fmt.Fprintln(w, `{"kind":"Node","apiVersion":"v1","metadata":{"name":"node1"}}`)
+			})
+			if err := test.cordon(h); err != nil {
+				t.Fatal(err)
+			}
+		})
+	}
+}
+
+// nodePods is the pods running on node1.
+const nodePods = `{"kind":"PodList","apiVersion":"v1","metadata":{},"items":[
+{"metadata":{"name":"ds-pod","namespace":"kube-system","ownerReferences":[{"apiVersion":"apps/v1","kind":"DaemonSet","name":"ds","uid":"1","controller":true}]}},
+{"metadata":{"name":"mirror-pod","namespace":"kube-system","annotations":{"kubernetes.io/config.mirror":"abc"}}},
+{"metadata":{"name":"rs-pod","namespace":"default","ownerReferences":[{"apiVersion":"apps/v1","kind":"ReplicaSet","name":"rs","uid":"2","controller":true}]}},
+{"metadata":{"name":"done-pod","namespace":"default"},"status":{"phase":"Succeeded"}}
+]}`
+
+func TestDrain(t *testing.T) {
+	tests := []struct {
+		name        string
+		version     string
+		opts        DrainOptions
+		wantEvicted []string
+		wantErr     string
+	}{
+		{"skip DaemonSet pods", "v1", DrainOptions{IgnoreDaemonSets: true}, []string{"default/done-pod", "default/rs-pod"}, ""},
+		{"fallback to policy/v1beta1", "v1beta1", DrainOptions{IgnoreDaemonSets: true}, []string{"default/done-pod", "default/rs-pod"}, ""},
+		{"DaemonSet pods block draining", "v1", DrainOptions{}, nil, "kube-system/ds-pod is managed by DaemonSet"},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var (
+				mu      sync.Mutex
+				evicted []string
+				cordon  bool
+			)
+			h := newTestHandler(t, func(w http.ResponseWriter, r *http.Request) {
+				mu.Lock()
+				defer mu.Unlock()
+				w.Header().Set("Content-Type", "application/json")
+				switch {
+				case r.Method == http.MethodPatch && r.URL.Path == "/api/v1/nodes/node1":
+					cordon = true
+					fmt.Fprintln(w, `{"kind":"Node","apiVersion":"v1","metadata":{"name":"node1"}}`)
+				case r.Method == http.MethodGet && r.URL.Path == "/api/v1/pods":
+					if fieldSelector := r.URL.Query().Get("fieldSelector"); fieldSelector != "spec.nodeName=node1" {
+						t.Errorf("fieldSelector = %q, want spec.nodeName=node1", fieldSelector)
+					}
+					fmt.Fprintln(w, nodePods)
+				case r.Method == http.MethodGet && r.URL.Path == "/api/v1":
+					fmt.Fprintf(w, `{"kind":"APIResourceList","groupVersion":"v1","resources":[`+
+						`{"name":"pods","namespaced":true,"kind":"Pod","verbs":["get"]},`+
+						`{"name":"pods/eviction","namespaced":true,"group":"policy","version":%q,"kind":"Eviction","verbs":["create"]}]}`, test.version)
+				case r.Method == http.MethodPost && strings.HasSuffix(r.URL.Path, "/eviction"):
+					// /api/v1/namespaces/{namespace}/pods/{name}/eviction
+					var eviction struct {
+						APIVersion string `json:"apiVersion"`
+					}
+					if err := json.NewDecoder(r.Body).Decode(&eviction); err != nil {
+						t.Error(err)
+					}
+					if want := "policy/" + test.version; eviction.APIVersion != want {
+						t.Errorf("eviction apiVersion = %q, want %q", eviction.APIVersion, want)
+					}
+					parts := strings.Split(r.URL.Path, "/")
+					evicted = append(evicted, parts[4]+"/"+parts[6])
+					w.WriteHeader(http.StatusCreated)
+					fmt.Fprintln(w, `{"kind":"Status","apiVersion":"v1","status":"Success"}`)
+				case r.Method == http.MethodGet:
+					// the evicted pods are deleted.
+					w.WriteHeader(http.StatusNotFound)
+					fmt.Fprintln(w, `{"kind":"Status","apiVersion":"v1","status":"Failure","reason":"NotFound","code":404}`)
+				default:
+					t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+				}
+			})
+
+			err := h.Drain("node1", test.opts)
+			if test.wantErr == "" && err != nil {
+				t.Fatal(err)
+			}
+			if test.wantErr != "" && (err == nil || !strings.Contains(err.Error(), test.wantErr)) {
+				t.Fatalf("Drain() = %v, want error %q", err, test.wantErr)
+			}
+			if !cordon {
+				t.Error("the node isn't cordoned")
+			}
+			sort.Strings(evicted)
+			if !reflect.DeepEqual(evicted, test.wantEvicted) {
+				t.Errorf("evicted %v, want %v", evicted, test.wantEvicted)
+			}
+		})
+	}
+}
+
+func TestDrainBlocked(t *testing.T) {
+	h := newTestHandler(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case r.Method == http.MethodPatch && r.URL.Path == "/api/v1/nodes/node1":
+			fmt.Fprintln(w, `{"kind":"Node","apiVersion":"v1","metadata":{"name":"node1"}}`)
+		case r.Method == http.MethodGet && r.URL.Path == "/api/v1/pods":
+			fmt.Fprintln(w, nodePods)
+		case r.Method == http.MethodGet && r.URL.Path == "/api/v1":
+			fmt.Fprintln(w, `{"kind":"APIResourceList","groupVersion":"v1","resources":[`+
+				`{"name":"pods/eviction","namespaced":true,"group":"policy","version":"v1","kind":"Eviction","verbs":["create"]}]}`)
+		case r.Method == http.MethodPost && strings.HasSuffix(r.URL.Path, "/eviction"):
+			w.WriteHeader(http.StatusTooManyRequests)
+			fmt.Fprintln(w, `{"kind":"Status","apiVersion":"v1","status":"Failure","reason":"TooManyRequests",`+
+				`"message":"Cannot evict pod as it would violate the pod's disruption budget.","code":429}`)
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+	})
+
+	err := h.Drain("node1", DrainOptions{IgnoreDaemonSets: true, Timeout: 100 * time.Millisecond})
+	if !errors.Is(err, pod.ErrEvictionBlocked) || !k8serrors.IsTooManyRequests(err) {
+		t.Errorf("Drain() = %v, want the eviction blocked by PodDisruptionBudget", err)
+	}
+}
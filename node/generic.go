@@ -0,0 +1,13 @@
+package node
+
+import (
+	"github.com/forbearing/k8s/types"
+	corev1 "k8s.io/api/core/v1"
+)
+
+// Generic returns the handler as a types.Handler, whose methods return
+// runtime.Object instead of *corev1.Node, so it can be used together with
+// the handlers of other resources.
+func (h *Handler) Generic() types.Handler {
+	return types.NewGeneric[*corev1.Node](h, GVK)
+}
@@ -2,7 +2,6 @@ package node
 
 import (
 	"encoding/json"
-	"fmt"
 	"io/ioutil"
 
 	corev1 "k8s.io/api/core/v1"
@@ -73,7 +72,7 @@ func (h *Handler) GetFromBytes(data []byte) (*corev1.Node, error) {
 func (h *Handler) GetFromObject(obj interface{}) (*corev1.Node, error) {
 	node, ok := obj.(*corev1.Node)
 	if !ok {
-		return nil, fmt.Errorf("object type is not *corev1.Node")
+		return nil, ErrInvalidObjectType
 	}
 	return h.getNode(node)
 }
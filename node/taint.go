@@ -0,0 +1,75 @@
+package node
+
+import (
+	"encoding/json"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/util/retry"
+)
+
+// AddTaint adds the taint to the node, it works like `kubectl taint nodes`.
+// A taint is identified by its key and effect, if the node already has a taint
+// with the same key and effect, its value is replaced. It's a no-op if the
+// node already has the same taint.
+func (h *Handler) AddTaint(name string, taint corev1.Taint) error {
+	return h.updateTaints(name, func(taints []corev1.Taint) ([]corev1.Taint, bool) {
+		for i := range taints {
+			if !taints[i].MatchTaint(&taint) {
+				continue
+			}
+			if taints[i].Value == taint.Value {
+				return taints, false
+			}
+			taints[i] = taint
+			return taints, true
+		}
+		return append(taints, taint), true
+	})
+}
+
+// RemoveTaint removes the taint with the key and effect from the node, it works
+// like `kubectl taint nodes name key:effect-`. If the effect is empty, the
+// taints with the key and any effect are removed. It's a no-op if the node
+// doesn't have the taint.
+func (h *Handler) RemoveTaint(name string, key string, effect corev1.TaintEffect) error {
+	return h.updateTaints(name, func(taints []corev1.Taint) ([]corev1.Taint, bool) {
+		var remained []corev1.Taint
+		for _, taint := range taints {
+			if taint.Key == key && (len(effect) == 0 || taint.Effect == effect) {
+				continue
+			}
+			remained = append(remained, taint)
+		}
+		return remained, len(remained) != len(taints)
+	})
+}
+
+// updateTaints gets the node, and merge-patches the node taints returned by
+// mutate if they're changed. The patch carries the node resourceVersion, so
+// the taints changed by others are never overwritten, it retries on conflict.
+func (h *Handler) updateTaints(name string, mutate func(taints []corev1.Taint) ([]corev1.Taint, bool)) error {
+	return retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		node, err := h.clientset.CoreV1().Nodes().Get(h.ctx, name, h.Options.GetOptions)
+		if err != nil {
+			return err
+		}
+		taints, changed := mutate(node.Spec.Taints)
+		if !changed {
+			return nil
+		}
+		if taints == nil {
+			taints = []corev1.Taint{}
+		}
+		patchData, err := json.Marshal(map[string]interface{}{
+			"metadata": map[string]interface{}{"resourceVersion": node.ResourceVersion},
+			"spec":     map[string]interface{}{"taints": taints},
+		})
+		if err != nil {
+			return err
+		}
+		_, err = h.clientset.CoreV1().Nodes().
+			Patch(h.ctx, name, types.MergePatchType, patchData, h.Options.PatchOptions)
+		return err
+	})
+}
@@ -0,0 +1,107 @@
+package node
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"reflect"
+	"strconv"
+	"sync"
+	"testing"
+
+	jsonpatch "github.com/evanphx/json-patch"
+	corev1 "k8s.io/api/core/v1"
+)
+
+// serveNode is a fake kubernetes API server holding node1, it applies the
+// merge patches to the node and counts them.
+type serveNode struct {
+	mu      sync.Mutex
+	node    []byte
+	patches int
+}
+
+func (s *serveNode) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if r.Method == http.MethodPatch {
+		patchData, _ := io.ReadAll(r.Body)
+		node, err := jsonpatch.MergePatch(s.node, patchData)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		// bump the resourceVersion like kubernetes API server does.
+		obj := &corev1.Node{}
+		json.Unmarshal(node, obj)
+		rv, _ := strconv.Atoi(obj.ResourceVersion)
+		obj.ResourceVersion = strconv.Itoa(rv + 1)
+		s.node, _ = json.Marshal(obj)
+		s.patches++
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.Write(s.node)
+}
+
+func (s *serveNode) taints(t *testing.T) []corev1.Taint {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	node := &corev1.Node{}
+	if err := json.Unmarshal(s.node, node); err != nil {
+		t.Fatal(err)
+	}
+	return node.Spec.Taints
+}
+
+func TestAddTaint(t *testing.T) {
+	other := corev1.Taint{Key: "other", Effect: corev1.TaintEffectNoExecute}
+	s := &serveNode{node: []byte(`{"kind":"Node","apiVersion":"v1","metadata":{"name":"node1","resourceVersion":"1"},` +
+		`"spec":{"taints":[{"key":"other","effect":"NoExecute"}]}}`)}
+	h := newTestHandler(t, s.ServeHTTP)
+
+	taint := corev1.Taint{Key: "dedicated", Value: "gpu", Effect: corev1.TaintEffectNoSchedule}
+	for i := 0; i < 2; i++ {
+		if err := h.AddTaint("node1", taint); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if want := []corev1.Taint{other, taint}; !reflect.DeepEqual(s.taints(t), want) {
+		t.Errorf("taints = %v, want %v", s.taints(t), want)
+	}
+	if s.patches != 1 {
+		t.Errorf("patched the node %d times, want 1", s.patches)
+	}
+
+	// the taint with the same key and effect is replaced.
+	taint.Value = "tpu"
+	if err := h.AddTaint("node1", taint); err != nil {
+		t.Fatal(err)
+	}
+	if want := []corev1.Taint{other, taint}; !reflect.DeepEqual(s.taints(t), want) {
+		t.Errorf("taints = %v, want %v", s.taints(t), want)
+	}
+}
+
+func TestRemoveTaint(t *testing.T) {
+	s := &serveNode{node: []byte(`{"kind":"Node","apiVersion":"v1","metadata":{"name":"node1","resourceVersion":"1"},` +
+		`"spec":{"taints":[{"key":"dedicated","value":"gpu","effect":"NoSchedule"}]}}`)}
+	h := newTestHandler(t, s.ServeHTTP)
+
+	// removing a non-existent taint is a no-op.
+	if err := h.RemoveTaint("node1", "dedicated", corev1.TaintEffectNoExecute); err != nil {
+		t.Fatal(err)
+	}
+	if err := h.RemoveTaint("node1", "absent", ""); err != nil {
+		t.Fatal(err)
+	}
+	if s.patches != 0 {
+		t.Errorf("patched the node %d times, want 0", s.patches)
+	}
+
+	if err := h.RemoveTaint("node1", "dedicated", corev1.TaintEffectNoSchedule); err != nil {
+		t.Fatal(err)
+	}
+	if taints := s.taints(t); len(taints) != 0 {
+		t.Errorf("taints = %v, want none", taints)
+	}
+}
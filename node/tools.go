@@ -56,16 +56,51 @@ type NodeInfo struct {
 
 // IsReady check whether the node is ready.
 func (h *Handler) IsReady(name string) bool {
+	ready, err := h.Ready(name)
+	return err == nil && ready
+}
+
+// Ready checks whether the Ready condition of the node is true.
+// Unlike IsReady, the error getting the node is returned.
+func (h *Handler) Ready(name string) (bool, error) {
 	node, err := h.Get(name)
 	if err != nil {
-		return false
+		return false, err
 	}
 	for _, cond := range node.Status.Conditions {
-		if cond.Status == corev1.ConditionTrue && cond.Type == corev1.NodeReady {
-			return true
+		if cond.Type == corev1.NodeReady {
+			return cond.Status == corev1.ConditionTrue, nil
 		}
 	}
-	return false
+	return false, nil
+}
+
+// GetCondition returns the node condition of the condType, eg: corev1.NodeReady,
+// corev1.NodeMemoryPressure. It returns nil if the node doesn't report the
+// condition, and the NotFound error if the node doesn't exist.
+//
+// Unlike IsReady, the error getting the node is returned, so
+// GetCondition(name, corev1.NodeReady) tells a not ready node from a failed request.
+func (h *Handler) GetCondition(name string, condType corev1.NodeConditionType) (*corev1.NodeCondition, error) {
+	node, err := h.Get(name)
+	if err != nil {
+		return nil, err
+	}
+	for i := range node.Status.Conditions {
+		if node.Status.Conditions[i].Type == condType {
+			return &node.Status.Conditions[i], nil
+		}
+	}
+	return nil, nil
+}
+
+// Allocatable returns the resources of the node that are available for scheduling.
+func (h *Handler) Allocatable(name string) (corev1.ResourceList, error) {
+	node, err := h.Get(name)
+	if err != nil {
+		return nil, err
+	}
+	return node.Status.Allocatable, nil
 }
 
 // These are the valid phases of node.
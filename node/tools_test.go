@@ -0,0 +1,66 @@
+package node
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
+)
+
+func TestNodeStatus(t *testing.T) {
+	h := newTestHandler(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if r.URL.Path != "/api/v1/nodes/node1" {
+			w.WriteHeader(http.StatusNotFound)
+			fmt.Fprintln(w, `{"kind":"Status","apiVersion":"v1","status":"Failure","reason":"NotFound","code":404}`)
+			return
+		}
+		fmt.Fprintln(w, `{"kind":"Node","apiVersion":"v1","metadata":{"name":"node1"},"status":{`+
+			`"conditions":[{"type":"MemoryPressure","status":"True","reason":"KubeletHasInsufficientMemory"},{"type":"Ready","status":"True"}],`+
+			`"allocatable":{"cpu":"3800m","memory":"7Gi"}}}`)
+	})
+
+	if !h.IsReady("node1") {
+		t.Error("node1 is not ready")
+	}
+	if ready, err := h.Ready("node1"); !ready || err != nil {
+		t.Errorf("Ready(node1) = %v, %v, want true", ready, err)
+	}
+	cond, err := h.GetCondition("node1", corev1.NodeMemoryPressure)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if cond == nil || cond.Status != corev1.ConditionTrue || cond.Reason != "KubeletHasInsufficientMemory" {
+		t.Errorf("MemoryPressure condition = %+v", cond)
+	}
+	if cond, err := h.GetCondition("node1", corev1.NodeDiskPressure); cond != nil || err != nil {
+		t.Errorf("DiskPressure condition = %+v, %v, want nil", cond, err)
+	}
+	allocatable, err := h.Allocatable("node1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if cpu := allocatable[corev1.ResourceCPU]; cpu.Cmp(resource.MustParse("3.8")) != 0 {
+		t.Errorf("allocatable cpu = %s, want 3800m", cpu.String())
+	}
+	if memory := allocatable[corev1.ResourceMemory]; memory.Cmp(resource.MustParse("7Gi")) != 0 {
+		t.Errorf("allocatable memory = %s, want 7Gi", memory.String())
+	}
+
+	// the node doesn't exist.
+	if h.IsReady("node2") {
+		t.Error("node2 is ready")
+	}
+	if ready, err := h.Ready("node2"); ready || !k8serrors.IsNotFound(err) {
+		t.Errorf("Ready(node2) = %v, %v, want NotFound", ready, err)
+	}
+	if _, err := h.GetCondition("node2", corev1.NodeReady); !k8serrors.IsNotFound(err) {
+		t.Errorf("GetCondition(node2) = %v, want NotFound", err)
+	}
+	if _, err := h.Allocatable("node2"); !k8serrors.IsNotFound(err) {
+		t.Errorf("Allocatable(node2) = %v, want NotFound", err)
+	}
+}
@@ -1,5 +1,7 @@
 package k8s
 
+import utilerrors "github.com/forbearing/k8s/util/errors"
+
 type Options int
 
 const (
@@ -8,3 +10,18 @@ const (
 	IgnoreInvalid
 	IgnoreTimeout
 )
+
+// ignoreErrors ignores the errors specified by opts.
+func ignoreErrors(err error, opts ...Options) error {
+	for _, opt := range opts {
+		switch opt {
+		case IgnoreAlreadyExists:
+			err = utilerrors.IgnoreAlreadyExists(err)
+		case IgnoreNotFound:
+			err = utilerrors.IgnoreNotFound(err)
+		case IgnoreInvalid:
+			err = utilerrors.IgnoreInvalid(err)
+		}
+	}
+	return err
+}
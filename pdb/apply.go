@@ -0,0 +1,91 @@
+package pdb
+
+import (
+	policyv1 "k8s.io/api/policy/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+// Apply applies pdb from type string, []byte, *policyv1.PodDisruptionBudget,
+// policyv1.PodDisruptionBudget, metav1.Object, runtime.Object, *unstructured.Unstructured,
+// unstructured.Unstructured or map[string]interface{}.
+func (h *Handler) Apply(obj interface{}) (*policyv1.PodDisruptionBudget, error) {
+	switch val := obj.(type) {
+	case string:
+		return h.ApplyFromFile(val)
+	case []byte:
+		return h.ApplyFromBytes(val)
+	case *policyv1.PodDisruptionBudget:
+		return h.ApplyFromObject(val)
+	case policyv1.PodDisruptionBudget:
+		return h.ApplyFromObject(&val)
+	case *unstructured.Unstructured:
+		return h.ApplyFromUnstructured(val)
+	case unstructured.Unstructured:
+		return h.ApplyFromUnstructured(&val)
+	case map[string]interface{}:
+		return h.ApplyFromMap(val)
+	case metav1.Object, runtime.Object:
+		return h.ApplyFromObject(val)
+	default:
+		return nil, ErrInvalidApplyType
+	}
+}
+
+// ApplyFromFile applies pdb from yaml or json file.
+func (h *Handler) ApplyFromFile(filename string) (pdb *policyv1.PodDisruptionBudget, err error) {
+	pdb, err = h.CreateFromFile(filename)
+	if k8serrors.IsAlreadyExists(err) { // if pdb already exist, update it.
+		pdb, err = h.UpdateFromFile(filename)
+	}
+	return
+}
+
+// ApplyFromBytes pply pdb from bytes data.
+func (h *Handler) ApplyFromBytes(data []byte) (pdb *policyv1.PodDisruptionBudget, err error) {
+	pdb, err = h.CreateFromBytes(data)
+	if k8serrors.IsAlreadyExists(err) {
+		pdb, err = h.UpdateFromBytes(data)
+	}
+	return
+}
+
+// ApplyFromObject applies pdb from metav1.Object or runtime.Object.
+func (h *Handler) ApplyFromObject(obj interface{}) (*policyv1.PodDisruptionBudget, error) {
+	pdb, ok := obj.(*policyv1.PodDisruptionBudget)
+	if !ok {
+		return nil, ErrInvalidObjectType
+	}
+	return h.applyPDB(pdb)
+}
+
+// ApplyFromUnstructured applies pdb from *unstructured.Unstructured.
+func (h *Handler) ApplyFromUnstructured(u *unstructured.Unstructured) (*policyv1.PodDisruptionBudget, error) {
+	pdb := &policyv1.PodDisruptionBudget{}
+	err := runtime.DefaultUnstructuredConverter.FromUnstructured(u.UnstructuredContent(), pdb)
+	if err != nil {
+		return nil, err
+	}
+	return h.applyPDB(pdb)
+}
+
+// ApplyFromMap applies pdb from map[string]interface{}.
+func (h *Handler) ApplyFromMap(u map[string]interface{}) (*policyv1.PodDisruptionBudget, error) {
+	pdb := &policyv1.PodDisruptionBudget{}
+	err := runtime.DefaultUnstructuredConverter.FromUnstructured(u, pdb)
+	if err != nil {
+		return nil, err
+	}
+	return h.applyPDB(pdb)
+}
+
+// applyPDB
+func (h *Handler) applyPDB(pdb *policyv1.PodDisruptionBudget) (*policyv1.PodDisruptionBudget, error) {
+	_, err := h.createPDB(pdb)
+	if k8serrors.IsAlreadyExists(err) {
+		return h.updatePDB(pdb)
+	}
+	return pdb, err
+}
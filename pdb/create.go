@@ -0,0 +1,112 @@
+package pdb
+
+import (
+	"encoding/json"
+	"io/ioutil"
+
+	policyv1 "k8s.io/api/policy/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/yaml"
+)
+
+// Create creates pdb from type string, []byte, *policyv1.PodDisruptionBudget,
+// policyv1.PodDisruptionBudget, metav1.Object, runtime.Object, *unstructured.Unstructured,
+// unstructured.Unstructured or map[string]interface{}.
+func (h *Handler) Create(obj interface{}) (*policyv1.PodDisruptionBudget, error) {
+	switch val := obj.(type) {
+	case string:
+		return h.CreateFromFile(val)
+	case []byte:
+		return h.CreateFromBytes(val)
+	case *policyv1.PodDisruptionBudget:
+		return h.CreateFromObject(val)
+	case policyv1.PodDisruptionBudget:
+		return h.CreateFromObject(&val)
+	case *unstructured.Unstructured:
+		return h.CreateFromUnstructured(val)
+	case unstructured.Unstructured:
+		return h.CreateFromUnstructured(&val)
+	case map[string]interface{}:
+		return h.CreateFromMap(val)
+	case metav1.Object, runtime.Object:
+		return h.CreateFromObject(val)
+	default:
+		return nil, ErrInvalidCreateType
+	}
+}
+
+// CreateFromFile creates pdb from yaml or json file.
+func (h *Handler) CreateFromFile(filename string) (*policyv1.PodDisruptionBudget, error) {
+	data, err := ioutil.ReadFile(filename)
+	if err != nil {
+		return nil, err
+	}
+	return h.CreateFromBytes(data)
+}
+
+// CreateFromBytes creates pdb from bytes data.
+func (h *Handler) CreateFromBytes(data []byte) (*policyv1.PodDisruptionBudget, error) {
+	pdbJson, err := yaml.ToJSON(data)
+	if err != nil {
+		return nil, err
+	}
+
+	pdb := &policyv1.PodDisruptionBudget{}
+	if err = json.Unmarshal(pdbJson, pdb); err != nil {
+		return nil, err
+	}
+	return h.createPDB(pdb)
+}
+
+// CreateFromObject creates pdb from metav1.Object or runtime.Object.
+func (h *Handler) CreateFromObject(obj interface{}) (*policyv1.PodDisruptionBudget, error) {
+	pdb, ok := obj.(*policyv1.PodDisruptionBudget)
+	if !ok {
+		return nil, ErrInvalidObjectType
+	}
+	return h.createPDB(pdb)
+}
+
+// CreateFromUnstructured creates pdb from *unstructured.Unstructured.
+func (h *Handler) CreateFromUnstructured(u *unstructured.Unstructured) (*policyv1.PodDisruptionBudget, error) {
+	pdb := &policyv1.PodDisruptionBudget{}
+	err := runtime.DefaultUnstructuredConverter.FromUnstructured(u.UnstructuredContent(), pdb)
+	if err != nil {
+		return nil, err
+	}
+	return h.createPDB(pdb)
+}
+
+// CreateFromMap creates pdb from map[string]interface{}.
+func (h *Handler) CreateFromMap(u map[string]interface{}) (*policyv1.PodDisruptionBudget, error) {
+	pdb := &policyv1.PodDisruptionBudget{}
+	err := runtime.DefaultUnstructuredConverter.FromUnstructured(u, pdb)
+	if err != nil {
+		return nil, err
+	}
+	return h.createPDB(pdb)
+}
+
+// createPDB
+func (h *Handler) createPDB(pdb *policyv1.PodDisruptionBudget) (*policyv1.PodDisruptionBudget, error) {
+	namespace := pdb.GetNamespace()
+	if len(namespace) == 0 {
+		namespace = h.namespace
+	}
+	pdb.ResourceVersion = ""
+	pdb.UID = ""
+	useV1beta1, err := h.useV1beta1()
+	if err != nil {
+		return nil, err
+	}
+	if !useV1beta1 {
+		return h.clientset.PolicyV1().PodDisruptionBudgets(namespace).Create(h.ctx, pdb, h.Options.CreateOptions)
+	}
+	pdbV1beta1, err := h.clientset.PolicyV1beta1().PodDisruptionBudgets(namespace).Create(h.ctx, toV1beta1(pdb), h.Options.CreateOptions)
+	if err != nil {
+		return nil, err
+	}
+	return fromV1beta1(pdbV1beta1), nil
+}
@@ -0,0 +1,114 @@
+package pdb
+
+import (
+	"encoding/json"
+	"io/ioutil"
+
+	policyv1 "k8s.io/api/policy/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/yaml"
+)
+
+// Delete deletes pdb from type string, []byte, *policyv1.PodDisruptionBudget,
+// policyv1.PodDisruptionBudget, metav1.Object, runtime.Object, *unstructured.Unstructured,
+// unstructured.Unstructured or map[string]interface{}.
+//
+// If passed parameter type is string, it will simply call DeleteByName instead of DeleteFromFile.
+// You should always explicitly call DeleteFromFile to delete a pdb from file path.
+func (h *Handler) Delete(obj interface{}) error {
+	switch val := obj.(type) {
+	case string:
+		return h.DeleteByName(val)
+	case []byte:
+		return h.DeleteFromBytes(val)
+	case *policyv1.PodDisruptionBudget:
+		return h.DeleteFromObject(val)
+	case policyv1.PodDisruptionBudget:
+		return h.DeleteFromObject(&val)
+	case *unstructured.Unstructured:
+		return h.DeleteFromUnstructured(val)
+	case unstructured.Unstructured:
+		return h.DeleteFromUnstructured(&val)
+	case map[string]interface{}:
+		return h.DeleteFromMap(val)
+	case metav1.Object, runtime.Object:
+		return h.DeleteFromObject(val)
+	default:
+		return ErrInvalidDeleteType
+	}
+}
+
+// DeleteByName deletes pdb by name.
+func (h *Handler) DeleteByName(name string) error {
+	return h.deletePDB(&policyv1.PodDisruptionBudget{ObjectMeta: metav1.ObjectMeta{Name: name}})
+}
+
+// DeleteFromFile deletes pdb from yaml or json file.
+func (h *Handler) DeleteFromFile(filename string) error {
+	data, err := ioutil.ReadFile(filename)
+	if err != nil {
+		return err
+	}
+	return h.DeleteFromBytes(data)
+}
+
+// DeleteFromBytes deletes pdb from bytes data.
+func (h *Handler) DeleteFromBytes(data []byte) error {
+	pdbJson, err := yaml.ToJSON(data)
+	if err != nil {
+		return err
+	}
+
+	pdb := &policyv1.PodDisruptionBudget{}
+	if err = json.Unmarshal(pdbJson, pdb); err != nil {
+		return err
+	}
+	return h.deletePDB(pdb)
+}
+
+// DeleteFromObject deletes pdb from metav1.Object or runtime.Object.
+func (h *Handler) DeleteFromObject(obj interface{}) error {
+	pdb, ok := obj.(*policyv1.PodDisruptionBudget)
+	if !ok {
+		return ErrInvalidObjectType
+	}
+	return h.deletePDB(pdb)
+}
+
+// DeleteFromUnstructured deletes pdb from *unstructured.Unstructured.
+func (h *Handler) DeleteFromUnstructured(u *unstructured.Unstructured) error {
+	pdb := &policyv1.PodDisruptionBudget{}
+	err := runtime.DefaultUnstructuredConverter.FromUnstructured(u.UnstructuredContent(), pdb)
+	if err != nil {
+		return err
+	}
+	return h.deletePDB(pdb)
+}
+
+// DeleteFromMap deletes pdb from map[string]interface{}.
+func (h *Handler) DeleteFromMap(u map[string]interface{}) error {
+	pdb := &policyv1.PodDisruptionBudget{}
+	err := runtime.DefaultUnstructuredConverter.FromUnstructured(u, pdb)
+	if err != nil {
+		return err
+	}
+	return h.deletePDB(pdb)
+}
+
+// deletePDB
+func (h *Handler) deletePDB(pdb *policyv1.PodDisruptionBudget) error {
+	namespace := pdb.GetNamespace()
+	if len(namespace) == 0 {
+		namespace = h.namespace
+	}
+	useV1beta1, err := h.useV1beta1()
+	if err != nil {
+		return err
+	}
+	if useV1beta1 {
+		return h.clientset.PolicyV1beta1().PodDisruptionBudgets(namespace).Delete(h.ctx, pdb.Name, h.Options.DeleteOptions)
+	}
+	return h.clientset.PolicyV1().PodDisruptionBudgets(namespace).Delete(h.ctx, pdb.Name, h.Options.DeleteOptions)
+}
@@ -0,0 +1,13 @@
+package pdb
+
+import (
+	"github.com/forbearing/k8s/types"
+	policyv1 "k8s.io/api/policy/v1"
+)
+
+// Generic returns the handler as a types.Handler, whose methods return
+// runtime.Object instead of *policyv1.PodDisruptionBudget, so it can be used together with
+// the handlers of other resources.
+func (h *Handler) Generic() types.Handler {
+	return types.NewGeneric[*policyv1.PodDisruptionBudget](h, GVK)
+}
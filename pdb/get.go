@@ -0,0 +1,120 @@
+package pdb
+
+import (
+	"encoding/json"
+	"io/ioutil"
+
+	policyv1 "k8s.io/api/policy/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/yaml"
+)
+
+// Get gets pdb from type string, []byte, *policyv1.PodDisruptionBudget,
+// policyv1.PodDisruptionBudget, metav1.Object, runtime.Object, *unstructured.Unstructured,
+// unstructured.Unstructured or map[string]interface{}.
+//
+// If passed parameter type is string, it will simply call GetByName instead of GetFromFile.
+// You should always explicitly call GetFromFile to get a pdb from file path.
+func (h *Handler) Get(obj interface{}) (*policyv1.PodDisruptionBudget, error) {
+	switch val := obj.(type) {
+	case string:
+		return h.GetByName(val)
+	case []byte:
+		return h.GetFromBytes(val)
+	case *policyv1.PodDisruptionBudget:
+		return h.GetFromObject(val)
+	case policyv1.PodDisruptionBudget:
+		return h.GetFromObject(&val)
+	case *unstructured.Unstructured:
+		return h.GetFromUnstructured(val)
+	case unstructured.Unstructured:
+		return h.GetFromUnstructured(&val)
+	case map[string]interface{}:
+		return h.GetFromMap(val)
+	case metav1.Object, runtime.Object:
+		return h.GetFromObject(val)
+	default:
+		return nil, ErrInvalidGetType
+	}
+}
+
+// GetByName gets pdb by name.
+func (h *Handler) GetByName(name string) (*policyv1.PodDisruptionBudget, error) {
+	return h.getPDB(&policyv1.PodDisruptionBudget{ObjectMeta: metav1.ObjectMeta{Name: name}})
+}
+
+// GetFromFile gets pdb from yaml or json file.
+func (h *Handler) GetFromFile(filename string) (*policyv1.PodDisruptionBudget, error) {
+	data, err := ioutil.ReadFile(filename)
+	if err != nil {
+		return nil, err
+	}
+	return h.GetFromBytes(data)
+}
+
+// GetFromBytes gets pdb from bytes data.
+func (h *Handler) GetFromBytes(data []byte) (*policyv1.PodDisruptionBudget, error) {
+	pdbJson, err := yaml.ToJSON(data)
+	if err != nil {
+		return nil, err
+	}
+
+	pdb := &policyv1.PodDisruptionBudget{}
+	if err = json.Unmarshal(pdbJson, pdb); err != nil {
+		return nil, err
+	}
+	return h.getPDB(pdb)
+}
+
+// GetFromObject gets pdb from metav1.Object or runtime.Object.
+func (h *Handler) GetFromObject(obj interface{}) (*policyv1.PodDisruptionBudget, error) {
+	pdb, ok := obj.(*policyv1.PodDisruptionBudget)
+	if !ok {
+		return nil, ErrInvalidObjectType
+	}
+	return h.getPDB(pdb)
+}
+
+// GetFromUnstructured gets pdb from *unstructured.Unstructured.
+func (h *Handler) GetFromUnstructured(u *unstructured.Unstructured) (*policyv1.PodDisruptionBudget, error) {
+	pdb := &policyv1.PodDisruptionBudget{}
+	err := runtime.DefaultUnstructuredConverter.FromUnstructured(u.UnstructuredContent(), pdb)
+	if err != nil {
+		return nil, err
+	}
+	return h.getPDB(pdb)
+}
+
+// GetFromMap gets pdb from map[string]interface{}.
+func (h *Handler) GetFromMap(u map[string]interface{}) (*policyv1.PodDisruptionBudget, error) {
+	pdb := &policyv1.PodDisruptionBudget{}
+	err := runtime.DefaultUnstructuredConverter.FromUnstructured(u, pdb)
+	if err != nil {
+		return nil, err
+	}
+	return h.getPDB(pdb)
+}
+
+// getPDB
+// It's necessary to get a new pdb resource from a old pdb resource,
+// because old pdb usually don't have pdb.Status field.
+func (h *Handler) getPDB(pdb *policyv1.PodDisruptionBudget) (*policyv1.PodDisruptionBudget, error) {
+	namespace := pdb.GetNamespace()
+	if len(namespace) == 0 {
+		namespace = h.namespace
+	}
+	useV1beta1, err := h.useV1beta1()
+	if err != nil {
+		return nil, err
+	}
+	if !useV1beta1 {
+		return h.clientset.PolicyV1().PodDisruptionBudgets(namespace).Get(h.ctx, pdb.Name, h.Options.GetOptions)
+	}
+	pdbV1beta1, err := h.clientset.PolicyV1beta1().PodDisruptionBudgets(namespace).Get(h.ctx, pdb.Name, h.Options.GetOptions)
+	if err != nil {
+		return nil, err
+	}
+	return fromV1beta1(pdbV1beta1), nil
+}
@@ -0,0 +1,80 @@
+package pdb
+
+import (
+	policyv1 "k8s.io/api/policy/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/fields"
+)
+
+// List list all pdbs in the k8s cluster, it simply call `ListAll`.
+func (h *Handler) List() ([]*policyv1.PodDisruptionBudget, error) {
+	return h.ListAll()
+}
+
+// ListByLabel list pdbs by labels.
+// Multiple labels separated by comma(",") eg: "name=myapp,role=devops",
+// and there is an "And" relationship between multiple labels.
+func (h *Handler) ListByLabel(labels string) ([]*policyv1.PodDisruptionBudget, error) {
+	listOptions := h.Options.ListOptions.DeepCopy()
+	listOptions.LabelSelector = labels
+	pdbList, err := h.listPDB(*listOptions)
+	if err != nil {
+		return nil, err
+	}
+	return extractList(pdbList), nil
+}
+
+// ListByField list pdbs by field, work like `kubectl get xxx --field-selector=xxx`.
+func (h *Handler) ListByField(field string) ([]*policyv1.PodDisruptionBudget, error) {
+	fieldSelector, err := fields.ParseSelector(field)
+	if err != nil {
+		return nil, err
+	}
+	listOptions := h.Options.ListOptions.DeepCopy()
+	listOptions.FieldSelector = fieldSelector.String()
+
+	pdbList, err := h.listPDB(*listOptions)
+	if err != nil {
+		return nil, err
+	}
+	return extractList(pdbList), nil
+}
+
+// ListByNamespace list all pdbs in the specified namespace.
+func (h *Handler) ListByNamespace(namespace string) ([]*policyv1.PodDisruptionBudget, error) {
+	return h.WithNamespace(namespace).ListByLabel("")
+}
+
+// ListAll list all pdbs in the k8s cluster.
+func (h *Handler) ListAll() ([]*policyv1.PodDisruptionBudget, error) {
+	return h.WithNamespace(metav1.NamespaceAll).ListByLabel("")
+}
+
+// listPDB lists the pdbs in the handler namespace.
+func (h *Handler) listPDB(listOptions metav1.ListOptions) (*policyv1.PodDisruptionBudgetList, error) {
+	useV1beta1, err := h.useV1beta1()
+	if err != nil {
+		return nil, err
+	}
+	if !useV1beta1 {
+		return h.clientset.PolicyV1().PodDisruptionBudgets(h.namespace).List(h.ctx, listOptions)
+	}
+	pdbListV1beta1, err := h.clientset.PolicyV1beta1().PodDisruptionBudgets(h.namespace).List(h.ctx, listOptions)
+	if err != nil {
+		return nil, err
+	}
+	pdbList := &policyv1.PodDisruptionBudgetList{ListMeta: pdbListV1beta1.ListMeta}
+	for i := range pdbListV1beta1.Items {
+		pdbList.Items = append(pdbList.Items, *fromV1beta1(&pdbListV1beta1.Items[i]))
+	}
+	return pdbList, nil
+}
+
+// extractList
+func extractList(pdbList *policyv1.PodDisruptionBudgetList) []*policyv1.PodDisruptionBudget {
+	var objList []*policyv1.PodDisruptionBudget
+	for i := range pdbList.Items {
+		objList = append(objList, &pdbList.Items[i])
+	}
+	return objList
+}
@@ -0,0 +1,209 @@
+package pdb
+
+import (
+	"encoding/json"
+	"os"
+
+	jsonpatch "github.com/evanphx/json-patch"
+	policyv1 "k8s.io/api/policy/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/strategicpatch"
+	"k8s.io/apimachinery/pkg/util/yaml"
+)
+
+// Patch use the default patch type(Strategic Merge Patch) to patch pdb.
+// Supported patch types are: "StrategicMergePatchType", "MergePatchType", "JSONPatchType".
+//
+// For further more Strategic Merge patch, see:
+//     https://kubernetes.io/docs/tasks/manage-kubernetes-objects/update-api-object-kubectl-patch/#before-you-begin
+// For a comparison of JSON patch and JSON merge patch, see:
+//     https://erosb.github.io/post/json-patch-vs-merge-patch/
+func (h *Handler) Patch(original *policyv1.PodDisruptionBudget, patch interface{}, patchOptions ...types.PatchType) (*policyv1.PodDisruptionBudget, error) {
+	switch val := patch.(type) {
+	case string:
+		var err error
+		var patchData []byte
+		var jsonData []byte
+
+		if patchData, err = os.ReadFile(val); err != nil {
+			return nil, err
+		}
+		if jsonData, err = yaml.ToJSON(patchData); err != nil {
+			return nil, err
+		}
+		if len(patchOptions) != 0 && patchOptions[0] == types.JSONPatchType {
+			return h.jsonPatch(original, jsonData)
+		}
+		if len(patchOptions) != 0 && patchOptions[0] == types.MergePatchType {
+			return h.jsonMergePatch(original, jsonData)
+		}
+		return h.strategicMergePatch(original, jsonData)
+
+	case []byte:
+		var err error
+		var jsonData []byte
+
+		if jsonData, err = yaml.ToJSON(val); err != nil {
+			return nil, err
+		}
+		if len(patchOptions) != 0 && patchOptions[0] == types.JSONPatchType {
+			return h.jsonPatch(original, jsonData)
+		}
+		if len(patchOptions) != 0 && patchOptions[0] == types.MergePatchType {
+			return h.jsonMergePatch(original, jsonData)
+		}
+		return h.strategicMergePatch(original, jsonData)
+
+	case *policyv1.PodDisruptionBudget:
+		return h.diffMergePatch(original, val, patchOptions...)
+
+	case policyv1.PodDisruptionBudget:
+		return h.diffMergePatch(original, &val, patchOptions...)
+
+	case map[string]interface{}:
+		modified := &policyv1.PodDisruptionBudget{}
+		if err := runtime.DefaultUnstructuredConverter.FromUnstructured(val, modified); err != nil {
+			return nil, err
+		}
+		return h.diffMergePatch(original, modified, patchOptions...)
+
+	case *unstructured.Unstructured:
+		modified := &policyv1.PodDisruptionBudget{}
+		if err := runtime.DefaultUnstructuredConverter.FromUnstructured(val.UnstructuredContent(), modified); err != nil {
+			return nil, err
+		}
+		return h.diffMergePatch(original, modified, patchOptions...)
+
+	case unstructured.Unstructured:
+		modified := &policyv1.PodDisruptionBudget{}
+		if err := runtime.DefaultUnstructuredConverter.FromUnstructured(val.UnstructuredContent(), modified); err != nil {
+			return nil, err
+		}
+		return h.diffMergePatch(original, modified, patchOptions...)
+
+	case metav1.Object, runtime.Object:
+		modified, ok := patch.(*policyv1.PodDisruptionBudget)
+		if !ok {
+			return nil, ErrInvalidObjectType
+		}
+		return h.diffMergePatch(original, modified, patchOptions...)
+
+	default:
+		return nil, ErrInvalidPatchType
+	}
+}
+
+// strategicMergePatch use the "Strategic Merge Patch" patch type to patch pdb.
+//
+// Notice that the patch did not replace the containers list. Instead it added
+// a new Container to the list. In other words, the list in the patch was merged
+// with the existing list.
+//
+// This is not always what happens when you use a strategic merge patch on a list.
+// In some cases, the list is replaced, not merged.
+//
+// Note: Strategic merge patch is not supported for custom resources.
+// For further more Strategic Merge patch, see:
+//     https://kubernetes.io/docs/tasks/manage-kubernetes-objects/update-api-object-kubectl-patch/#before-you-begin
+func (h *Handler) strategicMergePatch(original *policyv1.PodDisruptionBudget, patchData []byte) (*policyv1.PodDisruptionBudget, error) {
+	if len(patchData) == 0 || string(patchData) == "{}" {
+		return original, nil
+	}
+
+	return h.patch(original, types.StrategicMergePatchType, patchData)
+}
+
+// jsonMergePatch use the "JSON Merge Patch" patch type to patch pdb.
+// A JSON merge patch is different from strategic merge patch, With a JSON merge patch,
+// If you want to update a list, you have to specify the entire new list.
+// And the new list completely replicas the existing list.
+//
+// For a comparison of JSON patch and JSON merge patch, see:
+//     https://erosb.github.io/post/json-patch-vs-merge-patch/
+// For further more Json Patch see:
+//     https://kubernetes.io/docs/tasks/manage-kubernetes-objects/update-api-object-kubectl-patch/#before-you-begin
+//     https://tools.ietf.org/html/rfc6902
+func (h *Handler) jsonMergePatch(original *policyv1.PodDisruptionBudget, patchData []byte) (*policyv1.PodDisruptionBudget, error) {
+	if len(patchData) == 0 || string(patchData) == "{}" {
+		return original, nil
+	}
+
+	return h.patch(original, types.MergePatchType, patchData)
+}
+
+// jsonPatch use "JSON Patch" patch type to patch pdb.
+//
+// For a comparison of JSON patch and JSON merge patch, see:
+//     https://erosb.github.io/post/json-patch-vs-merge-patch/
+// For further more Json Merge Patch see:
+//     https://kubernetes.io/docs/tasks/manage-kubernetes-objects/update-api-object-kubectl-patch/#before-you-begin
+//     https://tools.ietf.org/html/rfc7386
+func (h *Handler) jsonPatch(original *policyv1.PodDisruptionBudget, patchData []byte) (*policyv1.PodDisruptionBudget, error) {
+	return h.patch(original, types.JSONPatchType, patchData)
+}
+
+// diffMergePatch will tak the difference data between original and modified pdb object,
+// and use the default patch type(Strategic Merge Patch) patch the differen pdb.
+// You can set patchOptions to MergePatchType to use the "JSON Merge Patch" to
+// patch pdb.
+func (h *Handler) diffMergePatch(original, modified *policyv1.PodDisruptionBudget, patchOptions ...types.PatchType) (*policyv1.PodDisruptionBudget, error) {
+	var (
+		err          error
+		originalJson []byte
+		modifiedJson []byte
+		patchData    []byte
+	)
+
+	if originalJson, err = json.Marshal(original); err != nil {
+		return nil, err
+	}
+	if modifiedJson, err = json.Marshal(modified); err != nil {
+		return nil, err
+	}
+	// the patch data of "JSON Merge Patch" must contain the entire new list,
+	// the patch data created by strategic merge patch only contains the
+	// changed list items, which would drop the other list items.
+	if len(patchOptions) != 0 && patchOptions[0] == types.MergePatchType {
+		patchData, err = jsonpatch.CreateMergePatch(originalJson, modifiedJson)
+	} else {
+		patchData, err = strategicpatch.CreateTwoWayMergePatch(originalJson, modifiedJson, policyv1.PodDisruptionBudget{})
+	}
+	if err != nil {
+		return nil, err
+	}
+	if len(patchData) == 0 || string(patchData) == "{}" {
+		return original, nil
+	}
+
+	if len(patchOptions) != 0 && patchOptions[0] == types.MergePatchType {
+		return h.patch(original, types.MergePatchType, patchData)
+	}
+	return h.patch(original, types.StrategicMergePatchType, patchData)
+}
+
+// patch sends the patch data to the kubernetes apiserver. The pdb schema of
+// policy/v1beta1 is the same as policy/v1, so if the apiserver only serves
+// policy/v1beta1, the patch data is sent to policy/v1beta1 as it is.
+func (h *Handler) patch(original *policyv1.PodDisruptionBudget, patchType types.PatchType, patchData []byte) (*policyv1.PodDisruptionBudget, error) {
+	useV1beta1, err := h.useV1beta1()
+	if err != nil {
+		return nil, err
+	}
+	namespace := original.GetNamespace()
+	if len(namespace) == 0 {
+		namespace = h.namespace
+	}
+	if !useV1beta1 {
+		return h.clientset.PolicyV1().PodDisruptionBudgets(namespace).
+			Patch(h.ctx, original.Name, patchType, patchData, h.Options.PatchOptions)
+	}
+	pdbV1beta1, err := h.clientset.PolicyV1beta1().PodDisruptionBudgets(namespace).
+		Patch(h.ctx, original.Name, patchType, patchData, h.Options.PatchOptions)
+	if err != nil {
+		return nil, err
+	}
+	return fromV1beta1(pdbV1beta1), nil
+}
@@ -0,0 +1,35 @@
+package pdb
+
+import (
+	"time"
+
+	policyv1 "k8s.io/api/policy/v1"
+)
+
+// GetAge get the pdb age.
+func (h *Handler) GetAge(object interface{}) (time.Duration, error) {
+	switch val := object.(type) {
+	case string:
+		pdb, err := h.Get(val)
+		if err != nil {
+			return time.Duration(0), err
+		}
+		return time.Now().Sub(pdb.CreationTimestamp.Time), nil
+	case *policyv1.PodDisruptionBudget:
+		return time.Now().Sub(val.CreationTimestamp.Time), nil
+	case policyv1.PodDisruptionBudget:
+		return time.Now().Sub(val.CreationTimestamp.Time), nil
+	default:
+		return time.Duration(0), ErrInvalidToolsType
+	}
+}
+
+// AllowedDisruptions returns the number of pod disruptions that are currently
+// allowed by the pdb, it's read from the pdb status.disruptionsAllowed.
+func (h *Handler) AllowedDisruptions(name string) (int32, error) {
+	pdb, err := h.Get(name)
+	if err != nil {
+		return 0, err
+	}
+	return pdb.Status.DisruptionsAllowed, nil
+}
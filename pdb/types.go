@@ -0,0 +1,14 @@
+package pdb
+
+import "errors"
+
+var (
+	ErrInvalidToolsType  = errors.New("type must be string, *policyv1.PodDisruptionBudget, policyv1.PodDisruptionBudget, metav1.Object or runtime.Object")
+	ErrInvalidCreateType = errors.New("type must be string, []byte, *policyv1.PodDisruptionBudget, policyv1.PodDisruptionBudget, metav1.Object, runtime.Object, *unstructured.Unstructured, unstructured.Unstructured or map[string]interface{}")
+	ErrInvalidUpdateType = ErrInvalidCreateType
+	ErrInvalidApplyType  = ErrInvalidCreateType
+	ErrInvalidDeleteType = ErrInvalidCreateType
+	ErrInvalidGetType    = ErrInvalidCreateType
+	ErrInvalidPatchType  = errors.New("patch data type must be string, []byte, *policyv1.PodDisruptionBudget, policyv1.PodDisruptionBudget, metav1.Object, runtime.Object, *unstructured.Unstructured, unstructured.Unstructured or map[string]interface{}")
+	ErrInvalidObjectType = errors.New("object type is not *policyv1.PodDisruptionBudget")
+)
@@ -0,0 +1,112 @@
+package pdb
+
+import (
+	"encoding/json"
+	"io/ioutil"
+
+	policyv1 "k8s.io/api/policy/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/yaml"
+)
+
+// Update updates pdb from type string, []byte, *policyv1.PodDisruptionBudget,
+// policyv1.PodDisruptionBudget, metav1.Object, runtime.Object, *unstructured.Unstructured,
+// unstructured.Unstructured or map[string]interface{}.
+func (h *Handler) Update(obj interface{}) (*policyv1.PodDisruptionBudget, error) {
+	switch val := obj.(type) {
+	case string:
+		return h.UpdateFromFile(val)
+	case []byte:
+		return h.UpdateFromBytes(val)
+	case *policyv1.PodDisruptionBudget:
+		return h.UpdateFromObject(val)
+	case policyv1.PodDisruptionBudget:
+		return h.UpdateFromObject(&val)
+	case *unstructured.Unstructured:
+		return h.UpdateFromUnstructured(val)
+	case unstructured.Unstructured:
+		return h.UpdateFromUnstructured(&val)
+	case map[string]interface{}:
+		return h.UpdateFromMap(val)
+	case metav1.Object, runtime.Object:
+		return h.UpdateFromObject(val)
+	default:
+		return nil, ErrInvalidUpdateType
+	}
+}
+
+// UpdateFromFile updates pdb from yaml or json file.
+func (h *Handler) UpdateFromFile(filename string) (*policyv1.PodDisruptionBudget, error) {
+	data, err := ioutil.ReadFile(filename)
+	if err != nil {
+		return nil, err
+	}
+	return h.UpdateFromBytes(data)
+}
+
+// UpdateFromBytes updates pdb from bytes data.
+func (h *Handler) UpdateFromBytes(data []byte) (*policyv1.PodDisruptionBudget, error) {
+	pdbJson, err := yaml.ToJSON(data)
+	if err != nil {
+		return nil, err
+	}
+
+	pdb := &policyv1.PodDisruptionBudget{}
+	if err = json.Unmarshal(pdbJson, pdb); err != nil {
+		return nil, err
+	}
+	return h.updatePDB(pdb)
+}
+
+// UpdateFromObject updates pdb from metav1.Object or runtime.Object.
+func (h *Handler) UpdateFromObject(obj interface{}) (*policyv1.PodDisruptionBudget, error) {
+	pdb, ok := obj.(*policyv1.PodDisruptionBudget)
+	if !ok {
+		return nil, ErrInvalidObjectType
+	}
+	return h.updatePDB(pdb)
+}
+
+// UpdateFromUnstructured updates pdb from *unstructured.Unstructured.
+func (h *Handler) UpdateFromUnstructured(u *unstructured.Unstructured) (*policyv1.PodDisruptionBudget, error) {
+	pdb := &policyv1.PodDisruptionBudget{}
+	err := runtime.DefaultUnstructuredConverter.FromUnstructured(u.UnstructuredContent(), pdb)
+	if err != nil {
+		return nil, err
+	}
+	return h.updatePDB(pdb)
+}
+
+// UpdateFromMap updates pdb from map[string]interface{}.
+func (h *Handler) UpdateFromMap(u map[string]interface{}) (*policyv1.PodDisruptionBudget, error) {
+	pdb := &policyv1.PodDisruptionBudget{}
+	err := runtime.DefaultUnstructuredConverter.FromUnstructured(u, pdb)
+	if err != nil {
+		return nil, err
+	}
+	return h.updatePDB(pdb)
+}
+
+// updatePDB
+func (h *Handler) updatePDB(pdb *policyv1.PodDisruptionBudget) (*policyv1.PodDisruptionBudget, error) {
+	namespace := pdb.GetNamespace()
+	if len(namespace) == 0 {
+		namespace = h.namespace
+	}
+	pdb.ResourceVersion = ""
+	pdb.UID = ""
+	useV1beta1, err := h.useV1beta1()
+	if err != nil {
+		return nil, err
+	}
+	if !useV1beta1 {
+		return h.clientset.PolicyV1().PodDisruptionBudgets(namespace).Update(h.ctx, pdb, h.Options.UpdateOptions)
+	}
+	pdbV1beta1, err := h.clientset.PolicyV1beta1().PodDisruptionBudgets(namespace).Update(h.ctx, toV1beta1(pdb), h.Options.UpdateOptions)
+	if err != nil {
+		return nil, err
+	}
+	return fromV1beta1(pdbV1beta1), nil
+}
@@ -0,0 +1,86 @@
+package pdb
+
+import (
+	policyv1 "k8s.io/api/policy/v1"
+	policyv1beta1 "k8s.io/api/policy/v1beta1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+)
+
+// useV1beta1 returns true if the kubernetes apiserver doesn't serve the policy/v1
+// PodDisruptionBudget. The API version is detected by the discovery client
+// only once, and cached in the handler.
+func (h *Handler) useV1beta1() (bool, error) {
+	h.l.RLock()
+	apiVersion := h.apiVersion
+	h.l.RUnlock()
+	if len(apiVersion) != 0 {
+		return apiVersion == policyv1beta1.SchemeGroupVersion.String(), nil
+	}
+
+	apiVersion = policyv1beta1.SchemeGroupVersion.String()
+	resources, err := h.discoveryClient.ServerResourcesForGroupVersion(policyv1.SchemeGroupVersion.String())
+	switch {
+	case k8serrors.IsNotFound(err):
+	case err != nil:
+		return false, err
+	default:
+		for _, r := range resources.APIResources {
+			if r.Name == Resource {
+				apiVersion = policyv1.SchemeGroupVersion.String()
+				break
+			}
+		}
+	}
+
+	h.l.Lock()
+	h.apiVersion = apiVersion
+	h.l.Unlock()
+	return apiVersion == policyv1beta1.SchemeGroupVersion.String(), nil
+}
+
+// toV1beta1 converts the policy/v1 pdb to policy/v1beta1 pdb.
+//
+// Notice that an empty selector matches no pods in policy/v1beta1, but all the
+// pods in the namespace in policy/v1.
+func toV1beta1(pdb *policyv1.PodDisruptionBudget) *policyv1beta1.PodDisruptionBudget {
+	pdb = pdb.DeepCopy()
+	return &policyv1beta1.PodDisruptionBudget{
+		ObjectMeta: pdb.ObjectMeta,
+		Spec: policyv1beta1.PodDisruptionBudgetSpec{
+			MinAvailable:   pdb.Spec.MinAvailable,
+			Selector:       pdb.Spec.Selector,
+			MaxUnavailable: pdb.Spec.MaxUnavailable,
+		},
+		Status: policyv1beta1.PodDisruptionBudgetStatus{
+			ObservedGeneration: pdb.Status.ObservedGeneration,
+			DisruptedPods:      pdb.Status.DisruptedPods,
+			DisruptionsAllowed: pdb.Status.DisruptionsAllowed,
+			CurrentHealthy:     pdb.Status.CurrentHealthy,
+			DesiredHealthy:     pdb.Status.DesiredHealthy,
+			ExpectedPods:       pdb.Status.ExpectedPods,
+			Conditions:         pdb.Status.Conditions,
+		},
+	}
+}
+
+// fromV1beta1 converts the policy/v1beta1 pdb to policy/v1 pdb.
+func fromV1beta1(pdb *policyv1beta1.PodDisruptionBudget) *policyv1.PodDisruptionBudget {
+	pdb = pdb.DeepCopy()
+	return &policyv1.PodDisruptionBudget{
+		ObjectMeta: pdb.ObjectMeta,
+		Spec: policyv1.PodDisruptionBudgetSpec{
+			MinAvailable:   pdb.Spec.MinAvailable,
+			Selector:       pdb.Spec.Selector,
+			MaxUnavailable: pdb.Spec.MaxUnavailable,
+		},
+		Status: policyv1.PodDisruptionBudgetStatus{
+			ObservedGeneration: pdb.Status.ObservedGeneration,
+			DisruptedPods:      pdb.Status.DisruptedPods,
+			DisruptionsAllowed: pdb.Status.DisruptionsAllowed,
+			CurrentHealthy:     pdb.Status.CurrentHealthy,
+			DesiredHealthy:     pdb.Status.DesiredHealthy,
+			ExpectedPods:       pdb.Status.ExpectedPods,
+			Conditions:         pdb.Status.Conditions,
+		},
+	}
+}
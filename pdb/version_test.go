@@ -0,0 +1,249 @@
+package pdb
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/forbearing/k8s/types"
+	policyv1 "k8s.io/api/policy/v1"
+	policyv1beta1 "k8s.io/api/policy/v1beta1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	k8stypes "k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/client-go/discovery"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/rest"
+)
+
+// newTestHandler creates a pdb handler in namespace "test", whose clientset
+// and discovery client send the requests to the fake kubernetes API server serve.
+func newTestHandler(t *testing.T, serve http.HandlerFunc) *Handler {
+	srv := httptest.NewServer(serve)
+	t.Cleanup(srv.Close)
+	config := &rest.Config{Host: srv.URL}
+	clientset, err := kubernetes.NewForConfig(config)
+	if err != nil {
+		t.Fatal(err)
+	}
+	discoveryClient, err := discovery.NewDiscoveryClientForConfig(config)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return &Handler{
+		ctx:             context.Background(),
+		namespace:       "test",
+		clientset:       clientset,
+		discoveryClient: discoveryClient,
+		Options:         &types.HandlerOptions{},
+	}
+}
+
+// serveDiscovery serves the policy/v1 discovery document if v1 is true,
+// otherwise policy/v1 is not found. It returns false if the request is
+// not a discovery request.
+func serveDiscovery(w http.ResponseWriter, r *http.Request, v1 bool, discoveries *int32) bool {
+	if r.URL.Path != "/apis/policy/v1" {
+		return false
+	}
+	atomic.AddInt32(discoveries, 1)
+	w.Header().Set("Content-Type", "application/json")
+	if !v1 {
+		w.WriteHeader(http.StatusNotFound)
+		fmt.Fprintln(w, `{"kind":"Status","apiVersion":"v1","status":"Failure","reason":"NotFound","code":404}`)
+		return true
+	}
+	fmt.Fprintln(w, `{"kind":"APIResourceList","apiVersion":"v1","groupVersion":"policy/v1","resources":[`+
+		`{"name":"poddisruptionbudgets","namespaced":true,"kind":"PodDisruptionBudget","verbs":["get","list"]}]}`)
+	return true
+}
+
+func TestAllowedDisruptions(t *testing.T) {
+	tests := []struct {
+		name    string
+		v1      bool
+		path    string
+		allowed int32
+	}{
+		{"policy/v1", true, "/apis/policy/v1/namespaces/test/poddisruptionbudgets/web", 1},
+		{"policy/v1beta1", false, "/apis/policy/v1beta1/namespaces/test/poddisruptionbudgets/web", 2},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var discoveries int32
+			h := newTestHandler(t, func(w http.ResponseWriter, r *http.Request) {
+				if serveDiscovery(w, r, test.v1, &discoveries) {
+					return
+				}
+				if r.URL.Path != test.path {
+					t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+				}
+				w.Header().Set("Content-Type", "application/json")
+				fmt.Fprintf(w, `{"kind":"PodDisruptionBudget","apiVersion":"%s","metadata":{"name":"web","namespace":"test"},`+
+					`"spec":{"minAvailable":1},"status":{"disruptionsAllowed":%d}}`, test.name, test.allowed)
+			})
+
+			for i := 0; i < 2; i++ {
+				allowed, err := h.AllowedDisruptions("web")
+				if err != nil {
+					t.Fatal(err)
+				}
+				if allowed != test.allowed {
+					t.Errorf("allowed disruptions = %d, want %d", allowed, test.allowed)
+				}
+			}
+			if discoveries != 1 {
+				t.Errorf("discovered the API version %d times, want 1", discoveries)
+			}
+		})
+	}
+}
+
+func TestV1beta1Fallback(t *testing.T) {
+	var (
+		discoveries int32
+		created     *policyv1beta1.PodDisruptionBudget
+	)
+	h := newTestHandler(t, func(w http.ResponseWriter, r *http.Request) {
+		if serveDiscovery(w, r, false, &discoveries) {
+			return
+		}
+		if r.Method != http.MethodPost || r.URL.Path != "/apis/policy/v1beta1/namespaces/test/poddisruptionbudgets" {
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+		data, _ := io.ReadAll(r.Body)
+		created = &policyv1beta1.PodDisruptionBudget{}
+		if err := json.Unmarshal(data, created); err != nil {
+			t.Error(err)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.Write(data)
+	})
+
+	maxUnavailable := intstr.FromString("25%")
+	pdb := &policyv1.PodDisruptionBudget{
+		ObjectMeta: metav1.ObjectMeta{Name: "web"},
+		Spec: policyv1.PodDisruptionBudgetSpec{
+			MaxUnavailable: &maxUnavailable,
+			Selector:       &metav1.LabelSelector{MatchLabels: map[string]string{"app": "web"}},
+		},
+	}
+	got, err := h.Create(pdb)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if created == nil || created.Spec.MaxUnavailable.String() != "25%" || created.Spec.Selector.MatchLabels["app"] != "web" {
+		t.Errorf("created policy/v1beta1 pdb %+v", created)
+	}
+	if got.Name != "web" || got.Spec.MaxUnavailable.String() != "25%" {
+		t.Errorf("Create() = %+v", got)
+	}
+}
+
+func TestV1beta1Patch(t *testing.T) {
+	minAvailable := intstr.FromInt(1)
+	original := &policyv1.PodDisruptionBudget{
+		ObjectMeta: metav1.ObjectMeta{Name: "web", Namespace: "test"},
+		Spec: policyv1.PodDisruptionBudgetSpec{
+			MinAvailable: &minAvailable,
+			Selector:     &metav1.LabelSelector{MatchLabels: map[string]string{"app": "web"}},
+		},
+	}
+	modified := original.DeepCopy()
+	percent := intstr.FromString("50%")
+	modified.Spec.MinAvailable = &percent
+
+	tests := []struct {
+		name        string
+		patch       interface{}
+		patchType   k8stypes.PatchType
+		contentType string
+	}{
+		{"diff", modified, k8stypes.StrategicMergePatchType, "application/strategic-merge-patch+json"},
+		{"merge patch", []byte(`{"spec":{"minAvailable":"50%"}}`), k8stypes.MergePatchType, "application/merge-patch+json"},
+		{"json patch", []byte(`[{"op":"replace","path":"/spec/minAvailable","value":"50%"}]`), k8stypes.JSONPatchType, "application/json-patch+json"},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			h := newTestHandler(t, func(w http.ResponseWriter, r *http.Request) {
+				if serveDiscovery(w, r, false, new(int32)) {
+					return
+				}
+				if r.Method != http.MethodPatch || r.URL.Path != "/apis/policy/v1beta1/namespaces/test/poddisruptionbudgets/web" {
+					t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+				}
+				if contentType := r.Header.Get("Content-Type"); contentType != test.contentType {
+					t.Errorf("Content-Type = %s, want %s", contentType, test.contentType)
+				}
+				data, _ := io.ReadAll(r.Body)
+				if !strings.Contains(string(data), `"50%"`) {
+					t.Errorf("patch data %s doesn't set minAvailable", data)
+				}
+				w.Header().Set("Content-Type", "application/json")
+				fmt.Fprintln(w, `{"kind":"PodDisruptionBudget","apiVersion":"policy/v1beta1","metadata":{"name":"web","namespace":"test"},`+
+					`"spec":{"minAvailable":"50%","selector":{"matchLabels":{"app":"web"}}}}`)
+			})
+
+			pdb, err := h.Patch(original, test.patch, test.patchType)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if pdb.Spec.MinAvailable.String() != "50%" || pdb.Spec.Selector.MatchLabels["app"] != "web" {
+				t.Errorf("Patch() = %+v", pdb.Spec)
+			}
+		})
+	}
+}
+
+func TestV1beta1Watch(t *testing.T) {
+	h := newTestHandler(t, func(w http.ResponseWriter, r *http.Request) {
+		if serveDiscovery(w, r, false, new(int32)) {
+			return
+		}
+		if r.URL.Path != "/apis/policy/v1beta1/namespaces/test/poddisruptionbudgets" || r.URL.Query().Get("watch") != "true" {
+			t.Errorf("unexpected request %s %s", r.Method, r.URL)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprintln(w, `{"type":"MODIFIED","object":{"kind":"PodDisruptionBudget","apiVersion":"policy/v1beta1",`+
+			`"metadata":{"name":"web","namespace":"test","resourceVersion":"2"},"spec":{"maxUnavailable":1},"status":{"disruptionsAllowed":0}}}`)
+		fmt.Fprintln(w, `{"type":"DELETED","object":{"kind":"PodDisruptionBudget","apiVersion":"policy/v1beta1",`+
+			`"metadata":{"name":"web","namespace":"test","resourceVersion":"3"},"spec":{"maxUnavailable":1},"status":{"disruptionsAllowed":1}}}`)
+		w.(http.Flusher).Flush()
+		<-r.Context().Done()
+	})
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	h.ctx = ctx
+
+	events := make(chan string, 2)
+	record := func(eventType string) func(obj interface{}) {
+		return func(obj interface{}) {
+			pdb, ok := obj.(*policyv1.PodDisruptionBudget)
+			if !ok {
+				t.Errorf("%s event object %T is not policy/v1 pdb", eventType, obj)
+				return
+			}
+			events <- fmt.Sprintf("%s %s maxUnavailable=%s allowed=%d",
+				eventType, pdb.ResourceVersion, pdb.Spec.MaxUnavailable, pdb.Status.DisruptionsAllowed)
+		}
+	}
+	go h.WatchByName("web", record("ADDED"), record("MODIFIED"), record("DELETED"))
+
+	for _, want := range []string{"MODIFIED 2 maxUnavailable=1 allowed=0", "DELETED 3 maxUnavailable=1 allowed=1"} {
+		select {
+		case got := <-events:
+			if got != want {
+				t.Errorf("got event %q, want %q", got, want)
+			}
+		case <-time.After(5 * time.Second):
+			t.Fatalf("timeout waiting for event %q", want)
+		}
+	}
+}
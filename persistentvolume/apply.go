@@ -1,8 +1,6 @@
 package persistentvolume
 
 import (
-	"fmt"
-
 	corev1 "k8s.io/api/core/v1"
 	k8serrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -58,7 +56,7 @@ func (h *Handler) ApplyFromBytes(data []byte) (pv *corev1.PersistentVolume, err
 func (h *Handler) ApplyFromObject(obj interface{}) (*corev1.PersistentVolume, error) {
 	pv, ok := obj.(*corev1.PersistentVolume)
 	if !ok {
-		return nil, fmt.Errorf("object type is not *corev1.PersistentVolume")
+		return nil, ErrInvalidObjectType
 	}
 	return h.applyPV(pv)
 }
@@ -2,7 +2,6 @@ package persistentvolume
 
 import (
 	"encoding/json"
-	"fmt"
 	"io/ioutil"
 
 	corev1 "k8s.io/api/core/v1"
@@ -73,7 +72,7 @@ func (h *Handler) GetFromBytes(data []byte) (*corev1.PersistentVolume, error) {
 func (h *Handler) GetFromObject(obj interface{}) (*corev1.PersistentVolume, error) {
 	pv, ok := obj.(*corev1.PersistentVolume)
 	if !ok {
-		return nil, fmt.Errorf("object type is not *corev1.PersistentVolume")
+		return nil, ErrInvalidObjectType
 	}
 	return h.getPV(pv)
 }
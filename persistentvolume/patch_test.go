@@ -0,0 +1,76 @@
+package persistentvolume
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+
+	"github.com/forbearing/k8s/types"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	apitypes "k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/rest"
+)
+
+func TestPatchMergePatchType(t *testing.T) {
+	var contentType string
+	var patchData []byte
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		contentType = r.Header.Get("Content-Type")
+		patchData, _ = io.ReadAll(r.Body)
+		w.Header().Set("Content-Type", "application/json")
+		io.WriteString(w, `{"kind":"PersistentVolume","apiVersion":"v1","metadata":{"name":"mypv"}}`)
+	}))
+	defer srv.Close()
+	clientset, err := kubernetes.NewForConfig(&rest.Config{Host: srv.URL})
+	if err != nil {
+		t.Fatal(err)
+	}
+	h := &Handler{ctx: context.Background(), clientset: clientset, Options: &types.HandlerOptions{}}
+
+	original := &corev1.PersistentVolume{
+		ObjectMeta: metav1.ObjectMeta{Name: "mypv"},
+		Spec: corev1.PersistentVolumeSpec{
+			AccessModes:  []corev1.PersistentVolumeAccessMode{corev1.ReadWriteOnce, corev1.ReadOnlyMany},
+			MountOptions: []string{"hard", "nfsvers=4.1"},
+		},
+	}
+	modified := original.DeepCopy()
+	modified.Spec.AccessModes = []corev1.PersistentVolumeAccessMode{corev1.ReadWriteMany}
+	modified.Spec.MountOptions = []string{"hard", "nfsvers=4.2"}
+
+	tests := []struct {
+		name  string
+		patch interface{}
+	}{
+		{"object", modified},
+		{"bytes", []byte(`{"spec":{"accessModes":["ReadWriteMany"],"mountOptions":["hard","nfsvers=4.2"]}}`)},
+	}
+	for _, tt := range tests {
+		if _, err := h.Patch(original, tt.patch, apitypes.MergePatchType); err != nil {
+			t.Fatalf("%s: %v", tt.name, err)
+		}
+		if contentType != string(apitypes.MergePatchType) {
+			t.Errorf("%s: content type = %q, want %q", tt.name, contentType, apitypes.MergePatchType)
+		}
+		got := struct {
+			Spec map[string]interface{} `json:"spec"`
+		}{}
+		if err := json.Unmarshal(patchData, &got); err != nil {
+			t.Fatalf("%s: %v", tt.name, err)
+		}
+		// the merge patch must contain the entire new lists, they replace the existing lists.
+		want := map[string]interface{}{
+			"accessModes":  []interface{}{"ReadWriteMany"},
+			"mountOptions": []interface{}{"hard", "nfsvers=4.2"},
+		}
+		if !reflect.DeepEqual(got.Spec, want) {
+			t.Errorf("%s: patch = %s, want spec %v", tt.name, patchData, want)
+		}
+	}
+}
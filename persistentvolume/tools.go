@@ -1,6 +1,7 @@
 package persistentvolume
 
 import (
+	"fmt"
 	"reflect"
 	"strings"
 	"time"
@@ -138,6 +139,30 @@ func (h *Handler) getPVC(pv *corev1.PersistentVolume) string {
 	return pvc
 }
 
+// GetBoundPVC gets the persistentvolumeclaim bound to the persistentvolume,
+// the persistentvolumeclaim is got from the namespace of the claimRef.
+// ErrNotBound is returned if the persistentvolume is not bound, or the
+// persistentvolumeclaim in claimRef has been deleted and recreated.
+func (h *Handler) GetBoundPVC(name string) (*corev1.PersistentVolumeClaim, error) {
+	pv, err := h.Get(name)
+	if err != nil {
+		return nil, err
+	}
+	claimRef := pv.Spec.ClaimRef
+	if pv.Status.Phase != corev1.VolumeBound || claimRef == nil {
+		return nil, fmt.Errorf("%w: %s is %s", ErrNotBound, pv.Name, pv.Status.Phase)
+	}
+	pvc, err := h.clientset.CoreV1().PersistentVolumeClaims(claimRef.Namespace).Get(h.ctx, claimRef.Name, h.Options.GetOptions)
+	if err != nil {
+		return nil, err
+	}
+	if len(claimRef.UID) != 0 && claimRef.UID != pvc.UID {
+		return nil, fmt.Errorf("%w: %s, persistentvolumeclaim %s/%s has been recreated",
+			ErrNotBound, pv.Name, claimRef.Namespace, claimRef.Name)
+	}
+	return pvc, nil
+}
+
 // GetStorageClass get the storageclass name of the persistentvolume.
 func (h *Handler) GetStorageClass(object interface{}) (string, error) {
 	switch val := object.(type) {
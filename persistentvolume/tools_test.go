@@ -0,0 +1,67 @@
+package persistentvolume
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/forbearing/k8s/types"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/rest"
+)
+
+func TestGetBoundPVC(t *testing.T) {
+	// objects are the persistentvolumes and persistentvolumeclaims served by the fake kubernetes API server.
+	objects := map[string]string{
+		// pv1 is claimed by the persistentvolumeclaim in namespace "other", not the handler namespace.
+		"/api/v1/persistentvolumes/pv1": `{"kind":"PersistentVolume","apiVersion":"v1","metadata":{"name":"pv1"},` +
+			`"spec":{"claimRef":{"kind":"PersistentVolumeClaim","namespace":"other","name":"data","uid":"1"}},"status":{"phase":"Bound"}}`,
+		"/api/v1/persistentvolumes/pv2": `{"kind":"PersistentVolume","apiVersion":"v1","metadata":{"name":"pv2"},"status":{"phase":"Available"}}`,
+		// the persistentvolumeclaim claimed by pv3 has been deleted and recreated.
+		"/api/v1/persistentvolumes/pv3": `{"kind":"PersistentVolume","apiVersion":"v1","metadata":{"name":"pv3"},` +
+			`"spec":{"claimRef":{"kind":"PersistentVolumeClaim","namespace":"other","name":"logs","uid":"2"}},"status":{"phase":"Bound"}}`,
+		"/api/v1/namespaces/other/persistentvolumeclaims/data": `{"kind":"PersistentVolumeClaim","apiVersion":"v1",` +
+			`"metadata":{"name":"data","namespace":"other","uid":"1"},"spec":{"volumeName":"pv1"},"status":{"phase":"Bound"}}`,
+		"/api/v1/namespaces/other/persistentvolumeclaims/logs": `{"kind":"PersistentVolumeClaim","apiVersion":"v1",` +
+			`"metadata":{"name":"logs","namespace":"other","uid":"3"},"status":{"phase":"Pending"}}`,
+	}
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		obj, ok := objects[r.URL.Path]
+		if !ok {
+			w.WriteHeader(http.StatusNotFound)
+			fmt.Fprintln(w, `{"kind":"Status","apiVersion":"v1","status":"Failure","reason":"NotFound","code":404}`)
+			return
+		}
+		fmt.Fprintln(w, obj)
+	}))
+	defer srv.Close()
+	clientset, err := kubernetes.NewForConfig(&rest.Config{Host: srv.URL})
+	if err != nil {
+		t.Fatal(err)
+	}
+	h := &Handler{ctx: context.Background(), clientset: clientset, Options: &types.HandlerOptions{}}
+
+	tests := []struct {
+		name    string
+		wantPVC string
+		wantErr error
+	}{
+		{"pv1", "other/data", nil},
+		{"pv2", "", ErrNotBound},
+		{"pv3", "", ErrNotBound},
+	}
+	for _, test := range tests {
+		pvc, err := h.GetBoundPVC(test.name)
+		if !errors.Is(err, test.wantErr) {
+			t.Errorf("GetBoundPVC(%q) error = %v, want %v", test.name, err, test.wantErr)
+			continue
+		}
+		if test.wantErr == nil && pvc.Namespace+"/"+pvc.Name != test.wantPVC {
+			t.Errorf("GetBoundPVC(%q) = %s/%s, want %s", test.name, pvc.Namespace, pvc.Name, test.wantPVC)
+		}
+	}
+}
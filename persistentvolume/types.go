@@ -2,6 +2,7 @@ package persistentvolume
 
 import "errors"
 
+// ErrNotBound is wrapped with the volume name and phase, check it with errors.Is.
 var (
 	ErrInvalidToolsType  = errors.New("type must be string, *corev1.PersistentVolume, corev1.PersistentVolume, metav1.Object or runtime.Object")
 	ErrInvalidCreateType = errors.New("type must be string, []byte, *corev1.PersistentVolume, corev1.PersistentVolume, metav1.Object, runtime.Object, *unstructured.Unstructured, unstructured.Unstructured or map[string]interface{}")
@@ -10,4 +11,6 @@ var (
 	ErrInvalidDeleteType = ErrInvalidCreateType
 	ErrInvalidGetType    = ErrInvalidCreateType
 	ErrInvalidPatchType  = errors.New("patch data type must be string, []byte, *corev1.PersistentVolume, corev1.PersistentVolume, metav1.Object, runtime.Object, *unstructured.Unstructured, unstructured.Unstructured or map[string]interface{}")
+	ErrInvalidObjectType = errors.New("object type is not *corev1.PersistentVolume")
+	ErrNotBound          = errors.New("persistentvolume is not bound")
 )
@@ -1,7 +1,6 @@
 package persistentvolume
 
 import (
-	log "github.com/sirupsen/logrus"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/fields"
 	"k8s.io/apimachinery/pkg/watch"
@@ -19,7 +18,7 @@ import (
 //  * If Event.Type is Error: *api.Status is recommended; other types may make sense
 //    depending on context.
 func (h *Handler) Watch(addFunc, modifyFunc, deleteFunc func(obj interface{})) error {
-	return h.WatchByLabel("", addFunc, deleteFunc, modifyFunc)
+	return h.WatchByLabel("", addFunc, modifyFunc, deleteFunc)
 }
 
 // WatchByName watch a single persistentvolume reseource.
@@ -100,13 +99,13 @@ func (h *Handler) watchPersistentVolume(listOptions metav1.ListOptions,
 			case watch.Deleted:
 				deleteFunc(event.Object)
 			case watch.Bookmark:
-				log.Debug("watch persistentvolume: bookmark")
+				h.log().Debug("watch persistentvolume: bookmark")
 			case watch.Error:
-				log.Debug("watch persistentvolume: error")
+				h.log().Debug("watch persistentvolume: error")
 			}
 		}
 		// If event channel is closed, it means the server has closed the connection
-		log.Debug("watch persistentvolume: reconnect to kubernetes")
+		h.log().Debug("watch persistentvolume: reconnect to kubernetes")
 		watcher.Stop()
 	}
 }
@@ -1,8 +1,6 @@
 package persistentvolumeclaim
 
 import (
-	"fmt"
-
 	corev1 "k8s.io/api/core/v1"
 	k8serrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -58,7 +56,7 @@ func (h *Handler) ApplyFromBytes(data []byte) (pvc *corev1.PersistentVolumeClaim
 func (h *Handler) ApplyFromObject(obj interface{}) (*corev1.PersistentVolumeClaim, error) {
 	pvc, ok := obj.(*corev1.PersistentVolumeClaim)
 	if !ok {
-		return nil, fmt.Errorf("object type is not *corev1.PersistentVolumeClaim")
+		return nil, ErrInvalidObjectType
 	}
 	return h.applyPVC(pvc)
 }
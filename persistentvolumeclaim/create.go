@@ -2,7 +2,6 @@ package persistentvolumeclaim
 
 import (
 	"encoding/json"
-	"fmt"
 	"io/ioutil"
 
 	corev1 "k8s.io/api/core/v1"
@@ -65,7 +64,7 @@ func (h *Handler) CreateFromBytes(data []byte) (*corev1.PersistentVolumeClaim, e
 func (h *Handler) CreateFromObject(obj interface{}) (*corev1.PersistentVolumeClaim, error) {
 	pvc, ok := obj.(*corev1.PersistentVolumeClaim)
 	if !ok {
-		return nil, fmt.Errorf("object type is not *corev1.PersistentVolumeClaim")
+		return nil, ErrInvalidObjectType
 	}
 	return h.createPVC(pvc)
 }
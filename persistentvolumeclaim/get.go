@@ -2,7 +2,6 @@ package persistentvolumeclaim
 
 import (
 	"encoding/json"
-	"fmt"
 	"io/ioutil"
 
 	corev1 "k8s.io/api/core/v1"
@@ -73,7 +72,7 @@ func (h *Handler) GetFromBytes(data []byte) (*corev1.PersistentVolumeClaim, erro
 func (h *Handler) GetFromObject(obj interface{}) (*corev1.PersistentVolumeClaim, error) {
 	pvc, ok := obj.(*corev1.PersistentVolumeClaim)
 	if !ok {
-		return nil, fmt.Errorf("object type is not *corev1.PersistentVolumeClaim")
+		return nil, ErrInvalidObjectType
 	}
 	return h.getPVC(pvc)
 }
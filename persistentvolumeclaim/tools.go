@@ -52,6 +52,30 @@ func (h *Handler) GetPV(object interface{}) (string, error) {
 	}
 }
 
+// GetBoundPV gets the persistentvolume bound to the persistentvolumeclaim.
+// ErrNotBound is returned if the persistentvolumeclaim is not bound, or the
+// persistentvolume claimRef doesn't point back to the persistentvolumeclaim.
+func (h *Handler) GetBoundPV(name string) (*corev1.PersistentVolume, error) {
+	pvc, err := h.Get(name)
+	if err != nil {
+		return nil, err
+	}
+	if pvc.Status.Phase != corev1.ClaimBound || len(pvc.Spec.VolumeName) == 0 {
+		return nil, fmt.Errorf("%w: %s/%s is %s", ErrNotBound, pvc.Namespace, pvc.Name, pvc.Status.Phase)
+	}
+	pv, err := h.clientset.CoreV1().PersistentVolumes().Get(h.ctx, pvc.Spec.VolumeName, h.Options.GetOptions)
+	if err != nil {
+		return nil, err
+	}
+	claimRef := pv.Spec.ClaimRef
+	if claimRef == nil || claimRef.Namespace != pvc.Namespace || claimRef.Name != pvc.Name ||
+		(len(claimRef.UID) != 0 && claimRef.UID != pvc.UID) {
+		return nil, fmt.Errorf("%w: %s/%s, persistentvolume %s is claimed by another persistentvolumeclaim",
+			ErrNotBound, pvc.Namespace, pvc.Name, pv.Name)
+	}
+	return pv, nil
+}
+
 // GetCapacity get the storage capacity of the persistentvolumeclaim.
 func (h *Handler) GetCapacity(object interface{}) (int64, error) {
 	switch val := object.(type) {
@@ -0,0 +1,67 @@
+package persistentvolumeclaim
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/forbearing/k8s/types"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/rest"
+)
+
+func TestGetBoundPV(t *testing.T) {
+	// objects are the persistentvolumeclaims and persistentvolumes served by the fake kubernetes API server.
+	objects := map[string]string{
+		"/api/v1/namespaces/test/persistentvolumeclaims/data": `{"kind":"PersistentVolumeClaim","apiVersion":"v1",` +
+			`"metadata":{"name":"data","namespace":"test","uid":"1"},"spec":{"volumeName":"pv1"},"status":{"phase":"Bound"}}`,
+		"/api/v1/namespaces/test/persistentvolumeclaims/pending": `{"kind":"PersistentVolumeClaim","apiVersion":"v1",` +
+			`"metadata":{"name":"pending","namespace":"test","uid":"2"},"status":{"phase":"Pending"}}`,
+		"/api/v1/namespaces/test/persistentvolumeclaims/stale": `{"kind":"PersistentVolumeClaim","apiVersion":"v1",` +
+			`"metadata":{"name":"stale","namespace":"test","uid":"3"},"spec":{"volumeName":"pv2"},"status":{"phase":"Bound"}}`,
+		"/api/v1/persistentvolumes/pv1": `{"kind":"PersistentVolume","apiVersion":"v1","metadata":{"name":"pv1"},` +
+			`"spec":{"claimRef":{"kind":"PersistentVolumeClaim","namespace":"test","name":"data","uid":"1"}},"status":{"phase":"Bound"}}`,
+		// pv2 is claimed by the persistentvolumeclaim with the same name in another namespace.
+		"/api/v1/persistentvolumes/pv2": `{"kind":"PersistentVolume","apiVersion":"v1","metadata":{"name":"pv2"},` +
+			`"spec":{"claimRef":{"kind":"PersistentVolumeClaim","namespace":"other","name":"stale","uid":"4"}},"status":{"phase":"Bound"}}`,
+	}
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		obj, ok := objects[r.URL.Path]
+		if !ok {
+			w.WriteHeader(http.StatusNotFound)
+			fmt.Fprintln(w, `{"kind":"Status","apiVersion":"v1","status":"Failure","reason":"NotFound","code":404}`)
+			return
+		}
+		fmt.Fprintln(w, obj)
+	}))
+	defer srv.Close()
+	clientset, err := kubernetes.NewForConfig(&rest.Config{Host: srv.URL})
+	if err != nil {
+		t.Fatal(err)
+	}
+	h := &Handler{ctx: context.Background(), namespace: "test", clientset: clientset, Options: &types.HandlerOptions{}}
+
+	tests := []struct {
+		name    string
+		wantPV  string
+		wantErr error
+	}{
+		{"data", "pv1", nil},
+		{"pending", "", ErrNotBound},
+		{"stale", "", ErrNotBound},
+	}
+	for _, test := range tests {
+		pv, err := h.GetBoundPV(test.name)
+		if !errors.Is(err, test.wantErr) {
+			t.Errorf("GetBoundPV(%q) error = %v, want %v", test.name, err, test.wantErr)
+			continue
+		}
+		if test.wantErr == nil && pv.Name != test.wantPV {
+			t.Errorf("GetBoundPV(%q) = %s, want %s", test.name, pv.Name, test.wantPV)
+		}
+	}
+}
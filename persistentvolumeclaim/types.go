@@ -2,12 +2,16 @@ package persistentvolumeclaim
 
 import "errors"
 
+// ErrNotBound is wrapped with the claim name and phase, check it with errors.Is.
 var (
-	ErrInvalidToolsType  = errors.New("type must be string, *corev1.PersistentVolumeClaim, corev1.PersistentVolumeClaim, metav1.Object or runtime.Object")
-	ErrInvalidCreateType = errors.New("type must be string, []byte, *corev1.PersistentVolumeClaim, corev1.PersistentVolumeClaim, metav1.Object, runtime.Object, *unstructured.Unstructured, unstructured.Unstructured or map[string]interface{}")
-	ErrInvalidUpdateType = ErrInvalidCreateType
-	ErrInvalidApplyType  = ErrInvalidCreateType
-	ErrInvalidDeleteType = ErrInvalidCreateType
-	ErrInvalidGetType    = ErrInvalidCreateType
-	ErrInvalidPatchType  = errors.New("patch data type must be string, []byte, *corev1.PersistentVolumeClaim, corev1.PersistentVolumeClaim, metav1.Object, runtime.Object, *unstructured.Unstructured, unstructured.Unstructured or map[string]interface{}")
+	ErrInvalidToolsType     = errors.New("type must be string, *corev1.PersistentVolumeClaim, corev1.PersistentVolumeClaim, metav1.Object or runtime.Object")
+	ErrInvalidCreateType    = errors.New("type must be string, []byte, *corev1.PersistentVolumeClaim, corev1.PersistentVolumeClaim, metav1.Object, runtime.Object, *unstructured.Unstructured, unstructured.Unstructured or map[string]interface{}")
+	ErrInvalidUpdateType    = ErrInvalidCreateType
+	ErrInvalidApplyType     = ErrInvalidCreateType
+	ErrInvalidDeleteType    = ErrInvalidCreateType
+	ErrInvalidGetType       = ErrInvalidCreateType
+	ErrInvalidPatchType     = errors.New("patch data type must be string, []byte, *corev1.PersistentVolumeClaim, corev1.PersistentVolumeClaim, metav1.Object, runtime.Object, *unstructured.Unstructured, unstructured.Unstructured or map[string]interface{}")
+	ErrInvalidObjectType    = errors.New("object type is not *corev1.PersistentVolumeClaim")
+	ErrNotBound             = errors.New("persistentvolumeclaim is not bound")
+	ErrEmptyResourceVersion = errors.New("resourceVersion must not be empty to update persistentvolumeclaim with optimistic concurrency")
 )
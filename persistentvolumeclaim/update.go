@@ -2,7 +2,6 @@ package persistentvolumeclaim
 
 import (
 	"encoding/json"
-	"fmt"
 	"io/ioutil"
 
 	corev1 "k8s.io/api/core/v1"
@@ -65,7 +64,7 @@ func (h *Handler) UpdateFromBytes(data []byte) (*corev1.PersistentVolumeClaim, e
 func (h *Handler) UpdateFromObject(obj interface{}) (*corev1.PersistentVolumeClaim, error) {
 	pvc, ok := obj.(*corev1.PersistentVolumeClaim)
 	if !ok {
-		return nil, fmt.Errorf("object type is not *corev1.PersistentVolumeClaim")
+		return nil, ErrInvalidObjectType
 	}
 	return h.updatePVC(pvc)
 }
@@ -90,6 +89,22 @@ func (h *Handler) UpdateFromMap(u map[string]interface{}) (*corev1.PersistentVol
 	return h.updatePVC(pvc)
 }
 
+// UpdateWithResourceVersion updates the persistentvolumeclaim with optimistic concurrency.
+// Unlike Update, the resourceVersion of the persistentvolumeclaim is kept, so the update fails
+// with a Conflict error if the persistentvolumeclaim has been modified since it was read,
+// check it with k8serrors.IsConflict, get the latest persistentvolumeclaim and retry.
+// ErrEmptyResourceVersion is returned if the persistentvolumeclaim has no resourceVersion.
+func (h *Handler) UpdateWithResourceVersion(pvc *corev1.PersistentVolumeClaim) (*corev1.PersistentVolumeClaim, error) {
+	if len(pvc.ResourceVersion) == 0 {
+		return nil, ErrEmptyResourceVersion
+	}
+	namespace := pvc.GetNamespace()
+	if len(namespace) == 0 {
+		namespace = h.namespace
+	}
+	return h.clientset.CoreV1().PersistentVolumeClaims(namespace).Update(h.ctx, pvc, h.Options.UpdateOptions)
+}
+
 // updatePVC
 func (h *Handler) updatePVC(pvc *corev1.PersistentVolumeClaim) (*corev1.PersistentVolumeClaim, error) {
 	namespace := pvc.GetNamespace()
@@ -0,0 +1,57 @@
+package persistentvolumeclaim
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/forbearing/k8s/types"
+	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/rest"
+)
+
+func TestUpdateWithResourceVersion(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPut || r.URL.Path != "/api/v1/namespaces/test/persistentvolumeclaims/data" {
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+		pvc := &corev1.PersistentVolumeClaim{}
+		if err := json.NewDecoder(r.Body).Decode(pvc); err != nil {
+			t.Error(err)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		// the latest resourceVersion is "2".
+		if pvc.ResourceVersion != "2" {
+			w.WriteHeader(http.StatusConflict)
+			fmt.Fprintln(w, `{"kind":"Status","apiVersion":"v1","status":"Failure","reason":"Conflict","code":409}`)
+			return
+		}
+		pvc.ResourceVersion = "3"
+		json.NewEncoder(w).Encode(pvc)
+	}))
+	defer srv.Close()
+	clientset, err := kubernetes.NewForConfig(&rest.Config{Host: srv.URL})
+	if err != nil {
+		t.Fatal(err)
+	}
+	h := &Handler{ctx: context.Background(), namespace: "test", clientset: clientset, Options: &types.HandlerOptions{}}
+
+	pvc := &corev1.PersistentVolumeClaim{ObjectMeta: metav1.ObjectMeta{Name: "data", ResourceVersion: "1"}}
+	if _, err := h.UpdateWithResourceVersion(pvc); !k8serrors.IsConflict(err) {
+		t.Fatalf("update with stale resourceVersion: got %v, want Conflict error", err)
+	}
+	pvc.ResourceVersion = "2"
+	updated, err := h.UpdateWithResourceVersion(pvc)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if updated.ResourceVersion != "3" {
+		t.Errorf("resourceVersion = %s, want 3", updated.ResourceVersion)
+	}
+}
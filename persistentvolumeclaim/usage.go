@@ -0,0 +1,115 @@
+package persistentvolumeclaim
+
+import (
+	"encoding/json"
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+)
+
+// Usage is the storage usage of the persistentvolumeclaim.
+type Usage struct {
+	// CapacityBytes is the total capacity of the volume.
+	CapacityBytes int64
+	// UsedBytes is the bytes used by the volume. It's -1 if FromMetrics is false.
+	UsedBytes int64
+	// AvailableBytes is the bytes available in the volume. It's -1 if FromMetrics is false.
+	AvailableBytes int64
+	// FromMetrics is true if the usage comes from the kubelet volume metrics,
+	// or false if the usage falls back to the persistentvolumeclaim capacity.
+	FromMetrics bool
+}
+
+// volumeStatsSummary is the subset of the kubelet stats summary("/stats/summary")
+// that contains the persistentvolumeclaim volume metrics.
+// ref: https://github.com/kubernetes/kubelet/blob/v0.24.2/pkg/apis/stats/v1alpha1/types.go
+type volumeStatsSummary struct {
+	Pods []struct {
+		VolumeStats []struct {
+			CapacityBytes  *uint64 `json:"capacityBytes,omitempty"`
+			UsedBytes      *uint64 `json:"usedBytes,omitempty"`
+			AvailableBytes *uint64 `json:"availableBytes,omitempty"`
+			PVCRef         *struct {
+				Name      string `json:"name"`
+				Namespace string `json:"namespace"`
+			} `json:"pvcRef,omitempty"`
+		} `json:"volume,omitempty"`
+	} `json:"pods"`
+}
+
+// Usage gets the actual storage usage of the persistentvolumeclaim.
+//
+// The volume metrics are fetched from the kubelet stats summary API(through
+// the apiserver node proxy) of the node where a running pod mounts the
+// persistentvolumeclaim. If the persistentvolumeclaim is not mounted or the
+// metrics are not available, Usage gracefully degrades to the persistentvolumeclaim
+// capacity, and Usage.FromMetrics is false.
+func (h *Handler) Usage(name string) (*Usage, error) {
+	pvc, err := h.Get(name)
+	if err != nil {
+		return nil, err
+	}
+	if usage, err := h.usageFromMetrics(pvc); err == nil {
+		return usage, nil
+	}
+	capacity := h.getCapacity(pvc)
+	if capacity == 0 {
+		storage := pvc.Spec.Resources.Requests[corev1.ResourceStorage]
+		capacity = storage.Value()
+	}
+	return &Usage{CapacityBytes: capacity, UsedBytes: -1, AvailableBytes: -1}, nil
+}
+
+// usageFromMetrics gets the persistentvolumeclaim usage from the kubelet volume metrics.
+func (h *Handler) usageFromMetrics(pvc *corev1.PersistentVolumeClaim) (*Usage, error) {
+	nodeName, err := h.mountedNode(pvc)
+	if err != nil {
+		return nil, err
+	}
+	data, err := h.clientset.CoreV1().RESTClient().Get().
+		Resource("nodes").Name(nodeName).SubResource("proxy").Suffix("stats/summary").
+		DoRaw(h.ctx)
+	if err != nil {
+		return nil, err
+	}
+	summary := &volumeStatsSummary{}
+	if err := json.Unmarshal(data, summary); err != nil {
+		return nil, err
+	}
+	for _, pod := range summary.Pods {
+		for _, volume := range pod.VolumeStats {
+			if volume.PVCRef == nil || volume.PVCRef.Name != pvc.Name || volume.PVCRef.Namespace != pvc.Namespace {
+				continue
+			}
+			if volume.CapacityBytes == nil || volume.UsedBytes == nil || volume.AvailableBytes == nil {
+				continue
+			}
+			return &Usage{
+				CapacityBytes:  int64(*volume.CapacityBytes),
+				UsedBytes:      int64(*volume.UsedBytes),
+				AvailableBytes: int64(*volume.AvailableBytes),
+				FromMetrics:    true,
+			}, nil
+		}
+	}
+	return nil, fmt.Errorf("no volume metrics of persistentvolumeclaim/%s on node/%s", pvc.Name, nodeName)
+}
+
+// mountedNode returns the node name where a running pod mounts the persistentvolumeclaim.
+func (h *Handler) mountedNode(pvc *corev1.PersistentVolumeClaim) (string, error) {
+	listOptions := h.Options.ListOptions.DeepCopy()
+	listOptions.LabelSelector = ""
+	listOptions.FieldSelector = "status.phase=Running"
+	podList, err := h.clientset.CoreV1().Pods(pvc.Namespace).List(h.ctx, *listOptions)
+	if err != nil {
+		return "", err
+	}
+	for _, pod := range podList.Items {
+		for _, volume := range pod.Spec.Volumes {
+			if volume.PersistentVolumeClaim != nil && volume.PersistentVolumeClaim.ClaimName == pvc.Name {
+				return pod.Spec.NodeName, nil
+			}
+		}
+	}
+	return "", fmt.Errorf("persistentvolumeclaim/%s is not mounted by any running pod", pvc.Name)
+}
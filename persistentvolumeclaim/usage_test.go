@@ -0,0 +1,74 @@
+package persistentvolumeclaim
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/forbearing/k8s/types"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/rest"
+)
+
+func TestUsage(t *testing.T) {
+	const (
+		pvcJSON = `{"kind":"PersistentVolumeClaim","apiVersion":"v1","metadata":{"name":"data","namespace":"test"},` +
+			`"spec":{"resources":{"requests":{"storage":"1Gi"}}},"status":{"capacity":{"storage":"2Gi"}}}`
+		mountedPods = `{"kind":"PodList","apiVersion":"v1","metadata":{},"items":[` +
+			`{"metadata":{"name":"other","namespace":"test"},"spec":{"nodeName":"node1","volumes":[{"name":"v","emptyDir":{}}]}},` +
+			`{"metadata":{"name":"web","namespace":"test"},"spec":{"nodeName":"node2","volumes":[{"name":"v","persistentVolumeClaim":{"claimName":"data"}}]}}]}`
+		summary = `{"node":{"nodeName":"node2"},"pods":[` +
+			`{"podRef":{"name":"other","namespace":"test"},"volume":[{"name":"v","capacityBytes":100,"usedBytes":1,"availableBytes":99}]},` +
+			`{"podRef":{"name":"web","namespace":"test"},"volume":[` +
+			`{"name":"v","capacityBytes":2147483648,"usedBytes":1073741824,"availableBytes":1073741824,"pvcRef":{"name":"data","namespace":"test"}}]}]}`
+	)
+	tests := []struct {
+		name    string
+		pods    string
+		summary string
+		want    Usage
+	}{
+		{"from metrics", mountedPods, summary,
+			Usage{CapacityBytes: 2 << 30, UsedBytes: 1 << 30, AvailableBytes: 1 << 30, FromMetrics: true}},
+		{"not mounted", `{"kind":"PodList","apiVersion":"v1","metadata":{},"items":[]}`, summary,
+			Usage{CapacityBytes: 2 << 30, UsedBytes: -1, AvailableBytes: -1}},
+		{"volume missing in the summary", mountedPods, `{"node":{"nodeName":"node2"},"pods":[]}`,
+			Usage{CapacityBytes: 2 << 30, UsedBytes: -1, AvailableBytes: -1}},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.Header().Set("Content-Type", "application/json")
+				switch r.URL.Path {
+				case "/api/v1/namespaces/test/persistentvolumeclaims/data":
+					fmt.Fprintln(w, pvcJSON)
+				case "/api/v1/namespaces/test/pods":
+					if selector := r.URL.Query().Get("fieldSelector"); selector != "status.phase=Running" {
+						t.Errorf("fieldSelector = %q, want the running pods", selector)
+					}
+					fmt.Fprintln(w, test.pods)
+				case "/api/v1/nodes/node2/proxy/stats/summary":
+					fmt.Fprintln(w, test.summary)
+				default:
+					t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+				}
+			}))
+			defer srv.Close()
+			clientset, err := kubernetes.NewForConfig(&rest.Config{Host: srv.URL})
+			if err != nil {
+				t.Fatal(err)
+			}
+			h := &Handler{ctx: context.Background(), namespace: "test", clientset: clientset, Options: &types.HandlerOptions{}}
+
+			usage, err := h.Usage("data")
+			if err != nil {
+				t.Fatal(err)
+			}
+			if *usage != test.want {
+				t.Errorf("Usage() = %+v, want %+v", *usage, test.want)
+			}
+		})
+	}
+}
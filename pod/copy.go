@@ -0,0 +1,179 @@
+package pod
+
+import (
+	"archive/tar"
+	"bytes"
+	"fmt"
+	"io"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+)
+
+// CopyToPod copies the local file or directory srcPath to destPath in the
+// container of the pod, it works like `kubectl cp srcPath podName:destPath -c container`.
+// If destPath ends with "/", it's regarded as a directory and srcPath is
+// copied into it, otherwise srcPath is copied as destPath.
+//
+// The tar binary must be present in the container.
+func (h *Handler) CopyToPod(podName, container, srcPath, destPath string) error {
+	if _, err := os.Stat(srcPath); err != nil {
+		return err
+	}
+	destDir, name := path.Split(destPath)
+	if len(name) == 0 {
+		name = filepath.Base(srcPath)
+	}
+	if len(destDir) == 0 {
+		destDir = "."
+	}
+
+	reader, writer := io.Pipe()
+	go func() {
+		writer.CloseWithError(writeTar(writer, srcPath, name))
+	}()
+	defer reader.Close()
+
+	stderr := &bytes.Buffer{}
+	err := h.Exec(podName, container, []string{"tar", "-xmf", "-", "-C", destDir}, reader, io.Discard, stderr)
+	return tarError(err, stderr)
+}
+
+// CopyFromPod copies the file or directory srcPath in the container of the pod
+// to the local destPath, it works like `kubectl cp podName:srcPath destPath -c container`.
+// If destPath is an existing directory, srcPath is copied into it, otherwise
+// srcPath is copied as destPath.
+//
+// The tar binary must be present in the container.
+func (h *Handler) CopyFromPod(podName, container, srcPath, destPath string) error {
+	srcPath = path.Clean(srcPath)
+	srcDir, name := path.Split(srcPath)
+	if len(srcDir) == 0 {
+		srcDir = "."
+	}
+	if info, err := os.Stat(destPath); err == nil && info.IsDir() {
+		destPath = filepath.Join(destPath, name)
+	}
+
+	reader, writer := io.Pipe()
+	stderr := &bytes.Buffer{}
+	go func() {
+		err := h.Exec(podName, container, []string{"tar", "-cf", "-", "-C", srcDir, name}, nil, writer, stderr)
+		writer.CloseWithError(tarError(err, stderr))
+	}()
+	defer reader.Close()
+
+	return untar(reader, name, destPath)
+}
+
+// writeTar writes the file or directory srcPath to w as a tar stream, the
+// srcPath is renamed to name in the tar stream.
+func writeTar(w io.Writer, srcPath, name string) error {
+	tw := tar.NewWriter(w)
+	err := filepath.Walk(srcPath, func(file string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		rel, err := filepath.Rel(srcPath, file)
+		if err != nil {
+			return err
+		}
+		header, err := tar.FileInfoHeader(info, "")
+		if err != nil {
+			return err
+		}
+		header.Name = path.Join(name, filepath.ToSlash(rel))
+		if info.IsDir() {
+			header.Name += "/"
+		}
+		if info.Mode()&os.ModeSymlink != 0 {
+			if header.Linkname, err = os.Readlink(file); err != nil {
+				return err
+			}
+		}
+		if err := tw.WriteHeader(header); err != nil {
+			return err
+		}
+		if !info.Mode().IsRegular() {
+			return nil
+		}
+		f, err := os.Open(file)
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+		_, err = io.Copy(tw, f)
+		return err
+	})
+	if err != nil {
+		return err
+	}
+	return tw.Close()
+}
+
+// untar extracts the tar stream to destPath, the leading path component name
+// of every entry is replaced with destPath.
+// The entries outside of name are rejected.
+func untar(r io.Reader, name, destPath string) error {
+	tr := tar.NewReader(r)
+	for {
+		header, err := tr.Next()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		entry := path.Clean(header.Name)
+		if entry != name && !strings.HasPrefix(entry, name+"/") {
+			return fmt.Errorf("tar entry %q is outside of %q", header.Name, name)
+		}
+		rel := strings.TrimPrefix(strings.TrimPrefix(entry, name), "/")
+		for _, elem := range strings.Split(rel, "/") {
+			if elem == ".." {
+				return fmt.Errorf("tar entry %q is outside of %q", header.Name, name)
+			}
+		}
+		target := filepath.Join(destPath, filepath.FromSlash(rel))
+
+		switch header.Typeflag {
+		case tar.TypeDir:
+			if err := os.MkdirAll(target, 0755); err != nil {
+				return err
+			}
+		case tar.TypeReg:
+			if err := os.MkdirAll(filepath.Dir(target), 0755); err != nil {
+				return err
+			}
+			f, err := os.OpenFile(target, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, os.FileMode(header.Mode).Perm())
+			if err != nil {
+				return err
+			}
+			_, err = io.Copy(f, tr)
+			f.Close()
+			if err != nil {
+				return err
+			}
+		default:
+			// the symbolic links and the special files are skipped, the
+			// symbolic links may point to the files outside of destPath.
+		}
+	}
+}
+
+// tarError returns a helpful error if the tar binary is missing in the container.
+func tarError(err error, stderr *bytes.Buffer) error {
+	if err == nil {
+		return nil
+	}
+	msg := err.Error() + stderr.String()
+	if strings.Contains(msg, "executable file not found") || strings.Contains(msg, "tar: not found") ||
+		strings.Contains(msg, "exited with code 127") {
+		return fmt.Errorf("tar binary is not found in the container, copying files requires tar: %w", err)
+	}
+	if stderr.Len() != 0 {
+		return fmt.Errorf("%w: %s", err, strings.TrimSpace(stderr.String()))
+	}
+	return err
+}
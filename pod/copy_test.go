@@ -0,0 +1,120 @@
+package pod
+
+import (
+	"archive/tar"
+	"bytes"
+	"errors"
+	"io"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestWriteTar(t *testing.T) {
+	src := t.TempDir()
+	if err := os.MkdirAll(filepath.Join(src, "dir", "sub"), 0755); err != nil {
+		t.Fatal(err)
+	}
+	files := map[string]string{
+		"file.txt":      "hello",
+		"dir/a.txt":     "a",
+		"dir/sub/b.txt": "b",
+	}
+	for name, content := range files {
+		if err := os.WriteFile(filepath.Join(src, name), []byte(content), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	tests := []struct {
+		name    string
+		srcPath string
+		tarName string
+		want    map[string]string
+	}{
+		{
+			name:    "single file",
+			srcPath: filepath.Join(src, "file.txt"),
+			tarName: "renamed.txt",
+			want:    map[string]string{"renamed.txt": "hello"},
+		},
+		{
+			name:    "directory",
+			srcPath: filepath.Join(src, "dir"),
+			tarName: "conf",
+			want: map[string]string{
+				"conf/":          "",
+				"conf/a.txt":     "a",
+				"conf/sub/":      "",
+				"conf/sub/b.txt": "b",
+			},
+		},
+	}
+	for _, tt := range tests {
+		buf := &bytes.Buffer{}
+		if err := writeTar(buf, tt.srcPath, tt.tarName); err != nil {
+			t.Fatalf("%s: %v", tt.name, err)
+		}
+		got := make(map[string]string)
+		tr := tar.NewReader(buf)
+		for {
+			header, err := tr.Next()
+			if err == io.EOF {
+				break
+			}
+			if err != nil {
+				t.Fatalf("%s: %v", tt.name, err)
+			}
+			content, _ := io.ReadAll(tr)
+			got[header.Name] = string(content)
+		}
+		if !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("%s: got %v, want %v", tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestUntar(t *testing.T) {
+	src := t.TempDir()
+	if err := os.MkdirAll(filepath.Join(src, "sub"), 0755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(src, "sub", "b.txt"), []byte("b"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	buf := &bytes.Buffer{}
+	if err := writeTar(buf, src, "data"); err != nil {
+		t.Fatal(err)
+	}
+
+	// the leading "data" component is replaced with the destination path.
+	dest := filepath.Join(t.TempDir(), "restored")
+	if err := untar(buf, "data", dest); err != nil {
+		t.Fatal(err)
+	}
+	content, err := os.ReadFile(filepath.Join(dest, "sub", "b.txt"))
+	if err != nil || string(content) != "b" {
+		t.Errorf("restored file = %q, %v, want b", content, err)
+	}
+
+	// the entries escaping the destination are rejected.
+	buf.Reset()
+	tw := tar.NewWriter(buf)
+	tw.WriteHeader(&tar.Header{Name: "data/../../evil", Typeflag: tar.TypeReg, Mode: 0644})
+	tw.Close()
+	if err := untar(buf, "data", dest); err == nil {
+		t.Error("untar() should reject the entry outside of the destination")
+	}
+}
+
+func TestTarError(t *testing.T) {
+	err := errors.New(`exec: "tar": executable file not found in $PATH`)
+	if got := tarError(err, &bytes.Buffer{}); got == nil || !strings.Contains(got.Error(), "tar binary is not found") {
+		t.Errorf("tarError() = %v, want tar not found error", got)
+	}
+	if got := tarError(nil, bytes.NewBufferString("warning")); got != nil {
+		t.Errorf("tarError(nil) = %v, want nil", got)
+	}
+}
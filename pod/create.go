@@ -2,7 +2,6 @@ package pod
 
 import (
 	"encoding/json"
-	"fmt"
 	"io/ioutil"
 
 	corev1 "k8s.io/api/core/v1"
@@ -65,7 +64,7 @@ func (h *Handler) CreateFromBytes(data []byte) (*corev1.Pod, error) {
 func (h *Handler) CreateFromObject(obj interface{}) (*corev1.Pod, error) {
 	pod, ok := obj.(*corev1.Pod)
 	if !ok {
-		return nil, fmt.Errorf("object type is not *corev1.Pod")
+		return nil, ErrInvalidObjectType
 	}
 	return h.createPod(pod)
 }
@@ -0,0 +1,45 @@
+package pod
+
+import (
+	"fmt"
+
+	utildiscovery "github.com/forbearing/k8s/util/discovery"
+	policyv1 "k8s.io/api/policy/v1"
+	policyv1beta1 "k8s.io/api/policy/v1beta1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// evictionGVR is the pod eviction subresource, kubernetes API server reports
+// the group version of the Eviction object it accepts, policy/v1 or policy/v1beta1.
+var evictionGVR = schema.GroupVersionResource{Version: "v1", Resource: "pods/eviction"}
+
+// Evict evicts the pod in the handler namespace through the eviction API, which
+// respects the PodDisruptionBudgets, unlike deleting the pod directly.
+// If gracePeriodSeconds is nil, the default grace period of the pod is used.
+//
+// The policy/v1 Eviction is used, falling back to policy/v1beta1 if kubernetes
+// API server doesn't support policy/v1 (before v1.22). If the eviction is
+// blocked by a PodDisruptionBudget, an error wrapping EvictionBlockedError is
+// returned, which matches ErrEvictionBlocked and k8serrors.IsTooManyRequests,
+// the eviction can be retried later.
+func (h *Handler) Evict(name string, gracePeriodSeconds *int64) error {
+	resource, err := utildiscovery.APIResource(h.discoveryClient, evictionGVR)
+	if err != nil {
+		return err
+	}
+	objectMeta := metav1.ObjectMeta{Name: name, Namespace: h.namespace}
+	deleteOptions := &metav1.DeleteOptions{GracePeriodSeconds: gracePeriodSeconds}
+	if resource.Group == policyv1beta1.GroupName && resource.Version == policyv1beta1.SchemeGroupVersion.Version {
+		err = h.clientset.PolicyV1beta1().Evictions(h.namespace).
+			Evict(h.ctx, &policyv1beta1.Eviction{ObjectMeta: objectMeta, DeleteOptions: deleteOptions})
+	} else {
+		err = h.clientset.PolicyV1().Evictions(h.namespace).
+			Evict(h.ctx, &policyv1.Eviction{ObjectMeta: objectMeta, DeleteOptions: deleteOptions})
+	}
+	if k8serrors.IsTooManyRequests(err) {
+		return fmt.Errorf("evict pod %s: %w", name, &EvictionBlockedError{Err: err})
+	}
+	return err
+}
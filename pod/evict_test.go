@@ -0,0 +1,77 @@
+package pod
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+)
+
+func TestEvict(t *testing.T) {
+	tests := []struct {
+		name           string
+		version        string
+		blocked        bool
+		wantAPIVersion string
+		wantErr        error
+	}{
+		{"evicted", "v1", false, "policy/v1", nil},
+		{"blocked by PodDisruptionBudget", "v1", true, "policy/v1", ErrEvictionBlocked},
+		{"policy/v1beta1 fallback", "v1beta1", false, "policy/v1beta1", nil},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var eviction struct {
+				APIVersion    string `json:"apiVersion"`
+				DeleteOptions struct {
+					GracePeriodSeconds *int64 `json:"gracePeriodSeconds"`
+				} `json:"deleteOptions"`
+			}
+			h := newTestHandler(t, func(w http.ResponseWriter, r *http.Request) {
+				w.Header().Set("Content-Type", "application/json")
+				switch {
+				case r.Method == http.MethodGet && r.URL.Path == "/api/v1":
+					fmt.Fprintf(w, `{"kind":"APIResourceList","groupVersion":"v1","resources":[`+
+						`{"name":"pods","namespaced":true,"kind":"Pod","verbs":["get"]},`+
+						`{"name":"pods/eviction","namespaced":true,"group":"policy","version":%q,"kind":"Eviction","verbs":["create"]}]}`, test.version)
+				case r.Method == http.MethodPost && r.URL.Path == "/api/v1/namespaces/test/pods/nginx/eviction":
+					if err := json.NewDecoder(r.Body).Decode(&eviction); err != nil {
+						t.Error(err)
+					}
+					if test.blocked {
+						w.WriteHeader(http.StatusTooManyRequests)
+						fmt.Fprintln(w, `{"kind":"Status","apiVersion":"v1","status":"Failure","reason":"TooManyRequests",`+
+							`"message":"Cannot evict pod as it would violate the pod's disruption budget.","code":429}`)
+						return
+					}
+					w.WriteHeader(http.StatusCreated)
+					fmt.Fprintln(w, `{"kind":"Status","apiVersion":"v1","status":"Success"}`)
+				default:
+					t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+				}
+			})
+
+			gracePeriodSeconds := int64(30)
+			err := h.Evict("nginx", &gracePeriodSeconds)
+			if !errors.Is(err, test.wantErr) {
+				t.Fatalf("Evict() = %v, want %v", err, test.wantErr)
+			}
+			if test.blocked {
+				// the error of kubernetes API server is kept.
+				var statusErr *k8serrors.StatusError
+				if !k8serrors.IsTooManyRequests(err) || !errors.As(err, &statusErr) {
+					t.Errorf("Evict() = %v, want the TooManyRequests StatusError", err)
+				}
+			}
+			if eviction.APIVersion != test.wantAPIVersion {
+				t.Errorf("eviction apiVersion = %q, want %q", eviction.APIVersion, test.wantAPIVersion)
+			}
+			if eviction.DeleteOptions.GracePeriodSeconds == nil || *eviction.DeleteOptions.GracePeriodSeconds != 30 {
+				t.Errorf("eviction gracePeriodSeconds = %v, want 30", eviction.DeleteOptions.GracePeriodSeconds)
+			}
+		})
+	}
+}
@@ -0,0 +1,57 @@
+package pod
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"net/url"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/tools/remotecommand"
+	utilexec "k8s.io/client-go/util/exec"
+)
+
+// Exec executes the command in the container of the pod, it works like
+// `kubectl exec podName -c container -- command`.
+// If the container is empty, the only container of the pod is used.
+//
+// Only the non-nil stdin, stdout and stderr are attached to the remote process,
+// and no TTY is allocated. If the remote command exits with a non-zero code,
+// the returned error wraps the utilexec.ExitError, which has the exit code.
+func (h *Handler) Exec(podName, container string, command []string, stdin io.Reader, stdout, stderr io.Writer) error {
+	execURL := h.execURL(podName, &corev1.PodExecOptions{
+		Container: container,
+		Command:   command,
+		Stdin:     stdin != nil,
+		Stdout:    stdout != nil,
+		Stderr:    stderr != nil,
+		TTY:       false,
+	})
+	executor, err := remotecommand.NewSPDYExecutor(h.config, "POST", execURL)
+	if err != nil {
+		return err
+	}
+	err = executor.Stream(remotecommand.StreamOptions{
+		Stdin:  stdin,
+		Stdout: stdout,
+		Stderr: stderr,
+		Tty:    false,
+	})
+	var exitErr utilexec.ExitError
+	if errors.As(err, &exitErr) {
+		return fmt.Errorf("command %q in pod/%s exited with code %d: %w", command, podName, exitErr.ExitStatus(), err)
+	}
+	return err
+}
+
+// execURL returns the url of the pods/exec subresource of the pod.
+func (h *Handler) execURL(podName string, opts *corev1.PodExecOptions) *url.URL {
+	return h.restClient.Post().
+		Namespace(h.namespace).
+		Resource("pods").
+		Name(podName).
+		SubResource("exec").
+		VersionedParams(opts, scheme.ParameterCodec).
+		URL()
+}
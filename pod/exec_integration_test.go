@@ -0,0 +1,45 @@
+//go:build integration
+// +build integration
+
+package pod
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	utilexec "k8s.io/client-go/util/exec"
+)
+
+// TestExecIntegration runs against the cluster of $HOME/.kube/config, the
+// pod named by $EXEC_TEST_POD in namespace "default" must be running.
+//
+//	EXEC_TEST_POD=nginx go test -tags integration -run TestExecIntegration ./pod
+func TestExecIntegration(t *testing.T) {
+	podName := os.Getenv("EXEC_TEST_POD")
+	if len(podName) == 0 {
+		t.Skip("EXEC_TEST_POD is not set")
+	}
+	h, err := New(context.Background(), filepath.Join(os.Getenv("HOME"), ".kube/config"), "default")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	stdout := &bytes.Buffer{}
+	if err := h.Exec(podName, "", []string{"echo", "hello"}, nil, stdout, nil); err != nil {
+		t.Fatal(err)
+	}
+	if strings.TrimSpace(stdout.String()) != "hello" {
+		t.Errorf("stdout = %q, want hello", stdout.String())
+	}
+
+	err = h.Exec(podName, "", []string{"sh", "-c", "exit 3"}, nil, nil, nil)
+	var exitErr utilexec.ExitError
+	if !errors.As(err, &exitErr) || exitErr.ExitStatus() != 3 {
+		t.Errorf("Exec() = %v, want exit code 3", err)
+	}
+}
@@ -0,0 +1,34 @@
+package pod
+
+import (
+	"net/http"
+	"reflect"
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+)
+
+func TestExecURL(t *testing.T) {
+	h := newTestHandler(t, func(w http.ResponseWriter, r *http.Request) {})
+
+	execURL := h.execURL("nginx", &corev1.PodExecOptions{
+		Container: "web",
+		Command:   []string{"sh", "-c", "echo hello"},
+		Stdin:     false,
+		Stdout:    true,
+		Stderr:    true,
+	})
+	if execURL.Path != "/api/v1/namespaces/test/pods/nginx/exec" {
+		t.Errorf("path = %s, want /api/v1/namespaces/test/pods/nginx/exec", execURL.Path)
+	}
+	query := execURL.Query()
+	if got := query["command"]; !reflect.DeepEqual(got, []string{"sh", "-c", "echo hello"}) {
+		t.Errorf("command = %q", got)
+	}
+	want := map[string]string{"container": "web", "stdin": "", "stdout": "true", "stderr": "true", "tty": ""}
+	for key, val := range want {
+		if query.Get(key) != val {
+			t.Errorf("query %s = %q, want %q", key, query.Get(key), val)
+		}
+	}
+}
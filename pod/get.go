@@ -2,7 +2,6 @@ package pod
 
 import (
 	"encoding/json"
-	"fmt"
 	"io/ioutil"
 
 	corev1 "k8s.io/api/core/v1"
@@ -73,7 +72,7 @@ func (h *Handler) GetFromBytes(data []byte) (*corev1.Pod, error) {
 func (h *Handler) GetFromObject(obj interface{}) (*corev1.Pod, error) {
 	pod, ok := obj.(*corev1.Pod)
 	if !ok {
-		return nil, fmt.Errorf("object type is not *corev1.Pod")
+		return nil, ErrInvalidObjectType
 	}
 	return h.getPod(pod)
 }
@@ -3,8 +3,6 @@ package pod
 import (
 	"time"
 
-	"github.com/sirupsen/logrus"
-	log "github.com/sirupsen/logrus"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/client-go/informers"
@@ -120,18 +118,18 @@ func (h *Handler) RunInformer(
 
 	// method 1, recommended
 	h.InformerFactory().Start(stopCh)
-	logrus.Info("Waiting for informer caches to sync")
+	h.log().Info("Waiting for informer caches to sync")
 	if ok := cache.WaitForCacheSync(stopCh, h.Informer().HasSynced); !ok {
-		logrus.Error("failed to wait for caches to sync")
+		h.log().Error("failed to wait for caches to sync")
 	}
 
 	//// method 2
 	//h.InformerFactory().Start(stopCh)
-	//logrus.Info("Waiting for informer caches to sync")
+	//h.log().Info("Waiting for informer caches to sync")
 	//h.InformerFactory().WaitForCacheSync(stopCh)
 
 	//// method 3
-	//logrus.Info("Waiting for informer caches to sync")
+	//h.log().Info("Waiting for informer caches to sync")
 	//h.informerFactory.WaitForCacheSync(stopCh)
 	//h.Informer().Run(stopCh)
 }
@@ -151,27 +149,27 @@ func (h *Handler) TestInformer(stopCh chan struct{}) {
 	h.Informer().AddEventHandler(cache.ResourceEventHandlerFuncs{
 		AddFunc: func(obj interface{}) {
 			myObj := obj.(metav1.Object)
-			log.Printf("New Pod Added to Store: %s", myObj.GetName())
+			h.log().Info("New Pod Added to Store: ", myObj.GetName())
 		},
 		UpdateFunc: func(oldObj, newObj interface{}) {
 			newPod := newObj.(*corev1.Pod)
 			oldPod := oldObj.(*corev1.Pod)
 			if newPod.ResourceVersion != oldPod.ResourceVersion {
-				log.Printf("Pod Updated to Store: %s\n", newPod.Name)
+				h.log().Info("Pod Updated to Store: ", newPod.Name)
 			}
 			//if !reflect.DeepEqual(newObj, oldObj) {
-			//    log.Printf("Pod Updated to Store: %s\n", newObj.(metav1.Object).GetName())
+			//    h.log().Info("Pod Updated to Store: ", newObj.(metav1.Object).GetName())
 			//}
 		},
 		DeleteFunc: func(obj interface{}) {
 			myObj := obj.(metav1.Object)
-			log.Printf("Pod Deleted from Store: %s", myObj.GetName())
+			h.log().Info("Pod Deleted from Store: ", myObj.GetName())
 		},
 	})
 	h.InformerFactory().Start(stopCh)
-	logrus.Info("Waiting for informer caches to sync")
+	h.log().Info("Waiting for informer caches to sync")
 	if ok := cache.WaitForCacheSync(stopCh, h.Informer().HasSynced); !ok {
-		logrus.Error("failed to wait for caches to sync")
+		h.log().Error("failed to wait for caches to sync")
 	}
 }
 
@@ -117,6 +117,30 @@ func (h *Handler) ListUnknow() ([]*corev1.Pod, error) {
 	return h.WithNamespace(metav1.NamespaceAll).ListByField(field)
 }
 
+// ListExcludeTerminating list pods by labels, but the pods that are
+// being deleted(.metadata.deletionTimestamp is not nil) are dropped.
+//
+// Field selector can't select objects by deletionTimestamp, so the terminating
+// pods are filtered out on the client side after listing.
+func (h *Handler) ListExcludeTerminating(labels string) ([]*corev1.Pod, error) {
+	objList, err := h.ListByLabel(labels)
+	if err != nil {
+		return nil, err
+	}
+	return excludeTerminating(objList), nil
+}
+
+// excludeTerminating drops the pods whose deletionTimestamp is not nil.
+func excludeTerminating(objList []*corev1.Pod) []*corev1.Pod {
+	var live []*corev1.Pod
+	for _, obj := range objList {
+		if obj.DeletionTimestamp == nil {
+			live = append(live, obj)
+		}
+	}
+	return live
+}
+
 // extractList
 func extractList(podList *corev1.PodList) []*corev1.Pod {
 	//var pl []*corev1.Pod
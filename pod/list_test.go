@@ -0,0 +1,36 @@
+package pod
+
+import (
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+)
+
+func TestListExcludeTerminating(t *testing.T) {
+	h := newTestHandler(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet || r.URL.Path != "/api/v1/namespaces/test/pods" {
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+		if selector := r.URL.Query().Get("labelSelector"); selector != "app=nginx" {
+			t.Errorf("labelSelector = %q, want app=nginx", selector)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprintln(w, `{"kind":"PodList","apiVersion":"v1","metadata":{},"items":[
+{"metadata":{"name":"nginx-1","namespace":"test"}},
+{"metadata":{"name":"nginx-2","namespace":"test","deletionTimestamp":"2022-01-01T00:00:00Z"}},
+{"metadata":{"name":"nginx-3","namespace":"test"}}]}`)
+	})
+
+	pods, err := h.ListExcludeTerminating("app=nginx")
+	if err != nil {
+		t.Fatal(err)
+	}
+	var names []string
+	for _, pod := range pods {
+		names = append(names, pod.Name)
+	}
+	if want := []string{"nginx-1", "nginx-3"}; !reflect.DeepEqual(names, want) {
+		t.Errorf("ListExcludeTerminating() = %v, want %v", names, want)
+	}
+}
@@ -2,8 +2,10 @@ package pod
 
 import (
 	"bufio"
+	"bytes"
 	"encoding/json"
 	"fmt"
+	"io"
 	"io/ioutil"
 
 	corev1 "k8s.io/api/core/v1"
@@ -74,7 +76,7 @@ func (h *Handler) LogFromBytes(data []byte, logOptions *LogOptions) error {
 func (h *Handler) LogFromObject(obj interface{}, logOptions *LogOptions) error {
 	pod, ok := obj.(*corev1.Pod)
 	if !ok {
-		return fmt.Errorf("object type is not *corev1.Pod")
+		return ErrInvalidObjectType
 	}
 	return h.logPod(pod, logOptions)
 }
@@ -101,7 +103,9 @@ func (h *Handler) LogFromMap(u map[string]interface{}, logOptions *LogOptions) e
 
 // logPod
 func (h *Handler) logPod(pod *corev1.Pod, logOptions *LogOptions) error {
-	if !h.IsReady(pod.Name) {
+	// the previous terminated container logs are required when the pod is
+	// crashlooping, and a crashlooping pod is never ready.
+	if !logOptions.Previous && !h.IsReady(pod.Name) {
 		return fmt.Errorf("pod/%s is not ready", pod.Name)
 	}
 
@@ -133,3 +137,78 @@ func (h *Handler) getLog(namespace, name string, logOptions *LogOptions) error {
 	}
 	return scanner.Err()
 }
+
+// GetLogs gets the logs of the pod, it works like `kubectl logs name`.
+// The opts.Container, opts.TailLines, opts.SinceSeconds, etc. are supported,
+// opts can be nil. Don't set opts.Follow, or GetLogs returns only after the
+// container terminated, use StreamLogs to follow the logs instead.
+func (h *Handler) GetLogs(name string, opts *corev1.PodLogOptions) ([]byte, error) {
+	if opts == nil {
+		opts = &corev1.PodLogOptions{}
+	}
+	return h.clientset.CoreV1().Pods(h.namespace).GetLogs(name, opts).DoRaw(h.ctx)
+}
+
+// StreamLogs streams the logs of the pod to w, it works like
+// `kubectl logs name -f` if opts.Follow is true. opts can be nil.
+//
+// StreamLogs returns when the log stream ends or the handler context is done,
+// the stream is always closed before returning.
+func (h *Handler) StreamLogs(name string, opts *corev1.PodLogOptions, w io.Writer) error {
+	if opts == nil {
+		opts = &corev1.PodLogOptions{}
+	}
+	readCloser, err := h.clientset.CoreV1().Pods(h.namespace).GetLogs(name, opts).Stream(h.ctx)
+	if err != nil {
+		return err
+	}
+	defer readCloser.Close()
+
+	if _, err = io.Copy(w, readCloser); err != nil && h.ctx.Err() != nil {
+		return h.ctx.Err()
+	}
+	return err
+}
+
+// CrashLogs gets the logs of the previous terminated container for every
+// container in CrashLoopBackOff of the pod, it works like
+// `kubectl logs name -c container --previous`.
+// The returned map is keyed by the container name.
+func (h *Handler) CrashLogs(name string) (map[string]string, error) {
+	pod, err := h.Get(name)
+	if err != nil {
+		return nil, err
+	}
+	containers := crashLoopingContainers(pod)
+	if len(containers) == 0 {
+		return nil, fmt.Errorf("no container of pod/%s is in CrashLoopBackOff", name)
+	}
+
+	logs := make(map[string]string)
+	for _, container := range containers {
+		buf := &bytes.Buffer{}
+		logOptions := &LogOptions{
+			PodLogOptions: corev1.PodLogOptions{Container: container, Previous: true},
+			Writer:        buf,
+			NewLine:       true,
+		}
+		if err := h.getLog(pod.Namespace, pod.Name, logOptions); err != nil {
+			return nil, err
+		}
+		logs[container] = buf.String()
+	}
+	return logs, nil
+}
+
+// crashLoopingContainers returns the containers and init containers of the
+// pod that are waiting with the reason "CrashLoopBackOff".
+func crashLoopingContainers(pod *corev1.Pod) []string {
+	var containers []string
+	statuses := append(append([]corev1.ContainerStatus{}, pod.Status.InitContainerStatuses...), pod.Status.ContainerStatuses...)
+	for _, status := range statuses {
+		if status.State.Waiting != nil && status.State.Waiting.Reason == "CrashLoopBackOff" {
+			containers = append(containers, status.Name)
+		}
+	}
+	return containers
+}
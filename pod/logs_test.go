@@ -0,0 +1,157 @@
+package pod
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"path"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/forbearing/k8s/types"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/rest"
+)
+
+// newTestHandler creates a pod handler in namespace "test", whose clientset
+// sends the requests to the fake kubernetes API server serve.
+func newTestHandler(t *testing.T, serve http.HandlerFunc) *Handler {
+	srv := httptest.NewServer(serve)
+	t.Cleanup(srv.Close)
+	config := &rest.Config{
+		Host:    srv.URL,
+		APIPath: "api",
+		ContentConfig: rest.ContentConfig{
+			GroupVersion:         &corev1.SchemeGroupVersion,
+			NegotiatedSerializer: scheme.Codecs,
+		},
+	}
+	clientset, err := kubernetes.NewForConfig(config)
+	if err != nil {
+		t.Fatal(err)
+	}
+	restClient, err := rest.RESTClientFor(config)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return &Handler{
+		ctx:             context.Background(),
+		namespace:       "test",
+		config:          config,
+		restClient:      restClient,
+		clientset:       clientset,
+		discoveryClient: clientset.DiscoveryClient,
+		Options:         &types.HandlerOptions{},
+	}
+}
+
+func TestGetLogs(t *testing.T) {
+	var query url.Values
+	h := newTestHandler(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/api/v1/namespaces/test/pods/nginx/log" {
+			t.Errorf("unexpected request %s", r.URL.Path)
+		}
+		query = r.URL.Query()
+		io.WriteString(w, "line 1\nline 2\n")
+	})
+
+	tailLines, sinceSeconds := int64(10), int64(60)
+	logs, err := h.GetLogs("nginx", &corev1.PodLogOptions{Container: "web", TailLines: &tailLines, SinceSeconds: &sinceSeconds})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(logs) != "line 1\nline 2\n" {
+		t.Errorf("GetLogs() = %q", logs)
+	}
+	want := url.Values{"container": {"web"}, "tailLines": {"10"}, "sinceSeconds": {"60"}}
+	for key := range want {
+		if query.Get(key) != want.Get(key) {
+			t.Errorf("query %s = %q, want %q", key, query.Get(key), want.Get(key))
+		}
+	}
+
+	buf := &bytes.Buffer{}
+	if err := h.StreamLogs("nginx", &corev1.PodLogOptions{Follow: true}, buf); err != nil {
+		t.Fatal(err)
+	}
+	if buf.String() != "line 1\nline 2\n" {
+		t.Errorf("StreamLogs() = %q", buf.String())
+	}
+	if query.Get("follow") != "true" {
+		t.Errorf("query follow = %q, want true", query.Get("follow"))
+	}
+}
+
+func TestStreamLogsCancel(t *testing.T) {
+	h := newTestHandler(t, func(w http.ResponseWriter, r *http.Request) {
+		io.WriteString(w, "line 1\n")
+		w.(http.Flusher).Flush()
+		// follow the logs until the client goes away.
+		<-r.Context().Done()
+	})
+	ctx, cancel := context.WithCancel(context.Background())
+	h.ctx = ctx
+
+	pr, pw := io.Pipe()
+	errCh := make(chan error, 1)
+	go func() {
+		errCh <- h.StreamLogs("nginx", &corev1.PodLogOptions{Follow: true}, pw)
+	}()
+	line := make([]byte, len("line 1\n"))
+	if _, err := io.ReadFull(pr, line); err != nil {
+		t.Fatal(err)
+	}
+	cancel()
+	go io.Copy(io.Discard, pr)
+	if err := <-errCh; err != context.Canceled {
+		t.Errorf("StreamLogs() = %v, want context.Canceled", err)
+	}
+}
+
+func TestCrashLogs(t *testing.T) {
+	// the init container "migrate" and the container "app" of pod "nginx" are
+	// crash looping, pod "healthy" is running.
+	pods := map[string]string{
+		"nginx": `{"kind":"Pod","apiVersion":"v1","metadata":{"name":"nginx","namespace":"test"},"status":{
+"initContainerStatuses":[{"name":"migrate","state":{"waiting":{"reason":"CrashLoopBackOff"}}}],
+"containerStatuses":[
+{"name":"app","state":{"waiting":{"reason":"CrashLoopBackOff"}},"lastState":{"terminated":{"exitCode":1}}},
+{"name":"sidecar","state":{"running":{}}},
+{"name":"pulling","state":{"waiting":{"reason":"ImagePullBackOff"}}}]}}`,
+		"healthy": `{"kind":"Pod","apiVersion":"v1","metadata":{"name":"healthy","namespace":"test"},"status":{
+"containerStatuses":[{"name":"app","state":{"running":{}}}]}}`,
+	}
+	h := newTestHandler(t, func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/api/v1/namespaces/test/pods/nginx", "/api/v1/namespaces/test/pods/healthy":
+			w.Header().Set("Content-Type", "application/json")
+			io.WriteString(w, pods[path.Base(r.URL.Path)])
+		case "/api/v1/namespaces/test/pods/nginx/log":
+			if previous := r.URL.Query().Get("previous"); previous != "true" {
+				t.Errorf("query previous = %q, want true", previous)
+			}
+			fmt.Fprintf(w, "%s crashed\nexit 1", r.URL.Query().Get("container"))
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+	})
+
+	logs, err := h.CrashLogs("nginx")
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := map[string]string{"migrate": "migrate crashed\nexit 1\n", "app": "app crashed\nexit 1\n"}
+	if !reflect.DeepEqual(logs, want) {
+		t.Errorf("CrashLogs() = %q, want %q", logs, want)
+	}
+	if _, err := h.CrashLogs("healthy"); err == nil || !strings.Contains(err.Error(), "CrashLoopBackOff") {
+		t.Errorf("CrashLogs(healthy) = %v, want no container in CrashLoopBackOff error", err)
+	}
+}
@@ -0,0 +1,24 @@
+package pod
+
+import (
+	"io"
+	"net/http"
+	"testing"
+)
+
+func TestForwardPorts(t *testing.T) {
+	var method, path string
+	h := newTestHandler(t, func(w http.ResponseWriter, r *http.Request) {
+		method, path = r.Method, r.URL.Path
+		// refuse to upgrade the connection.
+		http.Error(w, "upgrade refused", http.StatusForbidden)
+	})
+
+	stopCh, readyCh := make(chan struct{}), make(chan struct{})
+	if err := h.ForwardPorts("nginx", []string{"0:80"}, stopCh, readyCh, io.Discard, io.Discard); err == nil {
+		t.Fatal("ForwardPorts() should fail when the connection is not upgraded")
+	}
+	if method != http.MethodPost || path != "/api/v1/namespaces/test/pods/nginx/portforward" {
+		t.Errorf("dialer requested %s %s, want POST /api/v1/namespaces/test/pods/nginx/portforward", method, path)
+	}
+}
@@ -0,0 +1,58 @@
+package pod
+
+import (
+	corev1 "k8s.io/api/core/v1"
+)
+
+// ContainerStatuses gets the statuses of all the containers of the pod, the
+// init container statuses come first, followed by the container statuses, in
+// the order of the containers in the pod spec. The statuses of the containers
+// not yet created by kubelet are absent.
+func (h *Handler) ContainerStatuses(name string) ([]corev1.ContainerStatus, error) {
+	pod, err := h.Get(name)
+	if err != nil {
+		return nil, err
+	}
+	statuses := make([]corev1.ContainerStatus, 0, len(pod.Status.InitContainerStatuses)+len(pod.Status.ContainerStatuses))
+	statuses = append(statuses, pod.Status.InitContainerStatuses...)
+	statuses = append(statuses, pod.Status.ContainerStatuses...)
+	return statuses, nil
+}
+
+// RestartCount gets the total restart count of the pod, that is the sum of
+// the restart counts of all its containers, including the init containers.
+func (h *Handler) RestartCount(name string) (int32, error) {
+	statuses, err := h.ContainerStatuses(name)
+	if err != nil {
+		return 0, err
+	}
+	var restarts int32
+	for _, status := range statuses {
+		restarts += status.RestartCount
+	}
+	return restarts, nil
+}
+
+// Ready checks whether the pod is ready, that is the pod is running and its
+// Ready condition is true, which means all its containers are ready.
+// Unlike IsReady, the error getting the pod is returned.
+func (h *Handler) Ready(name string) (bool, error) {
+	pod, err := h.Get(name)
+	if err != nil {
+		return false, err
+	}
+	return isPodReady(pod), nil
+}
+
+// isPodReady returns true if the pod is running and its Ready condition is true.
+func isPodReady(pod *corev1.Pod) bool {
+	if pod.Status.Phase != corev1.PodRunning {
+		return false
+	}
+	for _, cond := range pod.Status.Conditions {
+		if cond.Type == corev1.PodReady {
+			return cond.Status == corev1.ConditionTrue
+		}
+	}
+	return false
+}
@@ -0,0 +1,69 @@
+package pod
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+)
+
+func TestContainerStatuses(t *testing.T) {
+	pods := map[string]string{
+		// nginx has an init container and two containers, the sidecar isn't ready.
+		"nginx": `{"kind":"Pod","apiVersion":"v1","metadata":{"name":"nginx","namespace":"test"},"status":{"phase":"Running",` +
+			`"conditions":[{"type":"Initialized","status":"True"},{"type":"Ready","status":"False"}],` +
+			`"initContainerStatuses":[{"name":"init","ready":true,"restartCount":1}],` +
+			`"containerStatuses":[{"name":"nginx","ready":true,"restartCount":2},{"name":"sidecar","ready":false,"restartCount":3}]}}`,
+		"redis": `{"kind":"Pod","apiVersion":"v1","metadata":{"name":"redis","namespace":"test"},"status":{"phase":"Running",` +
+			`"conditions":[{"type":"Ready","status":"True"}],` +
+			`"containerStatuses":[{"name":"redis","ready":true,"restartCount":0}]}}`,
+	}
+	h := newTestHandler(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		for name, pod := range pods {
+			if r.URL.Path == "/api/v1/namespaces/test/pods/"+name {
+				fmt.Fprintln(w, pod)
+				return
+			}
+		}
+		w.WriteHeader(http.StatusNotFound)
+		fmt.Fprintln(w, `{"kind":"Status","apiVersion":"v1","status":"Failure","reason":"NotFound","code":404}`)
+	})
+
+	tests := []struct {
+		name         string
+		wantNames    []string
+		wantRestarts int32
+		wantReady    bool
+	}{
+		{"nginx", []string{"init", "nginx", "sidecar"}, 6, false},
+		{"redis", []string{"redis"}, 0, true},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			statuses, err := h.ContainerStatuses(test.name)
+			if err != nil {
+				t.Fatal(err)
+			}
+			var names []string
+			for _, status := range statuses {
+				names = append(names, status.Name)
+			}
+			if fmt.Sprint(names) != fmt.Sprint(test.wantNames) {
+				t.Errorf("ContainerStatuses() = %v, want %v", names, test.wantNames)
+			}
+			if restarts, err := h.RestartCount(test.name); err != nil || restarts != test.wantRestarts {
+				t.Errorf("RestartCount() = %d, %v, want %d", restarts, err, test.wantRestarts)
+			}
+			if ready, err := h.Ready(test.name); err != nil || ready != test.wantReady {
+				t.Errorf("Ready() = %v, %v, want %v", ready, err, test.wantReady)
+			}
+			if ready := h.IsReady(test.name); ready != test.wantReady {
+				t.Errorf("IsReady() = %v, want %v", ready, test.wantReady)
+			}
+		})
+	}
+
+	if _, err := h.Ready("missing"); err == nil {
+		t.Error("expected Ready to fail for the missing pod")
+	}
+}
@@ -0,0 +1,160 @@
+package pod
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"io"
+	"sync"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/watch"
+	"k8s.io/client-go/tools/cache"
+)
+
+// maxLogLineSize is the max size of a log line tailed by TailLogs, the longer
+// line stops the stream of the container.
+const maxLogLineSize = 1024 * 1024
+
+// TailLogs tails the logs of all containers in all pods selected by the label
+// selector concurrently, it works like `stern` or `kubectl logs -f -l selector`.
+//
+// Every log line is prefixed with "pod/container " and the lines of all
+// containers are merged into w. The pods coming and going are tracked by an
+// informer, a new stream is opened when a pod is added or a container is
+// restarted, the streams of a pod are closed when the pod is deleted.
+//
+// opts.Follow is always true and opts.Container is ignored.
+// TailLogs blocks until the handler context is done, then returns the
+// handler context error.
+func (h *Handler) TailLogs(selector string, opts corev1.PodLogOptions, w io.Writer) error {
+	ctx, cancel := context.WithCancel(h.ctx)
+	defer cancel()
+
+	t := &tailer{
+		h:    h,
+		ctx:  ctx,
+		opts: opts,
+		w:    w,
+		pods: make(map[types.UID]*podStreams),
+	}
+	lw := &cache.ListWatch{
+		ListFunc: func(options metav1.ListOptions) (runtime.Object, error) {
+			options.LabelSelector = selector
+			return h.clientset.CoreV1().Pods(h.namespace).List(ctx, options)
+		},
+		WatchFunc: func(options metav1.ListOptions) (watch.Interface, error) {
+			options.LabelSelector = selector
+			return h.clientset.CoreV1().Pods(h.namespace).Watch(ctx, options)
+		},
+	}
+	informer := cache.NewSharedIndexInformer(lw, &corev1.Pod{}, 0, cache.Indexers{})
+	informer.AddEventHandler(cache.ResourceEventHandlerFuncs{
+		AddFunc:    func(obj interface{}) { t.tail(obj) },
+		UpdateFunc: func(_, newObj interface{}) { t.tail(newObj) },
+		DeleteFunc: func(obj interface{}) { t.stop(obj) },
+	})
+	informer.Run(ctx.Done())
+	t.wg.Wait()
+	return h.ctx.Err()
+}
+
+// tailer tracks the log streams of the pods.
+type tailer struct {
+	h    *Handler
+	ctx  context.Context
+	opts corev1.PodLogOptions
+	w    io.Writer
+
+	pods map[types.UID]*podStreams
+
+	wl sync.Mutex // protects w
+	l  sync.Mutex // protects pods
+	wg sync.WaitGroup
+}
+
+// podStreams records the opened log streams of a pod.
+type podStreams struct {
+	ctx    context.Context
+	cancel context.CancelFunc
+	// opened is keyed by "container/restartCount", so a restarted container
+	// gets a new stream.
+	opened map[string]bool
+}
+
+// tail opens streams for the running containers of the pod that have no stream yet.
+func (t *tailer) tail(obj interface{}) {
+	pod, ok := obj.(*corev1.Pod)
+	if !ok {
+		return
+	}
+	t.l.Lock()
+	defer t.l.Unlock()
+
+	ps, ok := t.pods[pod.UID]
+	if !ok {
+		ctx, cancel := context.WithCancel(t.ctx)
+		ps = &podStreams{ctx: ctx, cancel: cancel, opened: make(map[string]bool)}
+		t.pods[pod.UID] = ps
+	}
+	for _, status := range pod.Status.ContainerStatuses {
+		if status.State.Running == nil {
+			continue
+		}
+		key := fmt.Sprintf("%s/%d", status.Name, status.RestartCount)
+		if ps.opened[key] {
+			continue
+		}
+		ps.opened[key] = true
+		t.wg.Add(1)
+		go t.stream(ps.ctx, pod.Namespace, pod.Name, status.Name)
+	}
+}
+
+// stop closes all streams of the deleted pod.
+func (t *tailer) stop(obj interface{}) {
+	if tombstone, ok := obj.(cache.DeletedFinalStateUnknown); ok {
+		obj = tombstone.Obj
+	}
+	pod, ok := obj.(*corev1.Pod)
+	if !ok {
+		return
+	}
+	t.l.Lock()
+	defer t.l.Unlock()
+	if ps, ok := t.pods[pod.UID]; ok {
+		ps.cancel()
+		delete(t.pods, pod.UID)
+	}
+}
+
+// stream copies the logs of the container to w line by line, every line is
+// prefixed with "pod/container ".
+func (t *tailer) stream(ctx context.Context, namespace, name, container string) {
+	defer t.wg.Done()
+
+	opts := t.opts.DeepCopy()
+	opts.Container = container
+	opts.Follow = true
+	readCloser, err := t.h.clientset.CoreV1().Pods(namespace).GetLogs(name, opts).Stream(ctx)
+	if err != nil {
+		t.h.log().Error(fmt.Sprintf("tail logs of pod/%s container/%s: %s", name, container, err))
+		return
+	}
+	defer readCloser.Close()
+
+	prefix := name + "/" + container
+	scanner := bufio.NewScanner(readCloser)
+	scanner.Buffer(make([]byte, 0, bufio.MaxScanTokenSize), maxLogLineSize)
+	for scanner.Scan() {
+		t.wl.Lock()
+		fmt.Fprintf(t.w, "%s %s\n", prefix, scanner.Text())
+		t.wl.Unlock()
+	}
+	if err := scanner.Err(); err != nil && ctx.Err() == nil {
+		t.h.log().Error(fmt.Sprintf("tail logs of pod/%s container/%s: %s", name, container, err))
+	}
+}
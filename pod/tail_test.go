@@ -0,0 +1,91 @@
+package pod
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+)
+
+// syncBuffer is a bytes.Buffer safe for the concurrent writes and reads.
+type syncBuffer struct {
+	mu  sync.Mutex
+	buf bytes.Buffer
+}
+
+func (b *syncBuffer) Write(p []byte) (int, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.buf.Write(p)
+}
+
+func (b *syncBuffer) String() string {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.buf.String()
+}
+
+func TestTailLogs(t *testing.T) {
+	// the line longer than the default 64KiB limit of bufio.Scanner.
+	longLine := strings.Repeat("x", 100*1024)
+	done := make(chan struct{})
+	h := newTestHandler(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case r.URL.Path == "/api/v1/namespaces/test/pods/nginx/log":
+			if r.URL.Query().Get("container") != "web" || r.URL.Query().Get("follow") != "true" {
+				t.Errorf("log query = %s, want container=web and follow=true", r.URL.RawQuery)
+			}
+			fmt.Fprintf(w, "hello\n%s\n", longLine)
+		case r.URL.Path != "/api/v1/namespaces/test/pods":
+			t.Errorf("unexpected request %s", r.URL)
+			return
+		case r.URL.Query().Get("labelSelector") != "app=nginx":
+			t.Errorf("labelSelector = %q, want app=nginx", r.URL.Query().Get("labelSelector"))
+		case r.URL.Query().Get("watch") != "true":
+			fmt.Fprintln(w, `{"kind":"PodList","apiVersion":"v1","metadata":{"resourceVersion":"1"},"items":[`+
+				`{"metadata":{"name":"nginx","namespace":"test","uid":"1"},`+
+				`"status":{"containerStatuses":[{"name":"web","state":{"running":{}}}]}}]}`)
+			return
+		}
+		// keep the watch connection open until the test is done.
+		w.(http.Flusher).Flush()
+		select {
+		case <-r.Context().Done():
+		case <-done:
+		}
+	})
+	defer close(done)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	h.ctx = ctx
+
+	out := &syncBuffer{}
+	errCh := make(chan error, 1)
+	go func() { errCh <- h.TailLogs("app=nginx", corev1.PodLogOptions{}, out) }()
+
+	want := "nginx/web hello\nnginx/web " + longLine + "\n"
+	deadline := time.After(5 * time.Second)
+	for out.String() != want {
+		select {
+		case <-deadline:
+			t.Fatalf("tailed logs = %.100q, want %.100q", out.String(), want)
+		case <-time.After(10 * time.Millisecond):
+		}
+	}
+	cancel()
+	select {
+	case err := <-errCh:
+		if err != context.Canceled {
+			t.Errorf("TailLogs() = %v, want context.Canceled", err)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("TailLogs() didn't return after the context is cancelled")
+	}
+}
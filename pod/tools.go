@@ -9,15 +9,14 @@ import (
 	"os"
 	"os/signal"
 	"reflect"
-	"strings"
 	"syscall"
 	"time"
 
 	"github.com/forbearing/k8s/util/signals"
-	log "github.com/sirupsen/logrus"
 	corev1 "k8s.io/api/core/v1"
 	k8serrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/httpstream"
 	"k8s.io/apimachinery/pkg/watch"
 	"k8s.io/client-go/kubernetes/scheme"
 	"k8s.io/client-go/tools/portforward"
@@ -35,29 +34,8 @@ type PodController struct {
 
 // IsReady check whether the pod is ready.
 func (h *Handler) IsReady(name string) bool {
-	checkPhase := func(pod *corev1.Pod) bool {
-		if pod.Status.Phase == corev1.PodRunning {
-			return true
-		}
-		return false
-	}
-	checkCondition := func(pod *corev1.Pod) bool {
-		for _, cond := range pod.Status.Conditions {
-			if cond.Type == corev1.PodReady && cond.Status == corev1.ConditionTrue {
-				return true
-			}
-		}
-		return false
-	}
-
-	pod, err := h.Get(name)
-	if err != nil {
-		return false
-	}
-	if checkPhase(pod) && checkCondition(pod) {
-		return true
-	}
-	return false
+	ready, err := h.Ready(name)
+	return err == nil && ready
 }
 
 // WaitReady waiting for the pod to be in the ready status.
@@ -97,7 +75,7 @@ func (h *Handler) WaitReady(name string) error {
 			if err == nil {
 				return
 			}
-			log.Error(err)
+			h.log().Error(err)
 			time.Sleep(time.Second * 10)
 		}
 	}(ctxCheck)
@@ -127,13 +105,13 @@ func (h *Handler) WaitReady(name string) error {
 					errCh <- fmt.Errorf("pod/%s was deleted", name)
 					return
 				case watch.Bookmark:
-					log.Debug("watch pod: bookmark")
+					h.log().Debug("watch pod: bookmark")
 				case watch.Error:
-					log.Debug("watch pod: error")
+					h.log().Debug("watch pod: error")
 				}
 			}
 			// If event channel is closed, it means the kube-apiserver has closed the connection.
-			log.Debug("watch pod: reconnect to kubernetes")
+			h.log().Debug("watch pod: reconnect to kubernetes")
 			watcher.Stop()
 		}
 	}(ctxWatch)
@@ -704,14 +682,10 @@ func (h *Handler) ExecuteWithStream(podName, containerName string, command []str
 
 // PortForward forward a local port to the pod.
 func (h *Handler) PortForward(podName string, localPort, remotePort uint32, stopChan ...<-chan struct{}) error {
-	roundTripper, upgrader, err := spdy.RoundTripperFor(h.config)
+	dialer, err := h.portForwardDialer(podName)
 	if err != nil {
 		return err
 	}
-	apiPath := fmt.Sprintf("/api/v1/namespaces/%s/pods/%s/portforward", h.namespace, podName)
-	hostIP := strings.TrimLeft(h.config.Host, "https:/")
-	serverURL := url.URL{Scheme: "https", Path: apiPath, Host: hostIP}
-	dialer := spdy.NewDialer(upgrader, &http.Client{Transport: roundTripper}, http.MethodPost, &serverURL)
 
 	var stopCh <-chan struct{}
 	if len(stopChan) == 0 {
@@ -730,14 +704,10 @@ func (h *Handler) PortForward(podName string, localPort, remotePort uint32, stop
 
 // PortForwardWithStreama forward a local port to the pod, and you should provide the stdout, stderr.
 func (h *Handler) PortForwardWithStream(podName string, localPort, remotePort uint32, stdout, stderr io.Writer, stopChan ...<-chan struct{}) error {
-	roundTripper, upgrader, err := spdy.RoundTripperFor(h.config)
+	dialer, err := h.portForwardDialer(podName)
 	if err != nil {
 		return err
 	}
-	apiPath := fmt.Sprintf("/api/v1/namespaces/%s/pods/%s/portforward", h.namespace, podName)
-	hostIP := strings.TrimLeft(h.config.Host, "https:/")
-	serverURL := url.URL{Scheme: "https", Path: apiPath, Host: hostIP}
-	dialer := spdy.NewDialer(upgrader, &http.Client{Transport: roundTripper}, http.MethodPost, &serverURL)
 
 	var stopCh <-chan struct{}
 	if len(stopChan) == 0 {
@@ -753,3 +723,42 @@ func (h *Handler) PortForwardWithStream(podName string, localPort, remotePort ui
 	}
 	return forwarder.ForwardPorts()
 }
+
+// ForwardPorts forwards the local ports to the pod, it works like
+// `kubectl port-forward podName 8080:80 9090`. Every port is in the format
+// "localPort:remotePort", or "port" if the local and remote ports are the same.
+//
+// ForwardPorts blocks until stopCh is closed, readyCh is closed once the
+// forwarding is established. The forwarding messages and errors are written
+// to out and errOut.
+func (h *Handler) ForwardPorts(podName string, ports []string, stopCh, readyCh chan struct{}, out, errOut io.Writer) error {
+	dialer, err := h.portForwardDialer(podName)
+	if err != nil {
+		return err
+	}
+	forwarder, err := portforward.New(dialer, ports, stopCh, readyCh, out, errOut)
+	if err != nil {
+		return err
+	}
+	return forwarder.ForwardPorts()
+}
+
+// portForwardDialer creates a SPDY dialer connecting to the pods/portforward
+// subresource of the pod.
+func (h *Handler) portForwardDialer(podName string) (httpstream.Dialer, error) {
+	roundTripper, upgrader, err := spdy.RoundTripperFor(h.config)
+	if err != nil {
+		return nil, err
+	}
+	return spdy.NewDialer(upgrader, &http.Client{Transport: roundTripper}, http.MethodPost, h.portForwardURL(podName)), nil
+}
+
+// portForwardURL returns the url of the pods/portforward subresource of the pod.
+func (h *Handler) portForwardURL(podName string) *url.URL {
+	return h.restClient.Post().
+		Namespace(h.namespace).
+		Resource("pods").
+		Name(podName).
+		SubResource("portforward").
+		URL()
+}
@@ -2,6 +2,7 @@ package pod
 
 import (
 	"errors"
+	"fmt"
 	"io"
 	"os"
 
@@ -9,6 +10,7 @@ import (
 	"k8s.io/client-go/tools/remotecommand"
 )
 
+// ErrEvictionBlocked is matched by the EvictionBlockedError.
 var (
 	ErrInvalidToolsType  = errors.New("type must be string, *corev1.Pod, corev1.Pod, metav1.Object or runtime.Object")
 	ErrInvalidCreateType = errors.New("type must be string, []byte, *corev1.Pod, corev1.Pod, metav1.Object, runtime.Object, *unstructured.Unstructured, unstructured.Unstructured or map[string]interface{}")
@@ -18,8 +20,26 @@ var (
 	ErrInvalidGetType    = ErrInvalidCreateType
 	ErrInvalidLogType    = ErrInvalidCreateType
 	ErrInvalidPatchType  = errors.New("patch data type must be string, []byte, *corev1.Pod, corev1.Pod, metav1.Object, runtime.Object, *unstructured.Unstructured, unstructured.Unstructured or map[string]interface{}")
+	ErrInvalidObjectType = errors.New("object type is not *corev1.Pod")
+	ErrEvictionBlocked   = errors.New("eviction is blocked by PodDisruptionBudget, retry later")
 )
 
+// EvictionBlockedError is the error of the eviction blocked by
+// PodDisruptionBudget, it matches ErrEvictionBlocked by errors.Is and unwraps
+// to the error returned by kubernetes API server, so k8serrors.IsTooManyRequests
+// and errors.As to *k8serrors.StatusError work.
+type EvictionBlockedError struct {
+	Err error
+}
+
+func (e *EvictionBlockedError) Error() string {
+	return fmt.Sprintf("%s: %s", ErrEvictionBlocked.Error(), e.Err.Error())
+}
+
+func (e *EvictionBlockedError) Unwrap() error { return e.Err }
+
+func (e *EvictionBlockedError) Is(target error) bool { return target == ErrEvictionBlocked }
+
 type PtyHandler interface {
 	io.Reader
 	io.Writer
@@ -1,8 +1,6 @@
 package replicaset
 
 import (
-	"fmt"
-
 	appsv1 "k8s.io/api/apps/v1"
 	k8serrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -58,7 +56,7 @@ func (h *Handler) ApplyFromBytes(data []byte) (rs *appsv1.ReplicaSet, err error)
 func (h *Handler) ApplyFromObject(obj interface{}) (*appsv1.ReplicaSet, error) {
 	rs, ok := obj.(*appsv1.ReplicaSet)
 	if !ok {
-		return nil, fmt.Errorf("object type is not *appsv1.ReplicaSet")
+		return nil, ErrInvalidObjectType
 	}
 	return h.applyReplicaset(rs)
 }
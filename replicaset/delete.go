@@ -2,7 +2,6 @@ package replicaset
 
 import (
 	"encoding/json"
-	"fmt"
 	"io/ioutil"
 
 	appsv1 "k8s.io/api/apps/v1"
@@ -73,7 +72,7 @@ func (h *Handler) DeleteFromBytes(data []byte) error {
 func (h *Handler) DeleteFromObject(obj interface{}) error {
 	rs, ok := obj.(*appsv1.ReplicaSet)
 	if !ok {
-		return fmt.Errorf("object type is not *appsv1.ReplicaSet")
+		return ErrInvalidObjectType
 	}
 	return h.deleteReplicaset(rs)
 }
@@ -0,0 +1,13 @@
+package replicaset
+
+import (
+	"github.com/forbearing/k8s/types"
+	appsv1 "k8s.io/api/apps/v1"
+)
+
+// Generic returns the handler as a types.Handler, whose methods return
+// runtime.Object instead of *appsv1.ReplicaSet, so it can be used together with
+// the handlers of other resources.
+func (h *Handler) Generic() types.Handler {
+	return types.NewGeneric[*appsv1.ReplicaSet](h, GVK)
+}
@@ -2,7 +2,6 @@ package replicaset
 
 import (
 	"encoding/json"
-	"fmt"
 	"io/ioutil"
 
 	appsv1 "k8s.io/api/apps/v1"
@@ -73,7 +72,7 @@ func (h *Handler) GetFromBytes(data []byte) (*appsv1.ReplicaSet, error) {
 func (h *Handler) GetFromObject(obj interface{}) (*appsv1.ReplicaSet, error) {
 	rs, ok := obj.(*appsv1.ReplicaSet)
 	if !ok {
-		return nil, fmt.Errorf("object type is not *appsv1.ReplicaSet")
+		return nil, ErrInvalidObjectType
 	}
 	return h.getReplicaset(rs)
 }
@@ -8,7 +8,6 @@ import (
 	"syscall"
 	"time"
 
-	log "github.com/sirupsen/logrus"
 	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
 	k8serrors "k8s.io/apimachinery/pkg/api/errors"
@@ -90,7 +89,7 @@ func (h *Handler) WaitReady(name string) error {
 			if err == nil {
 				return
 			}
-			log.Error(err)
+			h.log().Error(err)
 			time.Sleep(time.Second * 10)
 		}
 	}(ctxCheck)
@@ -120,13 +119,13 @@ func (h *Handler) WaitReady(name string) error {
 					errCh <- fmt.Errorf("replicaset/%s was deleted", name)
 					return
 				case watch.Bookmark:
-					log.Debug("watch replicaset: bookmark")
+					h.log().Debug("watch replicaset: bookmark")
 				case watch.Error:
-					log.Debug("watch replicaset: error")
+					h.log().Debug("watch replicaset: error")
 				}
 			}
 			// If event channel is closed, it means the kube-apiserver has closed the connection.
-			log.Debug("watch replicaset: reconnect to kubernetes")
+			h.log().Debug("watch replicaset: reconnect to kubernetes")
 			watcher.Stop()
 		}
 	}(ctxWatch)
@@ -184,7 +183,8 @@ func (h *Handler) WaitReady(name string) error {
 //    }
 //}
 
-// GetPods get replicaset all pods
+// GetPods get replicaset all pods, they're the pods selected by the replicaset
+// selector and controlled by the replicaset.
 func (h *Handler) GetPods(object interface{}) ([]*corev1.Pod, error) {
 	switch val := object.(type) {
 	case string:
@@ -202,19 +202,27 @@ func (h *Handler) GetPods(object interface{}) ([]*corev1.Pod, error) {
 	}
 }
 func (h *Handler) getPods(rs *appsv1.ReplicaSet) ([]*corev1.Pod, error) {
+	selector, err := metav1.LabelSelectorAsSelector(rs.Spec.Selector)
+	if err != nil {
+		return nil, err
+	}
+	namespace := rs.Namespace
+	if len(namespace) == 0 {
+		namespace = h.namespace
+	}
 	listOptions := h.Options.ListOptions.DeepCopy()
-	listOptions.LabelSelector = ""
-	podList, err := h.clientset.CoreV1().Pods(h.namespace).List(h.ctx, *listOptions)
+	listOptions.LabelSelector = selector.String()
+	podList, err := h.clientset.CoreV1().Pods(namespace).List(h.ctx, *listOptions)
 	if err != nil {
 		return nil, err
 	}
 
+	// the pods selected by the selector may be controlled by the other
+	// replicaset with an overlapping selector.
 	var pl []*corev1.Pod
 	for i := range podList.Items {
-		for _, or := range podList.Items[i].OwnerReferences {
-			if or.Name == rs.Name {
-				pl = append(pl, &podList.Items[i])
-			}
+		if ref := metav1.GetControllerOf(&podList.Items[i]); ref != nil && ref.UID == rs.UID {
+			pl = append(pl, &podList.Items[i])
 		}
 	}
 	return pl, nil
@@ -284,3 +292,38 @@ func (h *Handler) GetAge(object interface{}) (time.Duration, error) {
 		return time.Duration(0), ErrInvalidToolsType
 	}
 }
+
+// GetOwnerDeployment gets the deployment that owns the replicaset, following
+// the replicaset controller ownerReference. ErrNoOwnerDeployment is returned
+// if the replicaset is orphaned, that is it has no controller, its controller
+// is not a deployment, or the owner deployment has been deleted.
+func (h *Handler) GetOwnerDeployment(name string) (*appsv1.Deployment, error) {
+	rs, err := h.Get(name)
+	if err != nil {
+		return nil, err
+	}
+	ref := metav1.GetControllerOf(rs)
+	if ref == nil || ref.Kind != "Deployment" {
+		return nil, fmt.Errorf("replicaset %s: %w", rs.Name, ErrNoOwnerDeployment)
+	}
+	deploy, err := h.clientset.AppsV1().Deployments(rs.Namespace).Get(h.ctx, ref.Name, h.Options.GetOptions)
+	if k8serrors.IsNotFound(err) {
+		return nil, fmt.Errorf("replicaset %s: %w", rs.Name, ErrNoOwnerDeployment)
+	}
+	if err != nil {
+		return nil, err
+	}
+	// the deployment with the same name is not the owner, it's recreated
+	// after the owner deployment was deleted.
+	if deploy.UID != ref.UID {
+		return nil, fmt.Errorf("replicaset %s: %w", rs.Name, ErrNoOwnerDeployment)
+	}
+	return deploy, nil
+}
+
+// Pods gets the pods managed by the replicaset, they're the pods selected by
+// the replicaset selector and controlled by the replicaset.
+// It's the same as GetPods(name).
+func (h *Handler) Pods(name string) ([]*corev1.Pod, error) {
+	return h.GetPods(name)
+}
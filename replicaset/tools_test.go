@@ -0,0 +1,102 @@
+package replicaset
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/forbearing/k8s/types"
+	appsv1 "k8s.io/api/apps/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/rest"
+)
+
+// objects is the replicasets, deployments and pods in namespace "test".
+// nginx-abc is owned by deployment nginx, orphan-abc has no owner, and the
+// owner deployment of redis-abc has been deleted and recreated.
+var objects = map[string]string{
+	"/apis/apps/v1/namespaces/test/replicasets/nginx-abc": `{"kind":"ReplicaSet","apiVersion":"apps/v1","metadata":{"name":"nginx-abc","namespace":"test","uid":"rs-1",` +
+		`"ownerReferences":[{"apiVersion":"apps/v1","kind":"Deployment","name":"nginx","uid":"deploy-1","controller":true}]},` +
+		`"spec":{"selector":{"matchLabels":{"app":"nginx"}}}}`,
+	"/apis/apps/v1/namespaces/test/replicasets/orphan-abc": `{"kind":"ReplicaSet","apiVersion":"apps/v1","metadata":{"name":"orphan-abc","namespace":"test","uid":"rs-2"},` +
+		`"spec":{"selector":{"matchLabels":{"app":"orphan"}}}}`,
+	"/apis/apps/v1/namespaces/test/replicasets/redis-abc": `{"kind":"ReplicaSet","apiVersion":"apps/v1","metadata":{"name":"redis-abc","namespace":"test","uid":"rs-3",` +
+		`"ownerReferences":[{"apiVersion":"apps/v1","kind":"Deployment","name":"redis","uid":"deploy-old","controller":true}]},` +
+		`"spec":{"selector":{"matchLabels":{"app":"redis"}}}}`,
+	"/apis/apps/v1/namespaces/test/deployments/nginx": `{"kind":"Deployment","apiVersion":"apps/v1","metadata":{"name":"nginx","namespace":"test","uid":"deploy-1"}}`,
+	"/apis/apps/v1/namespaces/test/deployments/redis": `{"kind":"Deployment","apiVersion":"apps/v1","metadata":{"name":"redis","namespace":"test","uid":"deploy-2"}}`,
+	// nginx-abc-2 is selected by the label selector, but controlled by the other replicaset.
+	"/api/v1/namespaces/test/pods": `{"kind":"PodList","apiVersion":"v1","metadata":{},"items":[
+{"metadata":{"name":"nginx-abc-1","namespace":"test","ownerReferences":[{"apiVersion":"apps/v1","kind":"ReplicaSet","name":"nginx-abc","uid":"rs-1","controller":true}]}},
+{"metadata":{"name":"nginx-abc-2","namespace":"test","ownerReferences":[{"apiVersion":"apps/v1","kind":"ReplicaSet","name":"nginx-def","uid":"rs-4","controller":true}]}}
+]}`,
+}
+
+func newTestHandler(t *testing.T) *Handler {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		object, ok := objects[r.URL.Path]
+		if !ok {
+			w.WriteHeader(http.StatusNotFound)
+			fmt.Fprintln(w, `{"kind":"Status","apiVersion":"v1","status":"Failure","reason":"NotFound","code":404}`)
+			return
+		}
+		if r.URL.Path == "/api/v1/namespaces/test/pods" {
+			if labelSelector := r.URL.Query().Get("labelSelector"); labelSelector != "app=nginx" {
+				t.Errorf("labelSelector = %q, want app=nginx", labelSelector)
+			}
+		}
+		fmt.Fprintln(w, object)
+	}))
+	t.Cleanup(srv.Close)
+	clientset, err := kubernetes.NewForConfig(&rest.Config{Host: srv.URL})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return &Handler{ctx: context.Background(), namespace: "test", clientset: clientset, Options: &types.HandlerOptions{}}
+}
+
+func TestGetOwnerDeployment(t *testing.T) {
+	h := newTestHandler(t)
+
+	deploy, err := h.GetOwnerDeployment("nginx-abc")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if deploy.Name != "nginx" {
+		t.Errorf("GetOwnerDeployment(nginx-abc) = %s, want nginx", deploy.Name)
+	}
+	for _, name := range []string{"orphan-abc", "redis-abc"} {
+		if _, err := h.GetOwnerDeployment(name); !errors.Is(err, ErrNoOwnerDeployment) {
+			t.Errorf("GetOwnerDeployment(%s) = %v, want ErrNoOwnerDeployment", name, err)
+		}
+	}
+}
+
+func TestPods(t *testing.T) {
+	h := newTestHandler(t)
+
+	pods, err := h.Pods("nginx-abc")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(pods) != 1 || pods[0].Name != "nginx-abc-1" {
+		t.Errorf("Pods(nginx-abc) got %d pods, want nginx-abc-1", len(pods))
+	}
+
+	// the replicaset passed without the namespace is in the handler namespace.
+	rs := &appsv1.ReplicaSet{
+		ObjectMeta: metav1.ObjectMeta{Name: "nginx-abc", UID: "rs-1"},
+		Spec:       appsv1.ReplicaSetSpec{Selector: &metav1.LabelSelector{MatchLabels: map[string]string{"app": "nginx"}}},
+	}
+	if pods, err = h.GetPods(rs); err != nil {
+		t.Fatal(err)
+	}
+	if len(pods) != 1 || pods[0].Name != "nginx-abc-1" {
+		t.Errorf("GetPods(nginx-abc) got %d pods, want nginx-abc-1", len(pods))
+	}
+}
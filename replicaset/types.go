@@ -2,6 +2,7 @@ package replicaset
 
 import "errors"
 
+// ErrNoOwnerDeployment is wrapped with the replicaset name.
 var (
 	ErrInvalidToolsType  = errors.New("type must be string, *appsv1.ReplicaSet, appsv1.ReplicaSet, metav1.Object or runtime.Object")
 	ErrInvalidCreateType = errors.New("type must be string, []byte, *appsv1.ReplicaSet, appsv1.ReplicaSet, metav1.Object, runtime.Object, *unstructured.Unstructured, unstructured.Unstructured or map[string]interface{}")
@@ -11,4 +12,6 @@ var (
 	ErrInvalidGetType    = ErrInvalidCreateType
 	ErrInvalidScaleType  = ErrInvalidCreateType
 	ErrInvalidPatchType  = errors.New("patch data type must be string, []byte, *appsv1.ReplicaSet, appsv1.ReplicaSet, metav1.Object, runtime.Object, *unstructured.Unstructured, unstructured.Unstructured or map[string]interface{}")
+	ErrInvalidObjectType = errors.New("object type is not *appsv1.ReplicaSet")
+	ErrNoOwnerDeployment = errors.New("replicaset is not owned by a deployment")
 )
@@ -2,7 +2,6 @@ package replicaset
 
 import (
 	"encoding/json"
-	"fmt"
 	"io/ioutil"
 
 	appsv1 "k8s.io/api/apps/v1"
@@ -65,7 +64,7 @@ func (h *Handler) UpdateFromBytes(data []byte) (*appsv1.ReplicaSet, error) {
 func (h *Handler) UpdateFromObject(obj interface{}) (*appsv1.ReplicaSet, error) {
 	rs, ok := obj.(*appsv1.ReplicaSet)
 	if !ok {
-		return nil, fmt.Errorf("object type is not *appsv1.ReplicaSet")
+		return nil, ErrInvalidObjectType
 	}
 	return h.updateReplicaset(rs)
 }
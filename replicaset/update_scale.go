@@ -2,7 +2,6 @@ package replicaset
 
 import (
 	"encoding/json"
-	"fmt"
 	"io/ioutil"
 
 	appsv1 "k8s.io/api/apps/v1"
@@ -81,7 +80,7 @@ func (h *Handler) ScaleFromBytes(data []byte, replicas int32) (*appsv1.ReplicaSe
 func (h *Handler) ScaleFromObject(obj interface{}, replicas int32) (*appsv1.ReplicaSet, error) {
 	rs, ok := obj.(*appsv1.ReplicaSet)
 	if !ok {
-		return nil, fmt.Errorf("object type is not *appsv1.ReplicaSet")
+		return nil, ErrInvalidObjectType
 	}
 	return h.ScaleByName(rs.Name, replicas)
 }
@@ -1,8 +1,6 @@
 package replicationcontroller
 
 import (
-	"fmt"
-
 	corev1 "k8s.io/api/core/v1"
 	k8serrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -58,7 +56,7 @@ func (h *Handler) ApplyFromBytes(data []byte) (rc *corev1.ReplicationController,
 func (h *Handler) ApplyFromObject(obj interface{}) (*corev1.ReplicationController, error) {
 	rc, ok := obj.(*corev1.ReplicationController)
 	if !ok {
-		return nil, fmt.Errorf("object type is not *corev1.ReplicationController")
+		return nil, ErrInvalidObjectType
 	}
 	return h.applyRS(rc)
 }
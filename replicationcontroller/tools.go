@@ -8,7 +8,6 @@ import (
 	"syscall"
 	"time"
 
-	log "github.com/sirupsen/logrus"
 	corev1 "k8s.io/api/core/v1"
 	k8serrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -89,7 +88,7 @@ func (h *Handler) WaitReady(name string) error {
 			if err == nil {
 				return
 			}
-			log.Error(err)
+			h.log().Error(err)
 			time.Sleep(time.Second * 10)
 		}
 	}(ctxCheck)
@@ -119,13 +118,13 @@ func (h *Handler) WaitReady(name string) error {
 					errCh <- fmt.Errorf("replicationcontroller/%s was deleted", name)
 					return
 				case watch.Bookmark:
-					log.Debug("watch replicationcontroller: bookmark")
+					h.log().Debug("watch replicationcontroller: bookmark")
 				case watch.Error:
-					log.Debug("watch replicationcontroller: error")
+					h.log().Debug("watch replicationcontroller: error")
 				}
 			}
 			// If event channel is closed, it means the kube-apiserver has closed the connection.
-			log.Debug("watch replicationcontroller: reconnect to kubernetes")
+			h.log().Debug("watch replicationcontroller: reconnect to kubernetes")
 			watcher.Stop()
 		}
 	}(ctxWatch)
@@ -11,4 +11,5 @@ var (
 	ErrInvalidGetType    = ErrInvalidCreateType
 	ErrInvalidScaleType  = ErrInvalidCreateType
 	ErrInvalidPatchType  = errors.New("patch data type must be string, []byte, *corev1.ReplicationController, corev1.ReplicationController, metav1.Object, runtime.Object, *unstructured.Unstructured, unstructured.Unstructured or map[string]interface{}")
+	ErrInvalidObjectType = errors.New("object type is not *corev1.ReplicationController")
 )
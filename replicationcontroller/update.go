@@ -2,7 +2,6 @@ package replicationcontroller
 
 import (
 	"encoding/json"
-	"fmt"
 	"io/ioutil"
 
 	corev1 "k8s.io/api/core/v1"
@@ -65,7 +64,7 @@ func (h *Handler) UpdateFromBytes(data []byte) (*corev1.ReplicationController, e
 func (h *Handler) UpdateFromObject(obj interface{}) (*corev1.ReplicationController, error) {
 	rc, ok := obj.(*corev1.ReplicationController)
 	if !ok {
-		return nil, fmt.Errorf("object type is not *corev1.ReplicationController")
+		return nil, ErrInvalidObjectType
 	}
 	return h.updateRS(rc)
 }
@@ -2,7 +2,6 @@ package replicationcontroller
 
 import (
 	"encoding/json"
-	"fmt"
 	"io/ioutil"
 
 	corev1 "k8s.io/api/core/v1"
@@ -81,7 +80,7 @@ func (h *Handler) ScaleFromBytes(data []byte, replicas int32) (*corev1.Replicati
 func (h *Handler) ScaleFromObject(obj interface{}, replicas int32) (*corev1.ReplicationController, error) {
 	rc, ok := obj.(*corev1.ReplicationController)
 	if !ok {
-		return nil, fmt.Errorf("object type is not *corev1.ReplicationController")
+		return nil, ErrInvalidObjectType
 	}
 	return h.ScaleByName(rc.Name, replicas)
 }
@@ -0,0 +1,91 @@
+package resourcequota
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+// Apply applies resourcequota from type string, []byte, *corev1.ResourceQuota,
+// corev1.ResourceQuota, metav1.Object, runtime.Object, *unstructured.Unstructured,
+// unstructured.Unstructured or map[string]interface{}.
+func (h *Handler) Apply(obj interface{}) (*corev1.ResourceQuota, error) {
+	switch val := obj.(type) {
+	case string:
+		return h.ApplyFromFile(val)
+	case []byte:
+		return h.ApplyFromBytes(val)
+	case *corev1.ResourceQuota:
+		return h.ApplyFromObject(val)
+	case corev1.ResourceQuota:
+		return h.ApplyFromObject(&val)
+	case *unstructured.Unstructured:
+		return h.ApplyFromUnstructured(val)
+	case unstructured.Unstructured:
+		return h.ApplyFromUnstructured(&val)
+	case map[string]interface{}:
+		return h.ApplyFromMap(val)
+	case metav1.Object, runtime.Object:
+		return h.ApplyFromObject(val)
+	default:
+		return nil, ErrInvalidApplyType
+	}
+}
+
+// ApplyFromFile applies resourcequota from yaml or json file.
+func (h *Handler) ApplyFromFile(filename string) (quota *corev1.ResourceQuota, err error) {
+	quota, err = h.CreateFromFile(filename)
+	if k8serrors.IsAlreadyExists(err) { // if resourcequota already exist, update it.
+		quota, err = h.UpdateFromFile(filename)
+	}
+	return
+}
+
+// ApplyFromBytes pply resourcequota from bytes data.
+func (h *Handler) ApplyFromBytes(data []byte) (quota *corev1.ResourceQuota, err error) {
+	quota, err = h.CreateFromBytes(data)
+	if k8serrors.IsAlreadyExists(err) {
+		quota, err = h.UpdateFromBytes(data)
+	}
+	return
+}
+
+// ApplyFromObject applies resourcequota from metav1.Object or runtime.Object.
+func (h *Handler) ApplyFromObject(obj interface{}) (*corev1.ResourceQuota, error) {
+	quota, ok := obj.(*corev1.ResourceQuota)
+	if !ok {
+		return nil, ErrInvalidObjectType
+	}
+	return h.applyResourceQuota(quota)
+}
+
+// ApplyFromUnstructured applies resourcequota from *unstructured.Unstructured.
+func (h *Handler) ApplyFromUnstructured(u *unstructured.Unstructured) (*corev1.ResourceQuota, error) {
+	quota := &corev1.ResourceQuota{}
+	err := runtime.DefaultUnstructuredConverter.FromUnstructured(u.UnstructuredContent(), quota)
+	if err != nil {
+		return nil, err
+	}
+	return h.applyResourceQuota(quota)
+}
+
+// ApplyFromMap applies resourcequota from map[string]interface{}.
+func (h *Handler) ApplyFromMap(u map[string]interface{}) (*corev1.ResourceQuota, error) {
+	quota := &corev1.ResourceQuota{}
+	err := runtime.DefaultUnstructuredConverter.FromUnstructured(u, quota)
+	if err != nil {
+		return nil, err
+	}
+	return h.applyResourceQuota(quota)
+}
+
+// applyResourceQuota
+func (h *Handler) applyResourceQuota(quota *corev1.ResourceQuota) (*corev1.ResourceQuota, error) {
+	_, err := h.createResourceQuota(quota)
+	if k8serrors.IsAlreadyExists(err) {
+		return h.updateResourceQuota(quota)
+	}
+	return quota, err
+}
@@ -0,0 +1,101 @@
+package resourcequota
+
+import (
+	"encoding/json"
+	"io/ioutil"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/yaml"
+)
+
+// Create creates resourcequota from type string, []byte, *corev1.ResourceQuota,
+// corev1.ResourceQuota, metav1.Object, runtime.Object, *unstructured.Unstructured,
+// unstructured.Unstructured or map[string]interface{}.
+func (h *Handler) Create(obj interface{}) (*corev1.ResourceQuota, error) {
+	switch val := obj.(type) {
+	case string:
+		return h.CreateFromFile(val)
+	case []byte:
+		return h.CreateFromBytes(val)
+	case *corev1.ResourceQuota:
+		return h.CreateFromObject(val)
+	case corev1.ResourceQuota:
+		return h.CreateFromObject(&val)
+	case *unstructured.Unstructured:
+		return h.CreateFromUnstructured(val)
+	case unstructured.Unstructured:
+		return h.CreateFromUnstructured(&val)
+	case map[string]interface{}:
+		return h.CreateFromMap(val)
+	case metav1.Object, runtime.Object:
+		return h.CreateFromObject(val)
+	default:
+		return nil, ErrInvalidCreateType
+	}
+}
+
+// CreateFromFile creates resourcequota from yaml or json file.
+func (h *Handler) CreateFromFile(filename string) (*corev1.ResourceQuota, error) {
+	data, err := ioutil.ReadFile(filename)
+	if err != nil {
+		return nil, err
+	}
+	return h.CreateFromBytes(data)
+}
+
+// CreateFromBytes creates resourcequota from bytes data.
+func (h *Handler) CreateFromBytes(data []byte) (*corev1.ResourceQuota, error) {
+	quotaJson, err := yaml.ToJSON(data)
+	if err != nil {
+		return nil, err
+	}
+
+	quota := &corev1.ResourceQuota{}
+	if err = json.Unmarshal(quotaJson, quota); err != nil {
+		return nil, err
+	}
+	return h.createResourceQuota(quota)
+}
+
+// CreateFromObject creates resourcequota from metav1.Object or runtime.Object.
+func (h *Handler) CreateFromObject(obj interface{}) (*corev1.ResourceQuota, error) {
+	quota, ok := obj.(*corev1.ResourceQuota)
+	if !ok {
+		return nil, ErrInvalidObjectType
+	}
+	return h.createResourceQuota(quota)
+}
+
+// CreateFromUnstructured creates resourcequota from *unstructured.Unstructured.
+func (h *Handler) CreateFromUnstructured(u *unstructured.Unstructured) (*corev1.ResourceQuota, error) {
+	quota := &corev1.ResourceQuota{}
+	err := runtime.DefaultUnstructuredConverter.FromUnstructured(u.UnstructuredContent(), quota)
+	if err != nil {
+		return nil, err
+	}
+	return h.createResourceQuota(quota)
+}
+
+// CreateFromMap creates resourcequota from map[string]interface{}.
+func (h *Handler) CreateFromMap(u map[string]interface{}) (*corev1.ResourceQuota, error) {
+	quota := &corev1.ResourceQuota{}
+	err := runtime.DefaultUnstructuredConverter.FromUnstructured(u, quota)
+	if err != nil {
+		return nil, err
+	}
+	return h.createResourceQuota(quota)
+}
+
+// createResourceQuota
+func (h *Handler) createResourceQuota(quota *corev1.ResourceQuota) (*corev1.ResourceQuota, error) {
+	namespace := quota.GetNamespace()
+	if len(namespace) == 0 {
+		namespace = h.namespace
+	}
+	quota.ResourceVersion = ""
+	quota.UID = ""
+	return h.clientset.CoreV1().ResourceQuotas(namespace).Create(h.ctx, quota, h.Options.CreateOptions)
+}
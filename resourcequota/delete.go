@@ -0,0 +1,107 @@
+package resourcequota
+
+import (
+	"encoding/json"
+	"io/ioutil"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/yaml"
+)
+
+// Delete deletes resourcequota from type string, []byte, *corev1.ResourceQuota,
+// corev1.ResourceQuota, metav1.Object, runtime.Object, *unstructured.Unstructured,
+// unstructured.Unstructured or map[string]interface{}.
+//
+// If passed parameter type is string, it will simply call DeleteByName instead of DeleteFromFile.
+// You should always explicitly call DeleteFromFile to delete a resourcequota from file path.
+func (h *Handler) Delete(obj interface{}) error {
+	switch val := obj.(type) {
+	case string:
+		return h.DeleteByName(val)
+	case []byte:
+		return h.DeleteFromBytes(val)
+	case *corev1.ResourceQuota:
+		return h.DeleteFromObject(val)
+	case corev1.ResourceQuota:
+		return h.DeleteFromObject(&val)
+	case *unstructured.Unstructured:
+		return h.DeleteFromUnstructured(val)
+	case unstructured.Unstructured:
+		return h.DeleteFromUnstructured(&val)
+	case map[string]interface{}:
+		return h.DeleteFromMap(val)
+	case metav1.Object, runtime.Object:
+		return h.DeleteFromObject(val)
+	default:
+		return ErrInvalidDeleteType
+	}
+}
+
+// DeleteByName deletes resourcequota by name.
+func (h *Handler) DeleteByName(name string) error {
+	return h.clientset.CoreV1().ResourceQuotas(h.namespace).Delete(h.ctx, name, h.Options.DeleteOptions)
+}
+
+// DeleteFromFile deletes resourcequota from yaml or json file.
+func (h *Handler) DeleteFromFile(filename string) error {
+	data, err := ioutil.ReadFile(filename)
+	if err != nil {
+		return err
+	}
+	return h.DeleteFromBytes(data)
+}
+
+// DeleteFromBytes deletes resourcequota from bytes data.
+func (h *Handler) DeleteFromBytes(data []byte) error {
+	quotaJson, err := yaml.ToJSON(data)
+	if err != nil {
+		return err
+	}
+
+	quota := &corev1.ResourceQuota{}
+	if err = json.Unmarshal(quotaJson, quota); err != nil {
+		return err
+	}
+	return h.deleteResourceQuota(quota)
+}
+
+// DeleteFromObject deletes resourcequota from metav1.Object or runtime.Object.
+func (h *Handler) DeleteFromObject(obj interface{}) error {
+	quota, ok := obj.(*corev1.ResourceQuota)
+	if !ok {
+		return ErrInvalidObjectType
+	}
+	return h.deleteResourceQuota(quota)
+}
+
+// DeleteFromUnstructured deletes resourcequota from *unstructured.Unstructured.
+func (h *Handler) DeleteFromUnstructured(u *unstructured.Unstructured) error {
+	quota := &corev1.ResourceQuota{}
+	err := runtime.DefaultUnstructuredConverter.FromUnstructured(u.UnstructuredContent(), quota)
+	if err != nil {
+		return err
+	}
+	return h.deleteResourceQuota(quota)
+}
+
+// DeleteFromMap deletes resourcequota from map[string]interface{}.
+func (h *Handler) DeleteFromMap(u map[string]interface{}) error {
+	quota := &corev1.ResourceQuota{}
+	err := runtime.DefaultUnstructuredConverter.FromUnstructured(u, quota)
+	if err != nil {
+		return err
+	}
+	return h.deleteResourceQuota(quota)
+}
+
+// deleteResourceQuota
+func (h *Handler) deleteResourceQuota(quota *corev1.ResourceQuota) error {
+	namespace := quota.GetNamespace()
+	if len(namespace) == 0 {
+		namespace = h.namespace
+	}
+	return h.clientset.CoreV1().ResourceQuotas(namespace).Delete(h.ctx, quota.Name, h.Options.DeleteOptions)
+}
@@ -0,0 +1,109 @@
+package resourcequota
+
+import (
+	"encoding/json"
+	"io/ioutil"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/yaml"
+)
+
+// Get gets resourcequota from type string, []byte, *corev1.ResourceQuota,
+// corev1.ResourceQuota, metav1.Object, runtime.Object, *unstructured.Unstructured,
+// unstructured.Unstructured or map[string]interface{}.
+//
+// If passed parameter type is string, it will simply call GetByName instead of GetFromFile.
+// You should always explicitly call GetFromFile to get a resourcequota from file path.
+func (h *Handler) Get(obj interface{}) (*corev1.ResourceQuota, error) {
+	switch val := obj.(type) {
+	case string:
+		return h.GetByName(val)
+	case []byte:
+		return h.GetFromBytes(val)
+	case *corev1.ResourceQuota:
+		return h.GetFromObject(val)
+	case corev1.ResourceQuota:
+		return h.GetFromObject(&val)
+	case *unstructured.Unstructured:
+		return h.GetFromUnstructured(val)
+	case unstructured.Unstructured:
+		return h.GetFromUnstructured(&val)
+	case map[string]interface{}:
+		return h.GetFromMap(val)
+	case metav1.Object, runtime.Object:
+		return h.GetFromObject(val)
+	default:
+		return nil, ErrInvalidGetType
+	}
+}
+
+// GetByName gets resourcequota by name.
+func (h *Handler) GetByName(name string) (*corev1.ResourceQuota, error) {
+	return h.clientset.CoreV1().ResourceQuotas(h.namespace).Get(h.ctx, name, h.Options.GetOptions)
+}
+
+// GetFromFile gets resourcequota from yaml or json file.
+func (h *Handler) GetFromFile(filename string) (*corev1.ResourceQuota, error) {
+	data, err := ioutil.ReadFile(filename)
+	if err != nil {
+		return nil, err
+	}
+	return h.GetFromBytes(data)
+}
+
+// GetFromBytes gets resourcequota from bytes data.
+func (h *Handler) GetFromBytes(data []byte) (*corev1.ResourceQuota, error) {
+	quotaJson, err := yaml.ToJSON(data)
+	if err != nil {
+		return nil, err
+	}
+
+	quota := &corev1.ResourceQuota{}
+	if err = json.Unmarshal(quotaJson, quota); err != nil {
+		return nil, err
+	}
+	return h.getResourceQuota(quota)
+}
+
+// GetFromObject gets resourcequota from metav1.Object or runtime.Object.
+func (h *Handler) GetFromObject(obj interface{}) (*corev1.ResourceQuota, error) {
+	quota, ok := obj.(*corev1.ResourceQuota)
+	if !ok {
+		return nil, ErrInvalidObjectType
+	}
+	return h.getResourceQuota(quota)
+}
+
+// GetFromUnstructured gets resourcequota from *unstructured.Unstructured.
+func (h *Handler) GetFromUnstructured(u *unstructured.Unstructured) (*corev1.ResourceQuota, error) {
+	quota := &corev1.ResourceQuota{}
+	err := runtime.DefaultUnstructuredConverter.FromUnstructured(u.UnstructuredContent(), quota)
+	if err != nil {
+		return nil, err
+	}
+	return h.getResourceQuota(quota)
+}
+
+// GetFromMap gets resourcequota from map[string]interface{}.
+func (h *Handler) GetFromMap(u map[string]interface{}) (*corev1.ResourceQuota, error) {
+	quota := &corev1.ResourceQuota{}
+	err := runtime.DefaultUnstructuredConverter.FromUnstructured(u, quota)
+	if err != nil {
+		return nil, err
+	}
+	return h.getResourceQuota(quota)
+}
+
+// getResourceQuota
+// It's necessary to get a new resourcequota resource from a old resourcequota resource,
+// because old resourcequota usually don't have resourcequota.Status field.
+func (h *Handler) getResourceQuota(quota *corev1.ResourceQuota) (*corev1.ResourceQuota, error) {
+	namespace := quota.GetNamespace()
+	if len(namespace) == 0 {
+		namespace = h.namespace
+	}
+	return h.clientset.CoreV1().ResourceQuotas(namespace).Get(h.ctx, quota.Name, h.Options.GetOptions)
+}
@@ -0,0 +1,60 @@
+package resourcequota
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/fields"
+)
+
+// List list all resourcequotas in the k8s cluster, it simply call `ListAll`.
+func (h *Handler) List() ([]*corev1.ResourceQuota, error) {
+	return h.ListAll()
+}
+
+// ListByLabel list resourcequotas by labels.
+// Multiple labels separated by comma(",") eg: "name=myapp,role=devops",
+// and there is an "And" relationship between multiple labels.
+func (h *Handler) ListByLabel(labels string) ([]*corev1.ResourceQuota, error) {
+	listOptions := h.Options.ListOptions.DeepCopy()
+	listOptions.LabelSelector = labels
+	quotaList, err := h.clientset.CoreV1().ResourceQuotas(h.namespace).List(h.ctx, *listOptions)
+	if err != nil {
+		return nil, err
+	}
+	return extractList(quotaList), nil
+}
+
+// ListByField list resourcequotas by field, work like `kubectl get xxx --field-selector=xxx`.
+func (h *Handler) ListByField(field string) ([]*corev1.ResourceQuota, error) {
+	fieldSelector, err := fields.ParseSelector(field)
+	if err != nil {
+		return nil, err
+	}
+	listOptions := h.Options.ListOptions.DeepCopy()
+	listOptions.FieldSelector = fieldSelector.String()
+
+	quotaList, err := h.clientset.CoreV1().ResourceQuotas(h.namespace).List(h.ctx, *listOptions)
+	if err != nil {
+		return nil, err
+	}
+	return extractList(quotaList), nil
+}
+
+// ListByNamespace list all resourcequotas in the specified namespace.
+func (h *Handler) ListByNamespace(namespace string) ([]*corev1.ResourceQuota, error) {
+	return h.WithNamespace(namespace).ListByLabel("")
+}
+
+// ListAll list all resourcequotas in the k8s cluster.
+func (h *Handler) ListAll() ([]*corev1.ResourceQuota, error) {
+	return h.WithNamespace(metav1.NamespaceAll).ListByLabel("")
+}
+
+// extractList
+func extractList(quotaList *corev1.ResourceQuotaList) []*corev1.ResourceQuota {
+	var objList []*corev1.ResourceQuota
+	for i := range quotaList.Items {
+		objList = append(objList, &quotaList.Items[i])
+	}
+	return objList
+}
@@ -0,0 +1,60 @@
+package resourcequota
+
+import (
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+)
+
+// GetAge get the resourcequota age.
+func (h *Handler) GetAge(object interface{}) (time.Duration, error) {
+	switch val := object.(type) {
+	case string:
+		quota, err := h.Get(val)
+		if err != nil {
+			return time.Duration(0), err
+		}
+		return time.Now().Sub(quota.CreationTimestamp.Time), nil
+	case *corev1.ResourceQuota:
+		return time.Now().Sub(val.CreationTimestamp.Time), nil
+	case corev1.ResourceQuota:
+		return time.Now().Sub(val.CreationTimestamp.Time), nil
+	default:
+		return time.Duration(0), ErrInvalidToolsType
+	}
+}
+
+// Usage gets the hard limits and the observed usage of the resourcequota,
+// they're read from resourcequota status.hard and status.used respectively.
+func (h *Handler) Usage(name string) (hard, used corev1.ResourceList, err error) {
+	quota, err := h.Get(name)
+	if err != nil {
+		return nil, nil, err
+	}
+	return quota.Status.Hard, quota.Status.Used, nil
+}
+
+// PercentUsed gets the percentage of the hard limit used by each resource of
+// the resourcequota, for example, 50 means half of the hard limit is used.
+// The resources whose hard limit is unset or zero are omitted, because their
+// percentage is undefined.
+func (h *Handler) PercentUsed(name string) (map[corev1.ResourceName]float64, error) {
+	hard, used, err := h.Usage(name)
+	if err != nil {
+		return nil, err
+	}
+	return percentUsed(hard, used), nil
+}
+
+// percentUsed computes the percentage of hard used by each resource.
+func percentUsed(hard, used corev1.ResourceList) map[corev1.ResourceName]float64 {
+	percent := make(map[corev1.ResourceName]float64)
+	for resource, limit := range hard {
+		if limit.IsZero() {
+			continue
+		}
+		usage := used[resource]
+		percent[resource] = usage.AsApproximateFloat64() / limit.AsApproximateFloat64() * 100
+	}
+	return percent
+}
@@ -0,0 +1,56 @@
+package resourcequota
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+
+	"github.com/forbearing/k8s/types"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/rest"
+)
+
+func TestPercentUsed(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/api/v1/namespaces/test/resourcequotas/quota" {
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+		// the memory used is not counted, the secrets hard is zero and the
+		// services hard is unset.
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprintln(w, `{"kind":"ResourceQuota","apiVersion":"v1","metadata":{"name":"quota","namespace":"test"},"status":{`+
+			`"hard":{"requests.cpu":"2","limits.memory":"4Gi","pods":"10","secrets":"0"},`+
+			`"used":{"requests.cpu":"500m","pods":"10","secrets":"1","services":"3"}}}`)
+	}))
+	defer srv.Close()
+	clientset, err := kubernetes.NewForConfig(&rest.Config{Host: srv.URL})
+	if err != nil {
+		t.Fatal(err)
+	}
+	h := &Handler{ctx: context.Background(), namespace: "test", clientset: clientset, Options: &types.HandlerOptions{}}
+
+	hard, used, err := h.Usage("quota")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(hard) != 4 || len(used) != 4 {
+		t.Errorf("Usage(quota) = %v, %v, want 4 hard and 4 used resources", hard, used)
+	}
+
+	percent, err := h.PercentUsed("quota")
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := map[corev1.ResourceName]float64{
+		corev1.ResourceRequestsCPU:  25,
+		corev1.ResourceLimitsMemory: 0,
+		corev1.ResourcePods:         100,
+	}
+	if !reflect.DeepEqual(percent, want) {
+		t.Errorf("PercentUsed(quota) = %v, want %v", percent, want)
+	}
+}
@@ -0,0 +1,14 @@
+package resourcequota
+
+import "errors"
+
+var (
+	ErrInvalidToolsType  = errors.New("type must be string, *corev1.ResourceQuota, corev1.ResourceQuota, metav1.Object or runtime.Object")
+	ErrInvalidCreateType = errors.New("type must be string, []byte, *corev1.ResourceQuota, corev1.ResourceQuota, metav1.Object, runtime.Object, *unstructured.Unstructured, unstructured.Unstructured or map[string]interface{}")
+	ErrInvalidUpdateType = ErrInvalidCreateType
+	ErrInvalidApplyType  = ErrInvalidCreateType
+	ErrInvalidDeleteType = ErrInvalidCreateType
+	ErrInvalidGetType    = ErrInvalidCreateType
+	ErrInvalidPatchType  = errors.New("patch data type must be string, []byte, *corev1.ResourceQuota, corev1.ResourceQuota, metav1.Object, runtime.Object, *unstructured.Unstructured, unstructured.Unstructured or map[string]interface{}")
+	ErrInvalidObjectType = errors.New("object type is not *corev1.ResourceQuota")
+)
@@ -0,0 +1,101 @@
+package resourcequota
+
+import (
+	"encoding/json"
+	"io/ioutil"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/yaml"
+)
+
+// Update updates resourcequota from type string, []byte, *corev1.ResourceQuota,
+// corev1.ResourceQuota, metav1.Object, runtime.Object, *unstructured.Unstructured,
+// unstructured.Unstructured or map[string]interface{}.
+func (h *Handler) Update(obj interface{}) (*corev1.ResourceQuota, error) {
+	switch val := obj.(type) {
+	case string:
+		return h.UpdateFromFile(val)
+	case []byte:
+		return h.UpdateFromBytes(val)
+	case *corev1.ResourceQuota:
+		return h.UpdateFromObject(val)
+	case corev1.ResourceQuota:
+		return h.UpdateFromObject(&val)
+	case *unstructured.Unstructured:
+		return h.UpdateFromUnstructured(val)
+	case unstructured.Unstructured:
+		return h.UpdateFromUnstructured(&val)
+	case map[string]interface{}:
+		return h.UpdateFromMap(val)
+	case metav1.Object, runtime.Object:
+		return h.UpdateFromObject(val)
+	default:
+		return nil, ErrInvalidUpdateType
+	}
+}
+
+// UpdateFromFile updates resourcequota from yaml or json file.
+func (h *Handler) UpdateFromFile(filename string) (*corev1.ResourceQuota, error) {
+	data, err := ioutil.ReadFile(filename)
+	if err != nil {
+		return nil, err
+	}
+	return h.UpdateFromBytes(data)
+}
+
+// UpdateFromBytes updates resourcequota from bytes data.
+func (h *Handler) UpdateFromBytes(data []byte) (*corev1.ResourceQuota, error) {
+	quotaJson, err := yaml.ToJSON(data)
+	if err != nil {
+		return nil, err
+	}
+
+	quota := &corev1.ResourceQuota{}
+	if err = json.Unmarshal(quotaJson, quota); err != nil {
+		return nil, err
+	}
+	return h.updateResourceQuota(quota)
+}
+
+// UpdateFromObject updates resourcequota from metav1.Object or runtime.Object.
+func (h *Handler) UpdateFromObject(obj interface{}) (*corev1.ResourceQuota, error) {
+	quota, ok := obj.(*corev1.ResourceQuota)
+	if !ok {
+		return nil, ErrInvalidObjectType
+	}
+	return h.updateResourceQuota(quota)
+}
+
+// UpdateFromUnstructured updates resourcequota from *unstructured.Unstructured.
+func (h *Handler) UpdateFromUnstructured(u *unstructured.Unstructured) (*corev1.ResourceQuota, error) {
+	quota := &corev1.ResourceQuota{}
+	err := runtime.DefaultUnstructuredConverter.FromUnstructured(u.UnstructuredContent(), quota)
+	if err != nil {
+		return nil, err
+	}
+	return h.updateResourceQuota(quota)
+}
+
+// UpdateFromMap updates resourcequota from map[string]interface{}.
+func (h *Handler) UpdateFromMap(u map[string]interface{}) (*corev1.ResourceQuota, error) {
+	quota := &corev1.ResourceQuota{}
+	err := runtime.DefaultUnstructuredConverter.FromUnstructured(u, quota)
+	if err != nil {
+		return nil, err
+	}
+	return h.updateResourceQuota(quota)
+}
+
+// updateResourceQuota
+func (h *Handler) updateResourceQuota(quota *corev1.ResourceQuota) (*corev1.ResourceQuota, error) {
+	namespace := quota.GetNamespace()
+	if len(namespace) == 0 {
+		namespace = h.namespace
+	}
+	quota.ResourceVersion = ""
+	quota.UID = ""
+	return h.clientset.CoreV1().ResourceQuotas(namespace).Update(h.ctx, quota, h.Options.UpdateOptions)
+}
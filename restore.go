@@ -0,0 +1,200 @@
+package k8s
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"sort"
+
+	"github.com/forbearing/k8s/dynamic"
+	"github.com/forbearing/k8s/types"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	utilyaml "k8s.io/apimachinery/pkg/util/yaml"
+)
+
+// RestoreOptions is the options for RestoreCluster.
+type RestoreOptions struct {
+	// Namespace is the namespace of the namespace-scoped k8s resource that
+	// has no namespace defined, default to "default".
+	Namespace string
+	// Overwrite updates the k8s resources already exist in the cluster,
+	// otherwise they are skipped.
+	Overwrite bool
+	// NamespaceMapping re-maps the namespace of the k8s resources, the key is
+	// the namespace in the snapshot and the value is the namespace restored to.
+	// The Namespace object in the snapshot is renamed too.
+	NamespaceMapping map[string]string
+}
+
+// The action taken on a k8s resource by RestoreCluster.
+const (
+	RestoreCreated = "created"
+	RestoreUpdated = "updated"
+	RestoreSkipped = "skipped"
+	RestoreFailed  = "failed"
+)
+
+// RestoreResult is the result of restoring a k8s resource.
+type RestoreResult struct {
+	APIVersion string
+	Kind       string
+	Namespace  string
+	Name       string
+	// Action is one of RestoreCreated, RestoreUpdated, RestoreSkipped and RestoreFailed.
+	Action string
+	// Err is the error why the k8s resource failed to restore.
+	Err error
+}
+
+// restoreKindOrder is the order of the kinds to be restored, the kind that
+// other kinds depend on comes first. The kinds not in the list are restored
+// after them, in the order of the snapshot.
+var restoreKindOrder = []string{
+	types.KindNamespace,
+	"CustomResourceDefinition",
+	"PriorityClass",
+	types.KindStorageClass,
+	types.KindPersistentVolume,
+	types.KindPersistentVolumeClaim,
+	types.KindServiceAccount,
+	types.KindSecret,
+	types.KindConfigMap,
+	types.KindClusterRole,
+	types.KindClusterRoleBinding,
+	types.KindRole,
+	types.KindRoleBinding,
+	types.KindService,
+	types.KindIngressClass,
+}
+
+// RestoreCluster reads a multi-document yaml snapshot written by ExportCluster
+// and restores the k8s resources in dependency order, eg: the namespaces and
+// CRDs are restored before the k8s resources that depend on them.
+//
+// The k8s resources already exist are skipped, or updated if opts.Overwrite
+// is true. RestoreCluster continues on error and reports the result of every
+// k8s resource, the returned error is only not nil when the snapshot can't be
+// read or decoded.
+func RestoreCluster(ctx context.Context, kubeconfig string, r io.Reader, opts RestoreOptions) ([]RestoreResult, error) {
+	objs, err := readDocuments(r)
+	if err != nil {
+		return nil, err
+	}
+	handler, err := New(ctx, kubeconfig, opts.Namespace)
+	if err != nil {
+		return nil, err
+	}
+	sortForRestore(objs)
+
+	results := make([]RestoreResult, 0, len(objs))
+	for _, obj := range objs {
+		remapNamespace(obj, opts.NamespaceMapping)
+		action, err := restoreObject(handler, obj, opts.Overwrite)
+		// the CRD may be just restored, but the RESTMapper cached the discovery
+		// information before it, reset the RESTMapper and try again.
+		if meta.IsNoMatchError(err) {
+			if resettable, ok := handler.RESTMapper().(meta.ResettableRESTMapper); ok {
+				resettable.Reset()
+				action, err = restoreObject(handler, obj, opts.Overwrite)
+			}
+		}
+		results = append(results, RestoreResult{
+			APIVersion: obj.GetAPIVersion(),
+			Kind:       obj.GetKind(),
+			Namespace:  obj.GetNamespace(),
+			Name:       obj.GetName(),
+			Action:     action,
+			Err:        err,
+		})
+	}
+	return results, nil
+}
+
+// restoreObject creates the k8s resource, if it already exists, updates it
+// or skips it.
+func restoreObject(handler *dynamic.Handler, obj *unstructured.Unstructured, overwrite bool) (string, error) {
+	_, err := handler.Create(obj.DeepCopy())
+	switch {
+	case err == nil:
+		return RestoreCreated, nil
+	case !apierrors.IsAlreadyExists(err):
+		return RestoreFailed, err
+	case !overwrite:
+		return RestoreSkipped, nil
+	}
+	if _, err = handler.Update(obj.DeepCopy()); err != nil {
+		return RestoreFailed, err
+	}
+	return RestoreUpdated, nil
+}
+
+// readDocuments decodes every yaml or json document read from r to
+// unstructured object, the empty documents are skipped.
+func readDocuments(r io.Reader) ([]*unstructured.Unstructured, error) {
+	var objs []*unstructured.Unstructured
+	reader := utilyaml.NewYAMLReader(bufio.NewReader(r))
+	for {
+		data, err := reader.Read()
+		if err == io.EOF {
+			return objs, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		if len(bytes.TrimSpace(data)) == 0 {
+			continue
+		}
+		jsonData, err := utilyaml.ToJSON(data)
+		if err != nil {
+			return nil, err
+		}
+		// the document only contains comments.
+		if bytes.Equal(bytes.TrimSpace(jsonData), []byte("null")) {
+			continue
+		}
+		obj := &unstructured.Unstructured{}
+		if err := json.Unmarshal(jsonData, obj); err != nil {
+			return nil, err
+		}
+		objs = append(objs, obj)
+	}
+}
+
+// sortForRestore sorts the k8s resources by restoreKindOrder, the relative
+// order of the k8s resources with the same priority is kept.
+func sortForRestore(objs []*unstructured.Unstructured) {
+	priority := make(map[string]int, len(restoreKindOrder))
+	for i, kind := range restoreKindOrder {
+		priority[kind] = i
+	}
+	rank := func(obj *unstructured.Unstructured) int {
+		if i, ok := priority[obj.GetKind()]; ok {
+			return i
+		}
+		return len(restoreKindOrder)
+	}
+	sort.SliceStable(objs, func(i, j int) bool {
+		return rank(objs[i]) < rank(objs[j])
+	})
+}
+
+// remapNamespace changes the namespace of the k8s resource according to the
+// mapping, the Namespace object is renamed.
+func remapNamespace(obj *unstructured.Unstructured, mapping map[string]string) {
+	if obj.GetKind() == types.KindNamespace && len(obj.GetNamespace()) == 0 {
+		if namespace, ok := mapping[obj.GetName()]; ok {
+			obj.SetName(namespace)
+			// the label "kubernetes.io/metadata.name" is set by apiserver
+			// and must be the same as the namespace name.
+			unstructured.RemoveNestedField(obj.Object, "metadata", "labels", "kubernetes.io/metadata.name")
+		}
+		return
+	}
+	if namespace, ok := mapping[obj.GetNamespace()]; ok {
+		obj.SetNamespace(namespace)
+	}
+}
@@ -0,0 +1,151 @@
+package k8s
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+func TestRestoreHelpers(t *testing.T) {
+	snapshot := `---
+apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: nginx
+  namespace: test
+---
+# only comments
+---
+apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: nginx
+  namespace: test
+---
+apiVersion: v1
+kind: Namespace
+metadata:
+  name: test
+  labels:
+    kubernetes.io/metadata.name: test
+---
+apiVersion: v1
+kind: Service
+metadata:
+  name: nginx
+  namespace: other
+`
+	objs, err := readDocuments(strings.NewReader(snapshot))
+	if err != nil {
+		t.Fatal(err)
+	}
+	sortForRestore(objs)
+	mapping := map[string]string{"test": "restored"}
+	var got []string
+	for _, obj := range objs {
+		remapNamespace(obj, mapping)
+		got = append(got, obj.GetKind()+" "+obj.GetNamespace()+"/"+obj.GetName())
+	}
+	want := []string{
+		"Namespace /restored",
+		"ConfigMap restored/nginx",
+		"Service other/nginx",
+		"Deployment restored/nginx",
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("got %v, want %v", got, want)
+	}
+	if labels := objs[0].GetLabels(); len(labels) != 0 {
+		t.Errorf("namespace labels should be removed, got %v", labels)
+	}
+}
+
+func TestExportRestoreRoundTrip(t *testing.T) {
+	// the service listed from the source cluster has the fields allocated by
+	// the apiserver, which must not be sent to the target cluster.
+	const liveService = `{"kind":"ServiceList","apiVersion":"v1","metadata":{"resourceVersion":"100"},"items":[` +
+		`{"metadata":{"name":"nginx","namespace":"test","uid":"1234","resourceVersion":"99",` +
+		`"ownerReferences":[{"apiVersion":"v1","kind":"Endpoints","name":"nginx","uid":"5678"}]},` +
+		`"spec":{"type":"NodePort","clusterIP":"10.96.0.10","clusterIPs":["10.96.0.10"],"selector":{"app":"nginx"},` +
+		`"ports":[{"name":"http","port":80,"targetPort":8080,"protocol":"TCP","nodePort":30080}]},` +
+		`"status":{"loadBalancer":{}}}]}`
+	var created *corev1.Service
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case r.URL.Path == "/api":
+			fmt.Fprintln(w, `{"kind":"APIVersions","versions":["v1"]}`)
+		case r.URL.Path == "/apis":
+			fmt.Fprintln(w, `{"kind":"APIGroupList","apiVersion":"v1","groups":[]}`)
+		case r.URL.Path == "/api/v1":
+			fmt.Fprintln(w, `{"kind":"APIResourceList","apiVersion":"v1","groupVersion":"v1","resources":[`+
+				`{"name":"services","namespaced":true,"kind":"Service","verbs":["create","get","list","update"]}]}`)
+		case r.Method == http.MethodGet && r.URL.Path == "/api/v1/namespaces/test/services":
+			fmt.Fprintln(w, liveService)
+		case r.Method == http.MethodPost && r.URL.Path == "/api/v1/namespaces/test/services":
+			data, _ := io.ReadAll(r.Body)
+			created = &corev1.Service{}
+			if err := json.Unmarshal(data, created); err != nil {
+				t.Error(err)
+			}
+			w.WriteHeader(http.StatusCreated)
+			w.Write(data)
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL)
+			http.NotFound(w, r)
+		}
+	}))
+	defer srv.Close()
+	kubeconfig := filepath.Join(t.TempDir(), "config")
+	if err := os.WriteFile(kubeconfig, []byte(fmt.Sprintf(`apiVersion: v1
+kind: Config
+clusters:
+- name: test
+  cluster:
+    server: %s
+contexts:
+- name: test
+  context:
+    cluster: test
+current-context: test
+`, srv.URL)), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	snapshot := &bytes.Buffer{}
+	services := schema.GroupVersionResource{Version: "v1", Resource: "services"}
+	if err := ExportCluster(context.Background(), kubeconfig, []schema.GroupVersionResource{services}, []string{"test"}, snapshot); err != nil {
+		t.Fatal(err)
+	}
+	results, err := RestoreCluster(context.Background(), kubeconfig, snapshot, RestoreOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(results) != 1 || results[0].Action != RestoreCreated || results[0].Err != nil {
+		t.Fatalf("RestoreCluster() = %+v, want the service created", results)
+	}
+	if created == nil {
+		t.Fatal("the service is not created")
+	}
+	if len(created.UID) != 0 || len(created.ResourceVersion) != 0 || len(created.OwnerReferences) != 0 {
+		t.Errorf("restored service metadata %+v has server-managed fields", created.ObjectMeta)
+	}
+	if len(created.Spec.ClusterIP) != 0 || len(created.Spec.ClusterIPs) != 0 || created.Spec.Ports[0].NodePort != 0 {
+		t.Errorf("restored service spec %+v has allocated fields", created.Spec)
+	}
+	if created.Spec.Type != corev1.ServiceTypeNodePort || created.Spec.Ports[0].Port != 80 || created.Spec.Selector["app"] != "nginx" {
+		t.Errorf("restored service spec %+v, want the spec declared by the user", created.Spec)
+	}
+}
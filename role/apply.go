@@ -1,8 +1,6 @@
 package role
 
 import (
-	"fmt"
-
 	rbacv1 "k8s.io/api/rbac/v1"
 	k8serrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -58,7 +56,7 @@ func (h *Handler) ApplyFromBytes(data []byte) (role *rbacv1.Role, err error) {
 func (h *Handler) ApplyFromObject(obj interface{}) (*rbacv1.Role, error) {
 	role, ok := obj.(*rbacv1.Role)
 	if !ok {
-		return nil, fmt.Errorf("object type is not *rbacv1.Role")
+		return nil, ErrInvalidObjectType
 	}
 	return h.applyRole(role)
 }
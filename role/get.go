@@ -2,7 +2,6 @@ package role
 
 import (
 	"encoding/json"
-	"fmt"
 	"io/ioutil"
 
 	rbacv1 "k8s.io/api/rbac/v1"
@@ -73,7 +72,7 @@ func (h *Handler) GetFromBytes(data []byte) (*rbacv1.Role, error) {
 func (h *Handler) GetFromObject(obj interface{}) (*rbacv1.Role, error) {
 	role, ok := obj.(*rbacv1.Role)
 	if !ok {
-		return nil, fmt.Errorf("object type is not *rbacv1.Role")
+		return nil, ErrInvalidObjectType
 	}
 	return h.getRole(role)
 }
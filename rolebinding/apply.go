@@ -1,8 +1,6 @@
 package rolebinding
 
 import (
-	"fmt"
-
 	rbacv1 "k8s.io/api/rbac/v1"
 	k8serrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -58,7 +56,7 @@ func (h *Handler) ApplyFromBytes(data []byte) (rb *rbacv1.RoleBinding, err error
 func (h *Handler) ApplyFromObject(obj interface{}) (*rbacv1.RoleBinding, error) {
 	rb, ok := obj.(*rbacv1.RoleBinding)
 	if !ok {
-		return nil, fmt.Errorf("object type is not *rbacv1.RoleBinding")
+		return nil, ErrInvalidObjectType
 	}
 	return h.applyRolebinding(rb)
 }
@@ -2,7 +2,6 @@ package rolebinding
 
 import (
 	"encoding/json"
-	"fmt"
 	"io/ioutil"
 
 	rbacv1 "k8s.io/api/rbac/v1"
@@ -65,7 +64,7 @@ func (h *Handler) CreateFromBytes(data []byte) (*rbacv1.RoleBinding, error) {
 func (h *Handler) CreateFromObject(obj interface{}) (*rbacv1.RoleBinding, error) {
 	rb, ok := obj.(*rbacv1.RoleBinding)
 	if !ok {
-		return nil, fmt.Errorf("object type is not *rbacv1.RoleBinding")
+		return nil, ErrInvalidObjectType
 	}
 	return h.createRolebinding(rb)
 }
@@ -3,7 +3,6 @@ package rolebinding
 import (
 	"time"
 
-	"github.com/sirupsen/logrus"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/client-go/informers"
 	"k8s.io/client-go/informers/internalinterfaces"
@@ -79,6 +78,19 @@ func (h *Handler) Lister() listersrbac.RoleBindingLister {
 	return h.informerFactory.Rbac().V1().RoleBindings().Lister()
 }
 
+// AddIndexers adds more indexers to the underlying rolebinding informer, so you can
+// look up rolebindings from the informer cache by the index, for example, by a label value.
+// Indexers must be added before the informer starts, otherwise an error is returned.
+func (h *Handler) AddIndexers(indexers cache.Indexers) error {
+	return h.Informer().AddIndexers(indexers)
+}
+
+// GetIndexer returns the underlying Indexer of the rolebinding informer, which
+// helps query rolebindings by the indexers added with AddIndexers.
+func (h *Handler) GetIndexer() cache.Indexer {
+	return h.Informer().GetIndexer()
+}
+
 // RunInformer start and run the shared informer, returning after it stops.
 // The informer will be stopped when stopCh is closed.
 //
@@ -98,18 +110,18 @@ func (h *Handler) RunInformer(
 
 	// method 1, recommended
 	h.InformerFactory().Start(stopCh)
-	logrus.Info("Waiting for informer caches to sync")
+	h.log().Info("Waiting for informer caches to sync")
 	if ok := cache.WaitForCacheSync(stopCh, h.Informer().HasSynced); !ok {
-		logrus.Error("failed to wait for caches to sync")
+		h.log().Error("failed to wait for caches to sync")
 	}
 
 	//// method 2
 	//h.InformerFactory().Start(stopCh)
-	//logrus.Info("Waiting for informer caches to sync")
+	//h.log().Info("Waiting for informer caches to sync")
 	//h.InformerFactory().WaitForCacheSync(stopCh)
 
 	//// method 3
-	//logrus.Info("Waiting for informer caches to sync")
+	//h.log().Info("Waiting for informer caches to sync")
 	//h.informerFactory.WaitForCacheSync(stopCh)
 	//h.Informer().Run(stopCh)
 }
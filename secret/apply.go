@@ -1,8 +1,6 @@
 package secret
 
 import (
-	"fmt"
-
 	corev1 "k8s.io/api/core/v1"
 	k8serrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -58,7 +56,7 @@ func (h *Handler) ApplyFromBytes(data []byte) (secret *corev1.Secret, err error)
 func (h *Handler) ApplyFromObject(obj interface{}) (*corev1.Secret, error) {
 	secret, ok := obj.(*corev1.Secret)
 	if !ok {
-		return nil, fmt.Errorf("object type is not *corev1.Secret")
+		return nil, ErrInvalidObjectType
 	}
 	return h.applySecret(secret)
 }
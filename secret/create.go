@@ -1,6 +1,8 @@
 package secret
 
 import (
+	"crypto/tls"
+	"encoding/base64"
 	"encoding/json"
 	"fmt"
 	"io/ioutil"
@@ -65,7 +67,7 @@ func (h *Handler) CreateFromBytes(data []byte) (*corev1.Secret, error) {
 func (h *Handler) CreateFromObject(obj interface{}) (*corev1.Secret, error) {
 	secret, ok := obj.(*corev1.Secret)
 	if !ok {
-		return nil, fmt.Errorf("object type is not *corev1.Secret")
+		return nil, ErrInvalidObjectType
 	}
 	return h.createSecret(secret)
 }
@@ -90,6 +92,53 @@ func (h *Handler) CreateFromMap(u map[string]interface{}) (*corev1.Secret, error
 	return h.createSecret(secret)
 }
 
+// CreateDockerConfigJSON creates a secret of type "kubernetes.io/dockerconfigjson"
+// for pulling images from the docker registry server, it works like
+// `kubectl create secret docker-registry`. The email is optional.
+func (h *Handler) CreateDockerConfigJSON(name, server, username, password, email string) (*corev1.Secret, error) {
+	type dockerConfigEntry struct {
+		Username string `json:"username,omitempty"`
+		Password string `json:"password,omitempty"`
+		Email    string `json:"email,omitempty"`
+		Auth     string `json:"auth,omitempty"`
+	}
+	dockerConfigJSON, err := json.Marshal(map[string]map[string]dockerConfigEntry{
+		"auths": {
+			server: {
+				Username: username,
+				Password: password,
+				Email:    email,
+				Auth:     base64.StdEncoding.EncodeToString([]byte(username + ":" + password)),
+			},
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+	return h.createSecret(&corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: name},
+		Type:       corev1.SecretTypeDockerConfigJson,
+		Data:       map[string][]byte{corev1.DockerConfigJsonKey: dockerConfigJSON},
+	})
+}
+
+// CreateTLS creates a secret of type "kubernetes.io/tls" from the PEM encoded
+// certificate and private key, it works like `kubectl create secret tls`.
+// The certificate and private key must be a valid pair.
+func (h *Handler) CreateTLS(name string, cert, key []byte) (*corev1.Secret, error) {
+	if _, err := tls.X509KeyPair(cert, key); err != nil {
+		return nil, fmt.Errorf("invalid tls certificate and key: %w", err)
+	}
+	return h.createSecret(&corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: name},
+		Type:       corev1.SecretTypeTLS,
+		Data: map[string][]byte{
+			corev1.TLSCertKey:       cert,
+			corev1.TLSPrivateKeyKey: key,
+		},
+	})
+}
+
 // createSecret
 func (h *Handler) createSecret(secret *corev1.Secret) (*corev1.Secret, error) {
 	namespace := secret.GetNamespace()
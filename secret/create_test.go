@@ -0,0 +1,126 @@
+package secret
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/json"
+	"encoding/pem"
+	"io"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/forbearing/k8s/types"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/rest"
+)
+
+// newTestHandler creates a secret handler in namespace "test", whose
+// clientset sends the requests to the fake kubernetes API server serve.
+func newTestHandler(t *testing.T, serve http.HandlerFunc) *Handler {
+	srv := httptest.NewServer(serve)
+	t.Cleanup(srv.Close)
+	clientset, err := kubernetes.NewForConfig(&rest.Config{Host: srv.URL})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return &Handler{
+		ctx:       context.Background(),
+		namespace: "test",
+		clientset: clientset,
+		Options:   &types.HandlerOptions{},
+	}
+}
+
+// echoCreated is a fake kubernetes API server echoing the created secret.
+func echoCreated(t *testing.T) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost || r.URL.Path != "/api/v1/namespaces/test/secrets" {
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+		data, err := io.ReadAll(r.Body)
+		if err != nil {
+			t.Error(err)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.Write(data)
+	}
+}
+
+func TestCreateDockerConfigJSON(t *testing.T) {
+	h := newTestHandler(t, echoCreated(t))
+
+	secret, err := h.CreateDockerConfigJSON("regcred", "registry.example.com", "admin", "passwd", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if secret.Name != "regcred" || secret.Type != corev1.SecretTypeDockerConfigJson {
+		t.Errorf("created secret %s of type %s", secret.Name, secret.Type)
+	}
+	var dockerConfig map[string]map[string]map[string]string
+	if err := json.Unmarshal(secret.Data[corev1.DockerConfigJsonKey], &dockerConfig); err != nil {
+		t.Fatal(err)
+	}
+	want := map[string]string{"username": "admin", "password": "passwd", "auth": "YWRtaW46cGFzc3dk"}
+	got := dockerConfig["auths"]["registry.example.com"]
+	if len(got) != len(want) {
+		t.Errorf("docker config = %v, want %v", got, want)
+	}
+	for key, value := range want {
+		if got[key] != value {
+			t.Errorf("docker config %s = %q, want %q", key, got[key], value)
+		}
+	}
+}
+
+func TestCreateTLS(t *testing.T) {
+	h := newTestHandler(t, echoCreated(t))
+	cert, key := newCertificate(t)
+
+	secret, err := h.CreateTLS("tls", cert, key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if secret.Type != corev1.SecretTypeTLS ||
+		string(secret.Data[corev1.TLSCertKey]) != string(cert) ||
+		string(secret.Data[corev1.TLSPrivateKeyKey]) != string(key) {
+		t.Errorf("unexpected tls secret: %+v", secret)
+	}
+
+	// the key doesn't match the certificate.
+	_, otherKey := newCertificate(t)
+	if _, err := h.CreateTLS("tls", cert, otherKey); err == nil {
+		t.Error("expected CreateTLS to fail with mismatched certificate and key")
+	}
+}
+
+// newCertificate generates a self-signed certificate and its private key in PEM.
+func newCertificate(t *testing.T) (cert, key []byte) {
+	privateKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "example.com"},
+		NotBefore:    time.Now(),
+		NotAfter:     time.Now().Add(time.Hour),
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &privateKey.PublicKey, privateKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	keyDer, err := x509.MarshalECPrivateKey(privateKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}),
+		pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDer})
+}
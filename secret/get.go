@@ -4,6 +4,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"io/ioutil"
+	"sync"
 
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -73,7 +74,7 @@ func (h *Handler) GetFromBytes(data []byte) (*corev1.Secret, error) {
 func (h *Handler) GetFromObject(obj interface{}) (*corev1.Secret, error) {
 	secret, ok := obj.(*corev1.Secret)
 	if !ok {
-		return nil, fmt.Errorf("object type is not *corev1.Secret")
+		return nil, ErrInvalidObjectType
 	}
 	return h.getSecret(secret)
 }
@@ -108,3 +109,64 @@ func (h *Handler) getSecret(secret *corev1.Secret) (*corev1.Secret, error) {
 	}
 	return h.clientset.CoreV1().Secrets(namespace).Get(h.ctx, secret.Name, h.Options.GetOptions)
 }
+
+// defaultConcurrency is the default max number of concurrent requests sent
+// by GetMany.
+const defaultConcurrency = 10
+
+// GetMany gets multiple secrets by names concurrently, the number of concurrent
+// requests is limited by SetConcurrency(default to 10).
+//
+// It returns a map keyed by secret name and the errors of the secrets
+// that failed to get. The remaining secrets will not be fetched if the handler
+// context is done.
+func (h *Handler) GetMany(names []string) (map[string]*corev1.Secret, []error) {
+	h.l.RLock()
+	concurrency := h.concurrency
+	h.l.RUnlock()
+	if concurrency <= 0 {
+		concurrency = defaultConcurrency
+	}
+
+	var (
+		mu    sync.Mutex
+		wg    sync.WaitGroup
+		errs  []error
+		objs  = make(map[string]*corev1.Secret, len(names))
+		limit = make(chan struct{}, concurrency)
+	)
+	for _, name := range names {
+		select {
+		case <-h.ctx.Done():
+			mu.Lock()
+			errs = append(errs, fmt.Errorf("secret/%s: %w", name, h.ctx.Err()))
+			mu.Unlock()
+			continue
+		case limit <- struct{}{}:
+		}
+		// select chooses randomly if the context is done while the semaphore
+		// is available, check the context again after acquiring it.
+		if err := h.ctx.Err(); err != nil {
+			<-limit
+			mu.Lock()
+			errs = append(errs, fmt.Errorf("secret/%s: %w", name, err))
+			mu.Unlock()
+			continue
+		}
+		wg.Add(1)
+		go func(name string) {
+			defer wg.Done()
+			defer func() { <-limit }()
+			secret, err := h.GetByName(name)
+			mu.Lock()
+			defer mu.Unlock()
+			if err != nil {
+				errs = append(errs, fmt.Errorf("secret/%s: %w", name, err))
+				return
+			}
+			objs[name] = secret
+		}(name)
+	}
+	wg.Wait()
+	return objs, errs
+}
@@ -0,0 +1,83 @@
+package secret
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"path"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+)
+
+func TestGetMany(t *testing.T) {
+	names := []string{"a", "b", "missing", "c"}
+	var requests, inflight, maxInflight int32
+	h := newTestHandler(t, func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&requests, 1)
+		n := atomic.AddInt32(&inflight, 1)
+		defer atomic.AddInt32(&inflight, -1)
+		for {
+			max := atomic.LoadInt32(&maxInflight)
+			if n <= max || atomic.CompareAndSwapInt32(&maxInflight, max, n) {
+				break
+			}
+		}
+		name := path.Base(r.URL.Path)
+		// the former secrets are returned later.
+		switch name {
+		case "a":
+			time.Sleep(30 * time.Millisecond)
+		case "b":
+			time.Sleep(10 * time.Millisecond)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		if name == "missing" {
+			w.WriteHeader(http.StatusNotFound)
+			fmt.Fprintln(w, `{"kind":"Status","apiVersion":"v1","status":"Failure","reason":"NotFound","code":404}`)
+			return
+		}
+		fmt.Fprintf(w, `{"kind":"Secret","apiVersion":"v1","metadata":{"name":%q,"namespace":"test"}}`+"\n", name)
+	})
+	h.SetConcurrency(2)
+
+	objs, errs := h.GetMany(names)
+	if len(objs) != 3 {
+		t.Errorf("GetMany() got %d secrets, want 3", len(objs))
+	}
+	for _, name := range []string{"a", "b", "c"} {
+		if secret, ok := objs[name]; !ok || secret.Name != name {
+			t.Errorf("GetMany()[%s] = %v, want secret %s", name, secret, name)
+		}
+	}
+	if len(errs) != 1 || !k8serrors.IsNotFound(errs[0]) || !strings.Contains(errs[0].Error(), "secret/missing") {
+		t.Errorf("GetMany() errors = %v, want secret/missing not found", errs)
+	}
+	if max := atomic.LoadInt32(&maxInflight); max > 2 {
+		t.Errorf("%d concurrent requests, want at most 2", max)
+	}
+
+	// the secrets are not fetched after the handler context is done.
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	h.ctx = ctx
+	atomic.StoreInt32(&requests, 0)
+	for i := 0; i < 10; i++ {
+		objs, errs = h.GetMany(names)
+		if len(objs) != 0 || len(errs) != len(names) {
+			t.Fatalf("GetMany() = %d secrets, %d errors, want 0 and %d", len(objs), len(errs), len(names))
+		}
+		for _, err := range errs {
+			if !errors.Is(err, context.Canceled) {
+				t.Errorf("GetMany() error = %v, want context.Canceled", err)
+			}
+		}
+	}
+	if n := atomic.LoadInt32(&requests); n != 0 {
+		t.Errorf("%d requests sent after the context is done, want 0", n)
+	}
+}
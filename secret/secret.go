@@ -8,8 +8,11 @@ import (
 
 	"github.com/forbearing/k8s/types"
 	"github.com/forbearing/k8s/util/client"
+	utildiscovery "github.com/forbearing/k8s/util/discovery"
+	"github.com/forbearing/k8s/util/recorder"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/client-go/discovery"
 	"k8s.io/client-go/dynamic"
@@ -37,7 +40,12 @@ type Handler struct {
 	tweakListOptions internalinterfaces.TweakListOptionsFunc
 	informerFactory  informers.SharedInformerFactory
 
+	recorder *recorder.Lazy
+
 	Options *types.HandlerOptions
+	logger  types.Logger
+
+	concurrency int
 
 	l sync.RWMutex
 }
@@ -120,6 +128,7 @@ func New(ctx context.Context, kubeconfig, namespace string) (*Handler, error) {
 		dynamicClient:   dynamicClient,
 		discoveryClient: discoveryClient,
 		informerFactory: informerFactory,
+		recorder:        recorder.NewLazy(clientset, "secret-handler"),
 		Options:         &types.HandlerOptions{},
 	}, nil
 }
@@ -147,6 +156,10 @@ func (in *Handler) DeepCopy() *Handler {
 	if in == nil {
 		return nil
 	}
+	// hold the read lock, so the Set* methods can't modify the handler while copying it.
+	in.l.RLock()
+	defer in.l.RUnlock()
+
 	return &Handler{
 		ctx:              in.ctx,
 		kubeconfig:       in.kubeconfig,
@@ -161,6 +174,9 @@ func (in *Handler) DeepCopy() *Handler {
 		resyncPeriod:     in.resyncPeriod,
 		informerScope:    in.informerScope,
 		tweakListOptions: in.tweakListOptions,
+		logger:           in.logger,
+		recorder:         in.recorder,
+		concurrency:      in.concurrency,
 		Options: &types.HandlerOptions{
 			CreateOptions: *in.Options.CreateOptions.DeepCopy(),
 			UpdateOptions: *in.Options.UpdateOptions.DeepCopy(),
@@ -196,6 +212,32 @@ func (h *Handler) SetForceDelete(force bool) {
 	}
 }
 
+// SetConcurrency sets the max number of concurrent requests sent to the
+// kubernetes API server by GetMany, default to 10.
+func (h *Handler) SetConcurrency(concurrency int) {
+	h.l.Lock()
+	defer h.l.Unlock()
+	h.concurrency = concurrency
+}
+
+// SetLogger sets the logger reporting the watch and informer events,
+// types.DiscardLogger silences them. The logrus standard logger is used by default.
+func (h *Handler) SetLogger(logger types.Logger) {
+	h.l.Lock()
+	defer h.l.Unlock()
+	h.logger = logger
+}
+
+// log returns the logger set by SetLogger, or the default logger.
+func (h *Handler) log() types.Logger {
+	h.l.RLock()
+	defer h.l.RUnlock()
+	if h.logger == nil {
+		return types.DefaultLogger()
+	}
+	return h.logger
+}
+
 // RESTConfig returns underlying rest config.
 func (h *Handler) RESTConfig() *rest.Config {
 	return h.config
@@ -221,6 +263,20 @@ func (h *Handler) DiscoveryClient() *discovery.DiscoveryClient {
 	return h.discoveryClient
 }
 
+// APIVerbs returns the verbs supported by the kubernetes apiserver for secret,
+// such as "get", "list", "watch", "create", "update", "patch" and "delete".
+func (h *Handler) APIVerbs() ([]string, error) {
+	return utildiscovery.APIVerbs(h.discoveryClient, GVR)
+}
+
+// RecordEvent records an event against the object, so the actions taken by
+// the tools are visible by `kubectl describe`, eg: "ScalingReplicaSet".
+// The eventType should be corev1.EventTypeNormal or corev1.EventTypeWarning.
+// The event broadcaster is started lazily when the first event is recorded.
+func (h *Handler) RecordEvent(obj runtime.Object, eventType, reason, message string) {
+	h.recorder.Event(obj, eventType, reason, message)
+}
+
 // GVK contains the Group, Version, Kind name of secret.
 var GVK = schema.GroupVersionKind{
 	Group:   corev1.SchemeGroupVersion.Group,
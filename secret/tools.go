@@ -59,3 +59,35 @@ func (h *Handler) GetAge(object interface{}) (time.Duration, error) {
 		return time.Duration(0), ErrInvalidToolsType
 	}
 }
+
+// GetData returns the secret data, the values are already base64-decoded.
+func (h *Handler) GetData(object interface{}) (map[string][]byte, error) {
+	switch val := object.(type) {
+	case string:
+		secret, err := h.Get(val)
+		if err != nil {
+			return nil, err
+		}
+		return secret.Data, nil
+	case *corev1.Secret:
+		return val.Data, nil
+	case corev1.Secret:
+		return val.Data, nil
+	default:
+		return nil, ErrInvalidToolsType
+	}
+}
+
+// GetDataString returns the secret data as strings, the values are already
+// base64-decoded.
+func (h *Handler) GetDataString(object interface{}) (map[string]string, error) {
+	data, err := h.GetData(object)
+	if err != nil {
+		return nil, err
+	}
+	dataString := make(map[string]string, len(data))
+	for key, value := range data {
+		dataString[key] = string(value)
+	}
+	return dataString, nil
+}
@@ -0,0 +1,34 @@
+package secret
+
+import (
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+)
+
+func TestGetData(t *testing.T) {
+	h := newTestHandler(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/api/v1/namespaces/test/secrets/mysecret" {
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+		// the secret data is base64 encoded on the wire.
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprintln(w, `{"kind":"Secret","apiVersion":"v1","metadata":{"name":"mysecret"},"data":{"username":"YWRtaW4=","password":"cGFzc3dk"}}`)
+	})
+
+	data, err := h.GetData("mysecret")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := map[string][]byte{"username": []byte("admin"), "password": []byte("passwd")}; !reflect.DeepEqual(data, want) {
+		t.Errorf("GetData() = %q, want %q", data, want)
+	}
+	dataString, err := h.GetDataString("mysecret")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := map[string]string{"username": "admin", "password": "passwd"}; !reflect.DeepEqual(dataString, want) {
+		t.Errorf("GetDataString() = %v, want %v", dataString, want)
+	}
+}
@@ -2,7 +2,6 @@ package secret
 
 import (
 	"encoding/json"
-	"fmt"
 	"io/ioutil"
 
 	corev1 "k8s.io/api/core/v1"
@@ -65,7 +64,7 @@ func (h *Handler) UpdateFromBytes(data []byte) (*corev1.Secret, error) {
 func (h *Handler) UpdateFromObject(obj interface{}) (*corev1.Secret, error) {
 	secret, ok := obj.(*corev1.Secret)
 	if !ok {
-		return nil, fmt.Errorf("object type is not *corev1.Secret")
+		return nil, ErrInvalidObjectType
 	}
 	return h.updateSecret(secret)
 }
@@ -2,17 +2,21 @@ package service
 
 import (
 	"fmt"
+	"io"
+	"net/http"
 
+	"github.com/forbearing/k8s/util/remote"
 	corev1 "k8s.io/api/core/v1"
 	k8serrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/util/retry"
 )
 
 // Apply applies service from type string, []byte, *corev1.Service,
 // corev1.Service, metav1.Object, runtime.Object, *unstructured.Unstructured,
-// unstructured.Unstructured or map[string]interface{}.
+// unstructured.Unstructured, map[string]interface{} or io.Reader.
 func (h *Handler) Apply(obj interface{}) (*corev1.Service, error) {
 	switch val := obj.(type) {
 	case string:
@@ -29,6 +33,8 @@ func (h *Handler) Apply(obj interface{}) (*corev1.Service, error) {
 		return h.ApplyFromUnstructured(&val)
 	case map[string]interface{}:
 		return h.ApplyFromMap(val)
+	case io.Reader:
+		return h.ApplyFromReader(val)
 	case metav1.Object, runtime.Object:
 		return h.ApplyFromObject(val)
 	default:
@@ -54,11 +60,31 @@ func (h *Handler) ApplyFromBytes(data []byte) (svc *corev1.Service, err error) {
 	return
 }
 
+// ApplyFromURL applies service from the yaml or json manifest fetched from the
+// url, the optional http headers are sent with the request, eg: the
+// "Authorization" header carrying the auth token.
+func (h *Handler) ApplyFromURL(url string, header ...http.Header) (*corev1.Service, error) {
+	data, err := remote.Fetch(h.ctx, url, remote.DefaultTimeout, header...)
+	if err != nil {
+		return nil, err
+	}
+	return h.ApplyFromBytes(data)
+}
+
+// ApplyFromReader applies service from io.Reader, the reader is read until EOF.
+func (h *Handler) ApplyFromReader(r io.Reader) (*corev1.Service, error) {
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return nil, fmt.Errorf("read service data: %w", err)
+	}
+	return h.ApplyFromBytes(data)
+}
+
 // ApplyFromObject applies service from metav1.Object or runtime.Object.
 func (h *Handler) ApplyFromObject(obj interface{}) (*corev1.Service, error) {
 	svc, ok := obj.(*corev1.Service)
 	if !ok {
-		return nil, fmt.Errorf("object type is not *corev1.Service")
+		return nil, ErrInvalidObjectType
 	}
 	return h.applyService(svc)
 }
@@ -91,3 +117,79 @@ func (h *Handler) applyService(svc *corev1.Service) (*corev1.Service, error) {
 	}
 	return svc, err
 }
+
+// ApplyOrUpdate creates the service, or updates it if it already exists.
+//
+// Unlike Apply, it gets the live service before updating, and copies the
+// resourceVersion and the fields allocated by kubernetes API server into the
+// desired service, unless they're set explicitly: the immutable clusterIP and
+// clusterIPs, ipFamilies, ipFamilyPolicy, healthCheckNodePort, and the
+// nodePort of the port with the same port number and protocol. So updating a
+// service from yaml file without these fields doesn't fail with the immutable
+// field error. The update is retried on conflict. The svc is not modified.
+func (h *Handler) ApplyOrUpdate(svc *corev1.Service) (*corev1.Service, error) {
+	namespace := svc.GetNamespace()
+	if len(namespace) == 0 {
+		namespace = h.namespace
+	}
+	created, err := h.createService(svc.DeepCopy())
+	if !k8serrors.IsAlreadyExists(err) {
+		return created, err
+	}
+
+	var updated *corev1.Service
+	err = retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		live, err := h.clientset.CoreV1().Services(namespace).Get(h.ctx, svc.Name, h.Options.GetOptions)
+		if err != nil {
+			return err
+		}
+		desired := svc.DeepCopy()
+		desired.Namespace = namespace
+		preserveAllocatedFields(desired, live)
+		updated, err = h.clientset.CoreV1().Services(namespace).Update(h.ctx, desired, h.Options.UpdateOptions)
+		return err
+	})
+	return updated, err
+}
+
+// preserveAllocatedFields copies the resourceVersion and the fields allocated
+// by kubernetes API server from the live service into the desired service,
+// if they're not set in the desired service.
+func preserveAllocatedFields(desired, live *corev1.Service) {
+	desired.ResourceVersion = live.ResourceVersion
+	if len(desired.Spec.ClusterIP) == 0 {
+		desired.Spec.ClusterIP = live.Spec.ClusterIP
+	}
+	if len(desired.Spec.ClusterIPs) == 0 {
+		desired.Spec.ClusterIPs = live.Spec.ClusterIPs
+	}
+	if len(desired.Spec.IPFamilies) == 0 {
+		desired.Spec.IPFamilies = live.Spec.IPFamilies
+	}
+	if desired.Spec.IPFamilyPolicy == nil {
+		desired.Spec.IPFamilyPolicy = live.Spec.IPFamilyPolicy
+	}
+	if desired.Spec.HealthCheckNodePort == 0 {
+		desired.Spec.HealthCheckNodePort = live.Spec.HealthCheckNodePort
+	}
+	for i := range desired.Spec.Ports {
+		port := &desired.Spec.Ports[i]
+		if port.NodePort != 0 {
+			continue
+		}
+		for _, livePort := range live.Spec.Ports {
+			if livePort.Port == port.Port && protocolOf(livePort) == protocolOf(*port) {
+				port.NodePort = livePort.NodePort
+				break
+			}
+		}
+	}
+}
+
+// protocolOf returns the protocol of the service port, which defaults to TCP.
+func protocolOf(port corev1.ServicePort) corev1.Protocol {
+	if len(port.Protocol) == 0 {
+		return corev1.ProtocolTCP
+	}
+	return port.Protocol
+}
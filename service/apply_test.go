@@ -0,0 +1,87 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/forbearing/k8s/types"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/rest"
+)
+
+func TestApplyOrUpdate(t *testing.T) {
+	live := &corev1.Service{
+		TypeMeta:   metav1.TypeMeta{Kind: "Service", APIVersion: "v1"},
+		ObjectMeta: metav1.ObjectMeta{Name: "nginx", Namespace: "test", ResourceVersion: "5"},
+		Spec: corev1.ServiceSpec{
+			Type:       corev1.ServiceTypeNodePort,
+			ClusterIP:  "10.96.0.10",
+			ClusterIPs: []string{"10.96.0.10"},
+			Ports:      []corev1.ServicePort{{Name: "http", Port: 80, Protocol: corev1.ProtocolTCP, NodePort: 30080}},
+		},
+	}
+	var updated *corev1.Service
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case r.Method == http.MethodPost && r.URL.Path == "/api/v1/namespaces/test/services":
+			w.WriteHeader(http.StatusConflict)
+			fmt.Fprintln(w, `{"kind":"Status","apiVersion":"v1","status":"Failure","reason":"AlreadyExists","code":409}`)
+		case r.Method == http.MethodGet && r.URL.Path == "/api/v1/namespaces/test/services/nginx":
+			json.NewEncoder(w).Encode(live)
+		case r.Method == http.MethodPut && r.URL.Path == "/api/v1/namespaces/test/services/nginx":
+			svc := &corev1.Service{}
+			json.NewDecoder(r.Body).Decode(svc)
+			// kubernetes API server rejects the update changing the immutable clusterIP.
+			if svc.Spec.ClusterIP != live.Spec.ClusterIP {
+				w.WriteHeader(http.StatusUnprocessableEntity)
+				fmt.Fprintln(w, `{"kind":"Status","apiVersion":"v1","status":"Failure","reason":"Invalid","code":422}`)
+				return
+			}
+			updated = svc
+			json.NewEncoder(w).Encode(svc)
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+	}))
+	defer srv.Close()
+	clientset, err := kubernetes.NewForConfig(&rest.Config{Host: srv.URL})
+	if err != nil {
+		t.Fatal(err)
+	}
+	h := &Handler{ctx: context.Background(), namespace: "test", clientset: clientset, Options: &types.HandlerOptions{}}
+
+	// the desired service decoded from yaml file doesn't have the allocated fields.
+	desired := &corev1.Service{
+		ObjectMeta: metav1.ObjectMeta{Name: "nginx", Labels: map[string]string{"app": "nginx"}},
+		Spec: corev1.ServiceSpec{
+			Type:  corev1.ServiceTypeNodePort,
+			Ports: []corev1.ServicePort{{Name: "http", Port: 80}},
+		},
+	}
+	// Apply clears the resourceVersion and doesn't preserve the clusterIP.
+	if _, err := h.Apply(desired.DeepCopy()); err == nil {
+		t.Fatal("expected Apply to be rejected because of the immutable clusterIP")
+	}
+
+	svc, err := h.ApplyOrUpdate(desired)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if svc.Labels["app"] != "nginx" {
+		t.Errorf("labels = %v, want app=nginx", svc.Labels)
+	}
+	if updated.ResourceVersion != "5" || updated.Spec.ClusterIP != "10.96.0.10" || updated.Spec.Ports[0].NodePort != 30080 {
+		t.Errorf("updated service resourceVersion %q, clusterIP %q, nodePort %d, want 5, 10.96.0.10, 30080",
+			updated.ResourceVersion, updated.Spec.ClusterIP, updated.Spec.Ports[0].NodePort)
+	}
+	if len(desired.ResourceVersion) != 0 || len(desired.Spec.ClusterIP) != 0 {
+		t.Error("the desired service is modified")
+	}
+}
@@ -1,8 +1,10 @@
 package service
 
 import (
+	"bytes"
 	"encoding/json"
 	"fmt"
+	"io"
 	"io/ioutil"
 
 	corev1 "k8s.io/api/core/v1"
@@ -14,7 +16,7 @@ import (
 
 // Create creates service from type string, []byte, *corev1.Service,
 // corev1.Service, metav1.Object, runtime.Object, *unstructured.Unstructured,
-// unstructured.Unstructured or map[string]interface{}.
+// unstructured.Unstructured, map[string]interface{} or io.Reader.
 func (h *Handler) Create(obj interface{}) (*corev1.Service, error) {
 	switch val := obj.(type) {
 	case string:
@@ -31,6 +33,8 @@ func (h *Handler) Create(obj interface{}) (*corev1.Service, error) {
 		return h.CreateFromUnstructured(&val)
 	case map[string]interface{}:
 		return h.CreateFromMap(val)
+	case io.Reader:
+		return h.CreateFromReader(val)
 	case metav1.Object, runtime.Object:
 		return h.CreateFromObject(val)
 	default:
@@ -48,6 +52,8 @@ func (h *Handler) CreateFromFile(filename string) (*corev1.Service, error) {
 }
 
 // CreateFromBytes creates service from bytes data.
+// Only the first yaml document is used, call CreateAllFromBytes to create
+// all services defined in the multi-document yaml data.
 func (h *Handler) CreateFromBytes(data []byte) (*corev1.Service, error) {
 	svcJson, err := yaml.ToJSON(data)
 	if err != nil {
@@ -61,11 +67,52 @@ func (h *Handler) CreateFromBytes(data []byte) (*corev1.Service, error) {
 	return h.createService(svc)
 }
 
+// CreateFromReader creates service from io.Reader, the reader is read until EOF.
+func (h *Handler) CreateFromReader(r io.Reader) (*corev1.Service, error) {
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return nil, fmt.Errorf("read service data: %w", err)
+	}
+	return h.CreateFromBytes(data)
+}
+
+// CreateAllFromFile creates every service defined in the multi-document
+// yaml or json file, see CreateAllFromBytes.
+func (h *Handler) CreateAllFromFile(filename string, skipOtherKinds bool) ([]*corev1.Service, error) {
+	data, err := ioutil.ReadFile(filename)
+	if err != nil {
+		return nil, err
+	}
+	return h.CreateAllFromBytes(data, skipOtherKinds)
+}
+
+// CreateAllFromBytes creates every service defined in the multi-document
+// yaml or json data, the documents are separated by "---".
+//
+// If a document is not a service, it's skipped if skipOtherKinds is true,
+// otherwise ErrKindMismatch is returned and nothing is created.
+// If failed to create a service, the created services and the error are returned.
+func (h *Handler) CreateAllFromBytes(data []byte, skipOtherKinds bool) ([]*corev1.Service, error) {
+	objs, err := decodeAll(data, skipOtherKinds)
+	if err != nil {
+		return nil, err
+	}
+	var svcs []*corev1.Service
+	for _, obj := range objs {
+		created, err := h.createService(obj)
+		if err != nil {
+			return svcs, err
+		}
+		svcs = append(svcs, created)
+	}
+	return svcs, nil
+}
+
 // CreateFromObject creates service from metav1.Object or runtime.Object.
 func (h *Handler) CreateFromObject(obj interface{}) (*corev1.Service, error) {
 	svc, ok := obj.(*corev1.Service)
 	if !ok {
-		return nil, fmt.Errorf("object type is not *corev1.Service")
+		return nil, ErrInvalidObjectType
 	}
 	return h.createService(svc)
 }
@@ -100,3 +147,33 @@ func (h *Handler) createService(svc *corev1.Service) (*corev1.Service, error) {
 	svc.UID = ""
 	return h.clientset.CoreV1().Services(namespace).Create(h.ctx, svc, h.Options.CreateOptions)
 }
+
+// decodeAll decodes every service in the multi-document yaml or json data.
+func decodeAll(data []byte, skipOtherKinds bool) ([]*corev1.Service, error) {
+	var objs []*corev1.Service
+	decoder := yaml.NewYAMLOrJSONDecoder(bytes.NewReader(data), 4096)
+	for {
+		doc := make(map[string]interface{})
+		if err := decoder.Decode(&doc); err == io.EOF {
+			return objs, nil
+		} else if err != nil {
+			return nil, err
+		}
+		// empty document.
+		if len(doc) == 0 {
+			continue
+		}
+		u := &unstructured.Unstructured{Object: doc}
+		if u.GroupVersionKind() != GVK {
+			if skipOtherKinds {
+				continue
+			}
+			return nil, fmt.Errorf("%w: %s %s", ErrKindMismatch, u.GroupVersionKind(), u.GetName())
+		}
+		obj := &corev1.Service{}
+		if err := runtime.DefaultUnstructuredConverter.FromUnstructured(doc, obj); err != nil {
+			return nil, err
+		}
+		objs = append(objs, obj)
+	}
+}
@@ -0,0 +1,56 @@
+package service
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func TestDecodeAll(t *testing.T) {
+	twoDocs := `apiVersion: v1
+kind: Service
+metadata:
+  name: svc1
+---
+# comments only
+---
+apiVersion: v1
+kind: Service
+metadata:
+  name: svc2
+spec:
+  ports:
+  - port: 80
+`
+	mixedKinds := twoDocs + `---
+apiVersion: v1
+kind: Secret
+metadata:
+  name: secret1
+`
+	tests := []struct {
+		name           string
+		data           string
+		skipOtherKinds bool
+		want           []string
+		wantErr        error
+	}{
+		{name: "two documents", data: twoDocs, want: []string{"svc1", "svc2"}},
+		{name: "mixed kinds skipped", data: mixedKinds, skipOtherKinds: true, want: []string{"svc1", "svc2"}},
+		{name: "mixed kinds rejected", data: mixedKinds, wantErr: ErrKindMismatch},
+	}
+	for _, tt := range tests {
+		svcs, err := decodeAll([]byte(tt.data), tt.skipOtherKinds)
+		if !errors.Is(err, tt.wantErr) {
+			t.Errorf("%s: error = %v, want %v", tt.name, err, tt.wantErr)
+			continue
+		}
+		var names []string
+		for _, svc := range svcs {
+			names = append(names, svc.Name)
+		}
+		if !reflect.DeepEqual(names, tt.want) {
+			t.Errorf("%s: got %v, want %v", tt.name, names, tt.want)
+		}
+	}
+}
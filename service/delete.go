@@ -3,6 +3,7 @@ package service
 import (
 	"encoding/json"
 	"fmt"
+	"io"
 	"io/ioutil"
 
 	corev1 "k8s.io/api/core/v1"
@@ -14,7 +15,7 @@ import (
 
 // Delete deletes service from type string, []byte, *corev1.Service,
 // corev1.Service, metav1.Object, runtime.Object, *unstructured.Unstructured,
-// unstructured.Unstructured or map[string]interface{}.
+// unstructured.Unstructured, map[string]interface{} or io.Reader.
 //
 // If passed parameter type is string, it will simply call DeleteByName instead of DeleteFromFile.
 // You should always explicitly call DeleteFromFile to delete a service from file path.
@@ -34,6 +35,8 @@ func (h *Handler) Delete(obj interface{}) error {
 		return h.DeleteFromUnstructured(&val)
 	case map[string]interface{}:
 		return h.DeleteFromMap(val)
+	case io.Reader:
+		return h.DeleteFromReader(val)
 	case metav1.Object, runtime.Object:
 		return h.DeleteFromObject(val)
 	default:
@@ -69,11 +72,20 @@ func (h *Handler) DeleteFromBytes(data []byte) error {
 	return h.deleteService(svc)
 }
 
+// DeleteFromReader deletes service from io.Reader, the reader is read until EOF.
+func (h *Handler) DeleteFromReader(r io.Reader) error {
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return fmt.Errorf("read service data: %w", err)
+	}
+	return h.DeleteFromBytes(data)
+}
+
 // DeleteFromObject deletes service from metav1.Object or runtime.Object.
 func (h *Handler) DeleteFromObject(obj interface{}) error {
 	svc, ok := obj.(*corev1.Service)
 	if !ok {
-		return fmt.Errorf("object type is not *corev1.Service")
+		return ErrInvalidObjectType
 	}
 	return h.deleteService(svc)
 }
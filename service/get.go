@@ -3,9 +3,11 @@ package service
 import (
 	"encoding/json"
 	"fmt"
+	"io"
 	"io/ioutil"
 
 	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime"
@@ -14,7 +16,7 @@ import (
 
 // Get gets service from type string, []byte, *corev1.Service,
 // corev1.Service, metav1.Object, runtime.Object, *unstructured.Unstructured,
-// unstructured.Unstructured or map[string]interface{}.
+// unstructured.Unstructured, map[string]interface{} or io.Reader.
 //
 // If passed parameter type is string, it will simply call GetByName instead of GetFromFile.
 // You should always explicitly call GetFromFile to get a service from file path.
@@ -34,6 +36,8 @@ func (h *Handler) Get(obj interface{}) (*corev1.Service, error) {
 		return h.GetFromUnstructured(&val)
 	case map[string]interface{}:
 		return h.GetFromMap(val)
+	case io.Reader:
+		return h.GetFromReader(val)
 	case metav1.Object, runtime.Object:
 		return h.GetFromObject(val)
 	default:
@@ -46,6 +50,20 @@ func (h *Handler) GetByName(name string) (*corev1.Service, error) {
 	return h.clientset.CoreV1().Services(h.namespace).Get(h.ctx, name, h.Options.GetOptions)
 }
 
+// Exists returns true if the service exists. It returns false and nil error if
+// the service is not found, the other errors, such as forbidden, are returned.
+func (h *Handler) Exists(name string) (bool, error) {
+	_, err := h.GetByName(name)
+	switch {
+	case err == nil:
+		return true, nil
+	case k8serrors.IsNotFound(err):
+		return false, nil
+	default:
+		return false, err
+	}
+}
+
 // GetFromFile gets service from yaml or json file.
 func (h *Handler) GetFromFile(filename string) (*corev1.Service, error) {
 	data, err := ioutil.ReadFile(filename)
@@ -69,11 +87,20 @@ func (h *Handler) GetFromBytes(data []byte) (*corev1.Service, error) {
 	return h.getService(svc)
 }
 
+// GetFromReader gets service from io.Reader, the reader is read until EOF.
+func (h *Handler) GetFromReader(r io.Reader) (*corev1.Service, error) {
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return nil, fmt.Errorf("read service data: %w", err)
+	}
+	return h.GetFromBytes(data)
+}
+
 // GetFromObject gets service from metav1.Object or runtime.Object.
 func (h *Handler) GetFromObject(obj interface{}) (*corev1.Service, error) {
 	svc, ok := obj.(*corev1.Service)
 	if !ok {
-		return nil, fmt.Errorf("object type is not *corev1.Service")
+		return nil, ErrInvalidObjectType
 	}
 	return h.getService(svc)
 }
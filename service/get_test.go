@@ -0,0 +1,53 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/forbearing/k8s/types"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/rest"
+)
+
+func TestExists(t *testing.T) {
+	// the "kubernetes" service only exists in namespace "default", the
+	// requests with the "expired" token are rejected.
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case r.Header.Get("Authorization") == "Bearer expired":
+			w.WriteHeader(http.StatusUnauthorized)
+			fmt.Fprintln(w, `{"kind":"Status","apiVersion":"v1","status":"Failure","reason":"Unauthorized","code":401}`)
+		case r.URL.Path == "/api/v1/namespaces/default/services/kubernetes":
+			fmt.Fprintln(w, `{"kind":"Service","apiVersion":"v1","metadata":{"name":"kubernetes","namespace":"default"},"spec":{"clusterIP":"10.96.0.1"}}`)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			fmt.Fprintln(w, `{"kind":"Status","apiVersion":"v1","status":"Failure","reason":"NotFound","code":404}`)
+		}
+	}))
+	defer srv.Close()
+	newHandler := func(token string) *Handler {
+		clientset, err := kubernetes.NewForConfig(&rest.Config{Host: srv.URL, BearerToken: token})
+		if err != nil {
+			t.Fatal(err)
+		}
+		return &Handler{ctx: context.Background(), namespace: "test", clientset: clientset, Options: &types.HandlerOptions{}}
+	}
+	h := newHandler("valid")
+
+	if exists, err := h.WithNamespace("default").Exists("kubernetes"); !exists || err != nil {
+		t.Errorf("Exists(default/kubernetes) = %v, %v, want true", exists, err)
+	}
+	// the service not found in the handler namespace doesn't exist.
+	if exists, err := h.Exists("kubernetes"); exists || err != nil {
+		t.Errorf("Exists(test/kubernetes) = %v, %v, want false and nil error", exists, err)
+	}
+	// the other errors don't mean the service doesn't exist.
+	if exists, err := newHandler("expired").WithNamespace("default").Exists("kubernetes"); exists || !k8serrors.IsUnauthorized(err) {
+		t.Errorf("Exists(default/kubernetes) = %v, %v, want Unauthorized error", exists, err)
+	}
+}
@@ -0,0 +1,74 @@
+package service
+
+import (
+	"reflect"
+	"sort"
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/informers"
+	"k8s.io/client-go/kubernetes/fake"
+	"k8s.io/client-go/tools/cache"
+)
+
+func TestRunInformerWaitsForCacheSync(t *testing.T) {
+	clientset := fake.NewSimpleClientset(&corev1.Service{
+		ObjectMeta: metav1.ObjectMeta{Name: "svc1", Namespace: "test"},
+	})
+	h := &Handler{informerFactory: informers.NewSharedInformerFactory(clientset, 0)}
+
+	stopCh := make(chan struct{})
+	defer close(stopCh)
+	h.RunInformer(stopCh, func(interface{}) {}, func(_, _ interface{}) {}, func(interface{}) {})
+
+	if !h.Informer().HasSynced() {
+		t.Fatal("the informer cache isn't synced after RunInformer returns")
+	}
+	services, err := h.Lister().List(labels.Everything())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(services) != 1 || services[0].Name != "svc1" {
+		t.Errorf("Lister().List() = %v, want [svc1]", services)
+	}
+}
+
+func TestAddIndexers(t *testing.T) {
+	const byApp = "byApp"
+	newService := func(name, app string) *corev1.Service {
+		return &corev1.Service{ObjectMeta: metav1.ObjectMeta{
+			Name: name, Namespace: "test", Labels: map[string]string{"app": app}}}
+	}
+	clientset := fake.NewSimpleClientset(newService("svc1", "nginx"), newService("svc2", "redis"), newService("svc3", "nginx"))
+	h := &Handler{informerFactory: informers.NewSharedInformerFactory(clientset, 0)}
+
+	err := h.AddIndexers(cache.Indexers{byApp: func(obj interface{}) ([]string, error) {
+		return []string{obj.(*corev1.Service).Labels["app"]}, nil
+	}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	stopCh := make(chan struct{})
+	defer close(stopCh)
+	h.RunInformer(stopCh, func(interface{}) {}, func(_, _ interface{}) {}, func(interface{}) {})
+
+	objs, err := h.GetIndexer().ByIndex(byApp, "nginx")
+	if err != nil {
+		t.Fatal(err)
+	}
+	var names []string
+	for _, obj := range objs {
+		names = append(names, obj.(*corev1.Service).Name)
+	}
+	sort.Strings(names)
+	if want := []string{"svc1", "svc3"}; !reflect.DeepEqual(names, want) {
+		t.Errorf("ByIndex() = %v, want %v", names, want)
+	}
+
+	// the informer is started, adding indexers is not allowed any more.
+	if err := h.AddIndexers(cache.Indexers{"other": cache.MetaNamespaceIndexFunc}); err == nil {
+		t.Error("expected AddIndexers to fail after the informer started")
+	}
+}
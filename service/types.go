@@ -2,6 +2,7 @@ package service
 
 import "errors"
 
+// ErrKindMismatch is wrapped with the kind and name of the mismatched object.
 var (
 	ErrInvalidToolsType  = errors.New("type must be string, *corev1.Service, corev1.Service, metav1.Object or runtime.Object")
 	ErrInvalidCreateType = errors.New("type must be string, []byte, *corev1.Service, corev1.Service, metav1.object, runtime.Object, *unstructured.Unstructured, unstructured.Unstructured or map[string]interface{}")
@@ -10,4 +11,6 @@ var (
 	ErrInvalidDeleteType = ErrInvalidCreateType
 	ErrInvalidGetType    = ErrInvalidCreateType
 	ErrInvalidPatchType  = errors.New("patch data type must be string, []byte, *corev1.Service, corev1.Service, metav1.Object, runtime.Object, *unstructured.Unstructured, unstructured.Unstructured or map[string]interface{}")
+	ErrInvalidObjectType = errors.New("object type is not *corev1.Service")
+	ErrKindMismatch      = errors.New("yaml document kind is not Service")
 )
@@ -3,6 +3,7 @@ package service
 import (
 	"encoding/json"
 	"fmt"
+	"io"
 	"io/ioutil"
 
 	corev1 "k8s.io/api/core/v1"
@@ -14,7 +15,7 @@ import (
 
 // Update updates service from type string, []byte, *corev1.Service,
 // corev1.Service, metav1.Object, runtime.Object, *unstructured.Unstructured,
-// unstructured.Unstructured or map[string]interface{}.
+// unstructured.Unstructured, map[string]interface{} or io.Reader.
 func (h *Handler) Update(obj interface{}) (*corev1.Service, error) {
 	switch val := obj.(type) {
 	case string:
@@ -31,6 +32,8 @@ func (h *Handler) Update(obj interface{}) (*corev1.Service, error) {
 		return h.UpdateFromUnstructured(&val)
 	case map[string]interface{}:
 		return h.UpdateFromMap(val)
+	case io.Reader:
+		return h.UpdateFromReader(val)
 	case metav1.Object, runtime.Object:
 		return h.UpdateFromObject(val)
 	default:
@@ -61,11 +64,20 @@ func (h *Handler) UpdateFromBytes(data []byte) (*corev1.Service, error) {
 	return h.updateService(svc)
 }
 
+// UpdateFromReader updates service from io.Reader, the reader is read until EOF.
+func (h *Handler) UpdateFromReader(r io.Reader) (*corev1.Service, error) {
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return nil, fmt.Errorf("read service data: %w", err)
+	}
+	return h.UpdateFromBytes(data)
+}
+
 // UpdateFromObject updates service from metav1.Object or runtime.Object.
 func (h *Handler) UpdateFromObject(obj interface{}) (*corev1.Service, error) {
 	svc, ok := obj.(*corev1.Service)
 	if !ok {
-		return nil, fmt.Errorf("object type is not *corev1.Service")
+		return nil, ErrInvalidObjectType
 	}
 	return h.updateService(svc)
 }
@@ -1,8 +1,6 @@
 package serviceaccount
 
 import (
-	"fmt"
-
 	corev1 "k8s.io/api/core/v1"
 	k8serrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -58,7 +56,7 @@ func (h *Handler) ApplyFromBytes(data []byte) (sa *corev1.ServiceAccount, err er
 func (h *Handler) ApplyFromObject(obj interface{}) (*corev1.ServiceAccount, error) {
 	sa, ok := obj.(*corev1.ServiceAccount)
 	if !ok {
-		return nil, fmt.Errorf("object type is not *corev1.ServiceAccount")
+		return nil, ErrInvalidObjectType
 	}
 	return h.applySA(sa)
 }
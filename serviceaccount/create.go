@@ -2,7 +2,6 @@ package serviceaccount
 
 import (
 	"encoding/json"
-	"fmt"
 	"io/ioutil"
 
 	corev1 "k8s.io/api/core/v1"
@@ -65,7 +64,7 @@ func (h *Handler) CreateFromBytes(data []byte) (*corev1.ServiceAccount, error) {
 func (h *Handler) CreateFromObject(obj interface{}) (*corev1.ServiceAccount, error) {
 	sa, ok := obj.(*corev1.ServiceAccount)
 	if !ok {
-		return nil, fmt.Errorf("object type is not *corev1.ServiceAccount")
+		return nil, ErrInvalidObjectType
 	}
 	return h.createSA(sa)
 }
@@ -1,7 +1,6 @@
 package serviceaccount
 
 import (
-	log "github.com/sirupsen/logrus"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/fields"
 	"k8s.io/apimachinery/pkg/watch"
@@ -19,7 +18,7 @@ import (
 //  * If Event.Type is Error: *api.Status is recommended; other types may make sense
 //    depending on context.
 func (h *Handler) Watch(addFunc, modifyFunc, deleteFunc func(obj interface{})) error {
-	return h.WithNamespace(metav1.NamespaceAll).WatchByLabel("", addFunc, deleteFunc, modifyFunc)
+	return h.WithNamespace(metav1.NamespaceAll).WatchByLabel("", addFunc, modifyFunc, deleteFunc)
 }
 
 // WatchByNamespace watch all serviceaccount resources in the specified namespace.
@@ -37,7 +36,7 @@ func (h *Handler) WatchByNamespace(namespace string, addFunc, modifyFunc, delete
 	if len(namespace) == 0 {
 		namespace = metav1.NamespaceDefault
 	}
-	return h.WithNamespace(namespace).WatchByLabel("", addFunc, deleteFunc, modifyFunc)
+	return h.WithNamespace(namespace).WatchByLabel("", addFunc, modifyFunc, deleteFunc)
 }
 
 // WatchByName watch a single serviceaccount reseource.
@@ -103,28 +102,42 @@ func (h *Handler) watchServiceAccount(listOptions metav1.ListOptions,
 	// reconnect to kubernetes API server.
 	for {
 		if watcher, err = h.clientset.CoreV1().ServiceAccounts(h.namespace).Watch(h.ctx, listOptions); err != nil {
+			// the handler context is done, stop watching.
+			if h.ctx.Err() != nil {
+				return h.ctx.Err()
+			}
 			return err
 		}
 		// kubernetes retains the resource event history, which includes this
 		// initial event, so that when our program first start, we are automatically
 		// notified of the serviceaccount existence and current state.
 		// There we will not ignore the first resource added event.
-		for event := range watcher.ResultChan() {
-			switch event.Type {
-			case watch.Added:
-				addFunc(event.Object)
-			case watch.Modified:
-				modifyFunc(event.Object)
-			case watch.Deleted:
-				deleteFunc(event.Object)
-			case watch.Bookmark:
-				log.Debug("watch serviceaccount: bookmark")
-			case watch.Error:
-				log.Debug("watch serviceaccount: error")
+	events:
+		for {
+			select {
+			case <-h.ctx.Done():
+				watcher.Stop()
+				return h.ctx.Err()
+			case event, ok := <-watcher.ResultChan():
+				if !ok {
+					break events
+				}
+				switch event.Type {
+				case watch.Added:
+					addFunc(event.Object)
+				case watch.Modified:
+					modifyFunc(event.Object)
+				case watch.Deleted:
+					deleteFunc(event.Object)
+				case watch.Bookmark:
+					h.log().Debug("watch serviceaccount: bookmark")
+				case watch.Error:
+					h.log().Debug("watch serviceaccount: error")
+				}
 			}
 		}
 		// If event channel is closed, it means the server has closed the connection
-		log.Debug("watch serviceaccount: reconnect to kubernetes")
+		h.log().Debug("watch serviceaccount: reconnect to kubernetes")
 		watcher.Stop()
 	}
 }
@@ -0,0 +1,96 @@
+package serviceaccount
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/forbearing/k8s/types"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/rest"
+)
+
+func TestWatchCancel(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprintln(w, `{"type":"ADDED","object":{"kind":"ServiceAccount","apiVersion":"v1","metadata":{"name":"default","namespace":"test"}}}`)
+		w.(http.Flusher).Flush()
+		// keep the watch connection open until the client goes away.
+		<-r.Context().Done()
+	}))
+	defer srv.Close()
+	clientset, err := kubernetes.NewForConfig(&rest.Config{Host: srv.URL})
+	if err != nil {
+		t.Fatal(err)
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	h := &Handler{ctx: ctx, namespace: "test", clientset: clientset, Options: &types.HandlerOptions{}}
+
+	added := make(chan struct{})
+	errCh := make(chan error, 1)
+	go func() {
+		errCh <- h.WatchByName("default", func(interface{}) { close(added) }, func(interface{}) {}, func(interface{}) {})
+	}()
+	<-added
+	cancel()
+	select {
+	case err := <-errCh:
+		if err != context.Canceled {
+			t.Errorf("WatchByName() = %v, want context.Canceled", err)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("WatchByName() didn't return after the context is cancelled")
+	}
+}
+
+func TestWatchObject(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if labelSelector := r.URL.Query().Get("labelSelector"); labelSelector != "app=nginx" {
+			t.Errorf("labelSelector = %q, want app=nginx", labelSelector)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		for i, eventType := range []string{"ADDED", "MODIFIED", "DELETED"} {
+			fmt.Fprintf(w, `{"type":%q,"object":{"kind":"ServiceAccount","apiVersion":"v1",`+
+				`"metadata":{"name":"nginx","namespace":"test","resourceVersion":"%d","labels":{"app":"nginx"}}}}`+"\n", eventType, i+1)
+		}
+		w.(http.Flusher).Flush()
+		<-r.Context().Done()
+	}))
+	defer srv.Close()
+	clientset, err := kubernetes.NewForConfig(&rest.Config{Host: srv.URL})
+	if err != nil {
+		t.Fatal(err)
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	h := &Handler{ctx: ctx, namespace: "test", clientset: clientset, Options: &types.HandlerOptions{}}
+
+	// objects receives the objects passed to the callbacks, in the order of events.
+	objects := make(chan interface{}, 3)
+	callback := func(obj interface{}) { objects <- obj }
+	errCh := make(chan error, 1)
+	go func() { errCh <- h.WatchByLabel("app=nginx", callback, callback, callback) }()
+
+	for i := 1; i <= 3; i++ {
+		select {
+		case obj := <-objects:
+			sa, ok := obj.(*corev1.ServiceAccount)
+			if !ok {
+				t.Fatalf("callback got %T, want *corev1.ServiceAccount", obj)
+			}
+			if sa.Name != "nginx" || sa.ResourceVersion != fmt.Sprint(i) {
+				t.Errorf("callback got serviceaccount %s at resourceVersion %s, want nginx at %d", sa.Name, sa.ResourceVersion, i)
+			}
+		case <-time.After(5 * time.Second):
+			t.Fatalf("callback isn't called for event %d", i)
+		}
+	}
+	cancel()
+	if err := <-errCh; err != context.Canceled {
+		t.Errorf("WatchByLabel() = %v, want context.Canceled", err)
+	}
+}
@@ -0,0 +1,94 @@
+package k8s
+
+import (
+	"context"
+	"io"
+
+	"github.com/forbearing/k8s/util/export"
+	utilrestmapper "github.com/forbearing/k8s/util/restmapper"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/dynamic"
+	"sigs.k8s.io/yaml"
+)
+
+// snapshotPageSize is the number of k8s resources fetched by one list request
+// when exporting the cluster.
+const snapshotPageSize = 500
+
+// ExportCluster lists the k8s resources of the given kinds and writes them to w
+// as a multi-document yaml snapshot, which is suitable for backup and migration.
+//
+// The server-managed fields are stripped from every k8s resource, just like
+// ExportForGit. The namespace-scoped resources are only listed in the given
+// namespaces, if no namespace provided, all namespaces are listed. The
+// cluster-scoped resources are always listed, the namespaces are ignored.
+//
+// The k8s resources are fetched page by page and streamed to w, so the
+// whole snapshot is never held in memory.
+func ExportCluster(ctx context.Context, kubeconfig string, kinds []schema.GroupVersionResource, namespaces []string, w io.Writer) error {
+	handler, err := New(ctx, kubeconfig, "")
+	if err != nil {
+		return err
+	}
+	restMapper := handler.RESTMapper()
+	for _, gvr := range kinds {
+		gvk, err := restMapper.KindFor(gvr)
+		if err != nil {
+			return err
+		}
+		namespaced, err := utilrestmapper.IsNamespaced(restMapper, gvk)
+		if err != nil {
+			return err
+		}
+		resource := handler.DynamicClient().Resource(gvr)
+		if !namespaced || len(namespaces) == 0 {
+			if err := exportResource(ctx, resource, w); err != nil {
+				return err
+			}
+			continue
+		}
+		for _, namespace := range namespaces {
+			if err := exportResource(ctx, resource.Namespace(namespace), w); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// exportResource lists the k8s resources page by page and writes every
+// k8s resource to w as a yaml document.
+func exportResource(ctx context.Context, resource dynamic.ResourceInterface, w io.Writer) error {
+	listOptions := metav1.ListOptions{Limit: snapshotPageSize}
+	for {
+		unstructList, err := resource.List(ctx, listOptions)
+		if err != nil {
+			return err
+		}
+		for i := range unstructList.Items {
+			if err := writeDocument(w, &unstructList.Items[i]); err != nil {
+				return err
+			}
+		}
+		if listOptions.Continue = unstructList.GetContinue(); len(listOptions.Continue) == 0 {
+			return nil
+		}
+	}
+}
+
+// writeDocument strips the server-managed fields of the k8s resource and
+// writes it to w as a yaml document started with the delimiter "---".
+func writeDocument(w io.Writer, obj *unstructured.Unstructured) error {
+	export.Strip(obj.Object)
+	data, err := yaml.Marshal(obj.Object)
+	if err != nil {
+		return err
+	}
+	if _, err := io.WriteString(w, "---\n"); err != nil {
+		return err
+	}
+	_, err = w.Write(data)
+	return err
+}
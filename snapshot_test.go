@@ -0,0 +1,43 @@
+package k8s
+
+import (
+	"bytes"
+	"testing"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+func TestWriteDocument(t *testing.T) {
+	obj := &unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": "v1",
+		"kind":       "ConfigMap",
+		"metadata": map[string]interface{}{
+			"name":            "mycm",
+			"namespace":       "test",
+			"uid":             "1234",
+			"resourceVersion": "100",
+			"managedFields":   []interface{}{map[string]interface{}{"manager": "kubectl"}},
+		},
+		"data": map[string]interface{}{"key": "value"},
+	}}
+
+	buf := &bytes.Buffer{}
+	if err := writeDocument(buf, obj); err != nil {
+		t.Fatal(err)
+	}
+	if err := writeDocument(buf, obj); err != nil {
+		t.Fatal(err)
+	}
+	want := `---
+apiVersion: v1
+data:
+  key: value
+kind: ConfigMap
+metadata:
+  name: mycm
+  namespace: test
+`
+	if got := buf.String(); got != want+want {
+		t.Errorf("writeDocument() got:\n%s\nwant:\n%s", got, want+want)
+	}
+}
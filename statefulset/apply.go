@@ -1,8 +1,6 @@
 package statefulset
 
 import (
-	"fmt"
-
 	appsv1 "k8s.io/api/apps/v1"
 	k8serrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -58,7 +56,7 @@ func (h *Handler) ApplyFromBytes(data []byte) (sts *appsv1.StatefulSet, err erro
 func (h *Handler) ApplyFromObject(obj interface{}) (*appsv1.StatefulSet, error) {
 	sts, ok := obj.(*appsv1.StatefulSet)
 	if !ok {
-		return nil, fmt.Errorf("object type is not *appsv1.StatefulSet")
+		return nil, ErrInvalidObjectType
 	}
 	return h.applyStatefulset(sts)
 }
@@ -2,7 +2,6 @@ package statefulset
 
 import (
 	"encoding/json"
-	"fmt"
 	"io/ioutil"
 
 	appsv1 "k8s.io/api/apps/v1"
@@ -73,7 +72,7 @@ func (h *Handler) DeleteFromBytes(data []byte) error {
 func (h *Handler) DeleteFromObject(obj interface{}) error {
 	sts, ok := obj.(*appsv1.StatefulSet)
 	if !ok {
-		return fmt.Errorf("object type is not *appsv1.StatefulSet")
+		return ErrInvalidObjectType
 	}
 	return h.deleteStatefulset(sts)
 }
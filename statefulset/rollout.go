@@ -0,0 +1,56 @@
+package statefulset
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	appsv1 "k8s.io/api/apps/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/wait"
+)
+
+const (
+	// pollInterval is the interval between two checks of the statefulset status.
+	pollInterval = time.Second
+
+	// restartedAtAnnotation is the annotation stamped on the pod template by
+	// `kubectl rollout restart`.
+	restartedAtAnnotation = "kubectl.kubernetes.io/restartedAt"
+)
+
+// RolloutRestart triggers a rolling restart of the statefulset, it works like
+// `kubectl rollout restart statefulset/name`, it patches the pod template
+// annotation "kubectl.kubernetes.io/restartedAt" with current time.
+func (h *Handler) RolloutRestart(name string) error {
+	patchData := fmt.Sprintf(`{"spec":{"template":{"metadata":{"annotations":{"%s":"%s"}}}}}`,
+		restartedAtAnnotation, time.Now().Format(time.RFC3339Nano))
+	_, err := h.clientset.AppsV1().StatefulSets(h.namespace).
+		Patch(h.ctx, name, types.StrategicMergePatchType, []byte(patchData), h.Options.PatchOptions)
+	return err
+}
+
+// WaitObservedGeneration waits until the statefulset controller has observed
+// the latest statefulset spec, that is status.observedGeneration >= metadata.generation.
+//
+// The other status fields (replicas, conditions, etc.) of a statefulset describe
+// the spec observed by the controller, they are stale until observedGeneration
+// catches up with generation. So always call WaitObservedGeneration before
+// trusting the statefulset status after an update.
+//
+// A zero timeout means waiting until the handler context is done.
+func (h *Handler) WaitObservedGeneration(name string, timeout time.Duration) error {
+	var sts *appsv1.StatefulSet
+	err := wait.PollImmediateWithContext(h.ctx, pollInterval, timeout, func(ctx context.Context) (bool, error) {
+		var err error
+		if sts, err = h.clientset.AppsV1().StatefulSets(h.namespace).Get(ctx, name, h.Options.GetOptions); err != nil {
+			return false, err
+		}
+		return sts.Status.ObservedGeneration >= sts.Generation, nil
+	})
+	if err == wait.ErrWaitTimeout && sts != nil {
+		return fmt.Errorf("statefulset/%s observedGeneration(%d) has not caught up with generation(%d): %w",
+			name, sts.Status.ObservedGeneration, sts.Generation, err)
+	}
+	return err
+}
@@ -0,0 +1,105 @@
+package statefulset
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"path"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/forbearing/k8s/types"
+	appsv1 "k8s.io/api/apps/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	apitypes "k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/rest"
+)
+
+func TestRolloutRestart(t *testing.T) {
+	var restartedAt []string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if r.URL.Path != "/apis/apps/v1/namespaces/test/statefulsets/web" {
+			status := k8serrors.NewNotFound(schema.GroupResource{Group: "apps", Resource: "statefulsets"}, "missing").Status()
+			w.WriteHeader(http.StatusNotFound)
+			json.NewEncoder(w).Encode(&status)
+			return
+		}
+		if r.Method != http.MethodPatch || r.Header.Get("Content-Type") != string(apitypes.StrategicMergePatchType) {
+			t.Errorf("got %s %s, want strategic merge patch", r.Method, r.Header.Get("Content-Type"))
+		}
+		data, _ := io.ReadAll(r.Body)
+		sts := &appsv1.StatefulSet{}
+		if err := json.Unmarshal(data, sts); err != nil {
+			t.Error(err)
+		}
+		restartedAt = append(restartedAt, sts.Spec.Template.Annotations[restartedAtAnnotation])
+		sts.TypeMeta = metav1.TypeMeta{Kind: "StatefulSet", APIVersion: "apps/v1"}
+		json.NewEncoder(w).Encode(sts)
+	}))
+	defer srv.Close()
+	clientset, err := kubernetes.NewForConfig(&rest.Config{Host: srv.URL})
+	if err != nil {
+		t.Fatal(err)
+	}
+	h := &Handler{ctx: context.Background(), namespace: "test", clientset: clientset, Options: &types.HandlerOptions{}}
+
+	for i := 0; i < 2; i++ {
+		if err := h.RolloutRestart("web"); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if len(restartedAt) != 2 || len(restartedAt[0]) == 0 || restartedAt[0] == restartedAt[1] {
+		t.Errorf("restartedAt annotations = %q, want two different timestamps", restartedAt)
+	}
+	if err := h.RolloutRestart("missing"); !k8serrors.IsNotFound(err) {
+		t.Errorf("restart missing statefulset: got %v, want NotFound error", err)
+	}
+}
+
+func TestWaitObservedGeneration(t *testing.T) {
+	var (
+		mu    sync.Mutex
+		polls = map[string]int{}
+	)
+	h := newTestHandler(t, func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+		name := path.Base(r.URL.Path)
+		polls[name]++
+		sts := &appsv1.StatefulSet{
+			TypeMeta:   metav1.TypeMeta{Kind: "StatefulSet", APIVersion: "apps/v1"},
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "test", Generation: 2},
+		}
+		// the "web" statefulset spec is observed at the second poll, the
+		// "stuck" statefulset spec is never observed.
+		sts.Status.ObservedGeneration = 1
+		if name == "web" && polls[name] >= 2 {
+			sts.Status.ObservedGeneration = 2
+		}
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(sts)
+	})
+
+	if err := h.WaitObservedGeneration("web", 5*time.Second); err != nil {
+		t.Errorf("WaitObservedGeneration() = %v, want nil", err)
+	}
+	err := h.WaitObservedGeneration("stuck", 1500*time.Millisecond)
+	if !errors.Is(err, wait.ErrWaitTimeout) || !strings.Contains(err.Error(), "observedGeneration(1) has not caught up with generation(2)") {
+		t.Errorf("WaitObservedGeneration() = %v, want timeout error", err)
+	}
+	mu.Lock()
+	defer mu.Unlock()
+	if polls["web"] != 2 {
+		t.Errorf("polled statefulset/web %d times, want 2", polls["web"])
+	}
+}
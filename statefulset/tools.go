@@ -5,10 +5,12 @@ import (
 	"fmt"
 	"os"
 	"os/signal"
+	"sort"
+	"strconv"
+	"strings"
 	"syscall"
 	"time"
 
-	log "github.com/sirupsen/logrus"
 	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
 	k8serrors "k8s.io/apimachinery/pkg/api/errors"
@@ -100,7 +102,7 @@ func (h *Handler) WaitReady(name string) error {
 			if err == nil {
 				return
 			}
-			log.Error(err)
+			h.log().Error(err)
 			time.Sleep(time.Second * 10)
 		}
 	}(ctxCheck)
@@ -130,13 +132,13 @@ func (h *Handler) WaitReady(name string) error {
 					errCh <- fmt.Errorf("statefulset/%s was deleted", name)
 					return
 				case watch.Bookmark:
-					log.Debug("watch statefulset: bookmark")
+					h.log().Debug("watch statefulset: bookmark")
 				case watch.Error:
-					log.Debug("watch statefulset: error")
+					h.log().Debug("watch statefulset: error")
 				}
 			}
 			// If event channel is closed, it means the kube-apiserver has closed the connection.
-			log.Debug("watch statefulset: reconnect to kubernetes")
+			h.log().Debug("watch statefulset: reconnect to kubernetes")
 			watcher.Stop()
 		}
 	}(ctxWatch)
@@ -348,3 +350,61 @@ func (h *Handler) getImages(sts *appsv1.StatefulSet) []string {
 	}
 	return il
 }
+
+// Pods returns the pods controlled by the statefulset, sorted by their ordinals.
+//
+// The statefulset pods have stable names "<statefulset name>-<ordinal>", the
+// pods matched by the statefulset selector are filtered by both the controller
+// reference and the name, so the pods created by other controllers with the
+// same labels are never returned.
+func (h *Handler) Pods(name string) ([]*corev1.Pod, error) {
+	sts, err := h.Get(name)
+	if err != nil {
+		return nil, err
+	}
+	if sts.Spec.Selector == nil ||
+		(len(sts.Spec.Selector.MatchLabels) == 0 && len(sts.Spec.Selector.MatchExpressions) == 0) {
+		return nil, fmt.Errorf("statefulset/%s has empty selector", name)
+	}
+	selector, err := metav1.LabelSelectorAsSelector(sts.Spec.Selector)
+	if err != nil {
+		return nil, err
+	}
+	listOptions := h.Options.ListOptions.DeepCopy()
+	listOptions.LabelSelector = selector.String()
+	podList, err := h.clientset.CoreV1().Pods(h.namespace).List(h.ctx, *listOptions)
+	if err != nil {
+		return nil, err
+	}
+	return ownedPods(sts, podList.Items), nil
+}
+
+// ownedPods returns the pods controlled by the statefulset, sorted by their ordinals.
+func ownedPods(sts *appsv1.StatefulSet, pods []corev1.Pod) []*corev1.Pod {
+	var pl []*corev1.Pod
+	for i := range pods {
+		ref := metav1.GetControllerOf(&pods[i])
+		if ref == nil || ref.UID != sts.UID || podOrdinal(sts.Name, pods[i].Name) < 0 {
+			continue
+		}
+		pl = append(pl, &pods[i])
+	}
+	sort.Slice(pl, func(i, j int) bool {
+		return podOrdinal(sts.Name, pl[i].Name) < podOrdinal(sts.Name, pl[j].Name)
+	})
+	return pl
+}
+
+// podOrdinal returns the ordinal of the statefulset pod named "<statefulset name>-<ordinal>",
+// -1 if the pod name doesn't match.
+func podOrdinal(stsName, podName string) int {
+	suffix := strings.TrimPrefix(podName, stsName+"-")
+	if suffix == podName {
+		return -1
+	}
+	ordinal, err := strconv.Atoi(suffix)
+	if err != nil || ordinal < 0 || strconv.Itoa(ordinal) != suffix {
+		return -1
+	}
+	return ordinal
+}
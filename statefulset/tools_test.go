@@ -0,0 +1,45 @@
+package statefulset
+
+import (
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+)
+
+func TestPods(t *testing.T) {
+	h := newTestHandler(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/apis/apps/v1/namespaces/test/statefulsets/web":
+			fmt.Fprintln(w, `{"kind":"StatefulSet","apiVersion":"apps/v1","metadata":{"name":"web","namespace":"test","uid":"1"},`+
+				`"spec":{"selector":{"matchLabels":{"app":"web"}}}}`)
+		case "/api/v1/namespaces/test/pods":
+			if labelSelector := r.URL.Query().Get("labelSelector"); labelSelector != "app=web" {
+				t.Errorf("labelSelector = %q, want app=web", labelSelector)
+			}
+			fmt.Fprintln(w, `{"kind":"PodList","apiVersion":"v1","metadata":{},"items":[
+{"metadata":{"name":"web-10","ownerReferences":[{"apiVersion":"apps/v1","kind":"StatefulSet","name":"web","uid":"1","controller":true}]}},
+{"metadata":{"name":"web-2","ownerReferences":[{"apiVersion":"apps/v1","kind":"StatefulSet","name":"web","uid":"1","controller":true}]}},
+{"metadata":{"name":"web-0","ownerReferences":[{"apiVersion":"apps/v1","kind":"StatefulSet","name":"web","uid":"1","controller":true}]}},
+{"metadata":{"name":"web-1","ownerReferences":[{"apiVersion":"apps/v1","kind":"StatefulSet","name":"web","uid":"2","controller":true}]}},
+{"metadata":{"name":"web-abc","ownerReferences":[{"apiVersion":"apps/v1","kind":"StatefulSet","name":"web","uid":"1","controller":true}]}},
+{"metadata":{"name":"web-3"}}
+]}`)
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+	})
+
+	pods, err := h.Pods("web")
+	if err != nil {
+		t.Fatal(err)
+	}
+	var names []string
+	for _, pod := range pods {
+		names = append(names, pod.Name)
+	}
+	if want := []string{"web-0", "web-2", "web-10"}; !reflect.DeepEqual(names, want) {
+		t.Errorf("pods = %v, want %v", names, want)
+	}
+}
@@ -11,4 +11,5 @@ var (
 	ErrInvalidGetType    = ErrInvalidCreateType
 	ErrInvalidScaleType  = ErrInvalidCreateType
 	ErrInvalidPatchType  = errors.New("patch data type must be string, []byte, *appsv1.StatefulSet, appsv1.StatefulSet, metav1.Object, runtime.Object, *unstructured.Unstructured, unstructured.Unstructured or map[string]interface{}")
+	ErrInvalidObjectType = errors.New("object type is not *appsv1.StatefulSet")
 )
@@ -2,7 +2,6 @@ package statefulset
 
 import (
 	"encoding/json"
-	"fmt"
 	"io/ioutil"
 
 	appsv1 "k8s.io/api/apps/v1"
@@ -65,7 +64,7 @@ func (h *Handler) UpdateFromBytes(data []byte) (*appsv1.StatefulSet, error) {
 func (h *Handler) UpdateFromObject(obj interface{}) (*appsv1.StatefulSet, error) {
 	sts, ok := obj.(*appsv1.StatefulSet)
 	if !ok {
-		return nil, fmt.Errorf("object type is not *appsv1.StatefulSet")
+		return nil, ErrInvalidObjectType
 	}
 	return h.updateStatefulset(sts)
 }
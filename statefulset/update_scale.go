@@ -2,10 +2,10 @@ package statefulset
 
 import (
 	"encoding/json"
-	"fmt"
 	"io/ioutil"
 
 	appsv1 "k8s.io/api/apps/v1"
+	autoscalingv1 "k8s.io/api/autoscaling/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime"
@@ -42,16 +42,25 @@ func (h *Handler) Scale(obj interface{}, replicas int32) (*appsv1.StatefulSet, e
 }
 
 // ScaleByName scale statefulset by name.
+//
+// It only updates the scale subresource of the statefulset, so the other fields
+// of the statefulset modified concurrently won't be overwritten.
+// The refreshed statefulset is returned.
 func (h *Handler) ScaleByName(name string, replicas int32) (*appsv1.StatefulSet, error) {
-	sts, err := h.Get(name)
-	if err != nil {
-		return nil, err
+	scale := &autoscalingv1.Scale{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: h.namespace},
+		Spec:       autoscalingv1.ScaleSpec{Replicas: replicas},
 	}
-	copiedSts := sts.DeepCopy()
-	if copiedSts.Spec.Replicas != nil {
-		copiedSts.Spec.Replicas = &replicas
+	if _, err := h.clientset.AppsV1().StatefulSets(h.namespace).
+		UpdateScale(h.ctx, name, scale, h.Options.UpdateOptions); err != nil {
+		return nil, err
 	}
-	return h.Update(copiedSts)
+	return h.Get(name)
+}
+
+// GetScale gets the scale subresource of the statefulset.
+func (h *Handler) GetScale(name string) (*autoscalingv1.Scale, error) {
+	return h.clientset.AppsV1().StatefulSets(h.namespace).GetScale(h.ctx, name, h.Options.GetOptions)
 }
 
 // ScaleFromFile scale statefulset from yaml or json file.
@@ -81,7 +90,7 @@ func (h *Handler) ScaleFromBytes(data []byte, replicas int32) (*appsv1.StatefulS
 func (h *Handler) ScaleFromObject(obj interface{}, replicas int32) (*appsv1.StatefulSet, error) {
 	sts, ok := obj.(*appsv1.StatefulSet)
 	if !ok {
-		return nil, fmt.Errorf("object type is not *appsv1.StatefulSet")
+		return nil, ErrInvalidObjectType
 	}
 	return h.ScaleByName(sts.Name, replicas)
 }
@@ -0,0 +1,87 @@
+package statefulset
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/forbearing/k8s/types"
+	appsv1 "k8s.io/api/apps/v1"
+	autoscalingv1 "k8s.io/api/autoscaling/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/rest"
+)
+
+// newTestHandler creates a statefulset handler in namespace "test", whose
+// clientset sends the requests to the fake kubernetes API server serve.
+func newTestHandler(t *testing.T, serve http.HandlerFunc) *Handler {
+	srv := httptest.NewServer(serve)
+	t.Cleanup(srv.Close)
+	clientset, err := kubernetes.NewForConfig(&rest.Config{Host: srv.URL})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return &Handler{
+		ctx:       context.Background(),
+		namespace: "test",
+		clientset: clientset,
+		Options:   &types.HandlerOptions{},
+	}
+}
+
+func TestScaleByName(t *testing.T) {
+	sts := &appsv1.StatefulSet{
+		TypeMeta:   metav1.TypeMeta{Kind: "StatefulSet", APIVersion: "apps/v1"},
+		ObjectMeta: metav1.ObjectMeta{Name: "web", Namespace: "test"},
+	}
+	replicas := int32(1)
+	sts.Spec.Replicas = &replicas
+
+	h := newTestHandler(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/apis/apps/v1/namespaces/test/statefulsets/web/scale":
+			scale := &autoscalingv1.Scale{}
+			if r.Method == http.MethodPut {
+				if err := json.NewDecoder(r.Body).Decode(scale); err != nil {
+					t.Error(err)
+				}
+				*sts.Spec.Replicas = scale.Spec.Replicas
+			}
+			scale.TypeMeta = metav1.TypeMeta{Kind: "Scale", APIVersion: "autoscaling/v1"}
+			scale.ObjectMeta = sts.ObjectMeta
+			scale.Spec.Replicas = *sts.Spec.Replicas
+			json.NewEncoder(w).Encode(scale)
+		case "/apis/apps/v1/namespaces/test/statefulsets/web":
+			json.NewEncoder(w).Encode(sts)
+		default:
+			status := k8serrors.NewNotFound(schema.GroupResource{Group: "apps", Resource: "statefulsets"}, "missing").Status()
+			w.WriteHeader(http.StatusNotFound)
+			json.NewEncoder(w).Encode(&status)
+		}
+	})
+
+	got, err := h.ScaleByName("web", 3)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if *got.Spec.Replicas != 3 {
+		t.Errorf("replicas = %d, want 3", *got.Spec.Replicas)
+	}
+	scale, err := h.GetScale("web")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if scale.Spec.Replicas != 3 {
+		t.Errorf("scale replicas = %d, want 3", scale.Spec.Replicas)
+	}
+
+	if _, err := h.ScaleByName("missing", 3); !k8serrors.IsNotFound(err) {
+		t.Errorf("scale missing statefulset: got %v, want NotFound error", err)
+	}
+}
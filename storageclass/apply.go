@@ -1,8 +1,6 @@
 package storageclass
 
 import (
-	"fmt"
-
 	storagev1 "k8s.io/api/storage/v1"
 	k8serrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -58,7 +56,7 @@ func (h *Handler) ApplyFromBytes(data []byte) (sc *storagev1.StorageClass, err e
 func (h *Handler) ApplyFromObject(obj interface{}) (*storagev1.StorageClass, error) {
 	sc, ok := obj.(*storagev1.StorageClass)
 	if !ok {
-		return nil, fmt.Errorf("object type is not *storagev1.StorageClass")
+		return nil, ErrInvalidObjectType
 	}
 	return h.applySC(sc)
 }
@@ -2,7 +2,6 @@ package storageclass
 
 import (
 	"encoding/json"
-	"fmt"
 	"io/ioutil"
 
 	storagev1 "k8s.io/api/storage/v1"
@@ -73,7 +72,7 @@ func (h *Handler) DeleteFromBytes(data []byte) error {
 func (h *Handler) DeleteFromObject(obj interface{}) error {
 	sc, ok := obj.(*storagev1.StorageClass)
 	if !ok {
-		return fmt.Errorf("object type is not *storagev1.StorageClass")
+		return ErrInvalidObjectType
 	}
 	return h.deleteSC(sc)
 }
@@ -0,0 +1,13 @@
+package storageclass
+
+import (
+	"github.com/forbearing/k8s/types"
+	storagev1 "k8s.io/api/storage/v1"
+)
+
+// Generic returns the handler as a types.Handler, whose methods return
+// runtime.Object instead of *storagev1.StorageClass, so it can be used together with
+// the handlers of other resources.
+func (h *Handler) Generic() types.Handler {
+	return types.NewGeneric[*storagev1.StorageClass](h, GVK)
+}
@@ -2,7 +2,6 @@ package storageclass
 
 import (
 	"encoding/json"
-	"fmt"
 	"io/ioutil"
 
 	storagev1 "k8s.io/api/storage/v1"
@@ -73,7 +72,7 @@ func (h *Handler) GetFromBytes(data []byte) (*storagev1.StorageClass, error) {
 func (h *Handler) GetFromObject(obj interface{}) (*storagev1.StorageClass, error) {
 	sc, ok := obj.(*storagev1.StorageClass)
 	if !ok {
-		return nil, fmt.Errorf("object type is not *storagev1.StorageClass")
+		return nil, ErrInvalidObjectType
 	}
 	return h.getSC(sc)
 }
@@ -1,9 +1,12 @@
 package storageclass
 
 import (
+	"encoding/json"
+	"strconv"
 	"time"
 
 	storagev1 "k8s.io/api/storage/v1"
+	"k8s.io/apimachinery/pkg/types"
 )
 
 // GetProvisioner get the provisioner of the storageclass.
@@ -91,3 +94,83 @@ func (h *Handler) GetAge(object interface{}) (time.Duration, error) {
 		return time.Duration(0), ErrInvalidToolsType
 	}
 }
+
+const (
+	// defaultClassAnnotation is the annotation marking the default storageclass,
+	// the persistentvolumeclaims without storageClassName use the default storageclass.
+	defaultClassAnnotation = "storageclass.kubernetes.io/is-default-class"
+	// betaDefaultClassAnnotation is the deprecated beta annotation marking the
+	// default storageclass, it's still respected by kubernetes.
+	betaDefaultClassAnnotation = "storageclass.beta.kubernetes.io/is-default-class"
+)
+
+// isDefault returns true if the storageclass is annotated as the default storageclass.
+func isDefault(sc *storagev1.StorageClass) bool {
+	return sc.Annotations[defaultClassAnnotation] == "true" || sc.Annotations[betaDefaultClassAnnotation] == "true"
+}
+
+// GetDefault gets the default storageclass, which is annotated with
+// "storageclass.kubernetes.io/is-default-class=true". If more than one
+// storageclass is annotated as default, the newest one is returned, as
+// kubernetes does. ErrNoDefault is returned if there is no default storageclass.
+func (h *Handler) GetDefault() (*storagev1.StorageClass, error) {
+	scList, err := h.ListAll()
+	if err != nil {
+		return nil, err
+	}
+	var defaultClass *storagev1.StorageClass
+	for _, sc := range scList {
+		if !isDefault(sc) {
+			continue
+		}
+		if defaultClass == nil || defaultClass.CreationTimestamp.Before(&sc.CreationTimestamp) {
+			defaultClass = sc
+		}
+	}
+	if defaultClass == nil {
+		return nil, ErrNoDefault
+	}
+	return defaultClass, nil
+}
+
+// SetDefault makes the storageclass the only default storageclass. It annotates
+// the storageclass as default first, then patches the other default
+// storageclasses to unset their default annotation, so there is always a
+// default storageclass during the switch.
+func (h *Handler) SetDefault(name string) error {
+	if err := h.setDefault(name, true); err != nil {
+		return err
+	}
+	scList, err := h.ListAll()
+	if err != nil {
+		return err
+	}
+	for _, sc := range scList {
+		if sc.Name == name || !isDefault(sc) {
+			continue
+		}
+		if err := h.setDefault(sc.Name, false); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// setDefault merge-patches the default annotations of the storageclass.
+// The deprecated beta annotation is removed.
+func (h *Handler) setDefault(name string, defaultClass bool) error {
+	patchData, err := json.Marshal(map[string]interface{}{
+		"metadata": map[string]interface{}{
+			"annotations": map[string]interface{}{
+				defaultClassAnnotation:     strconv.FormatBool(defaultClass),
+				betaDefaultClassAnnotation: nil,
+			},
+		},
+	})
+	if err != nil {
+		return err
+	}
+	_, err = h.clientset.StorageV1().StorageClasses().
+		Patch(h.ctx, name, types.MergePatchType, patchData, h.Options.PatchOptions)
+	return err
+}
@@ -0,0 +1,113 @@
+package storageclass
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"path"
+	"sort"
+	"sync"
+	"testing"
+	"time"
+
+	jsonpatch "github.com/evanphx/json-patch"
+	"github.com/forbearing/k8s/types"
+	storagev1 "k8s.io/api/storage/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/rest"
+)
+
+// serveStorageClasses is a fake kubernetes API server holding the
+// storageclasses, it applies the merge patches to them.
+type serveStorageClasses struct {
+	mu      sync.Mutex
+	classes map[string][]byte
+}
+
+func (s *serveStorageClasses) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	w.Header().Set("Content-Type", "application/json")
+	if r.URL.Path == "/apis/storage.k8s.io/v1/storageclasses" {
+		scList := &storagev1.StorageClassList{TypeMeta: metav1.TypeMeta{Kind: "StorageClassList", APIVersion: "storage.k8s.io/v1"}}
+		for _, data := range s.classes {
+			sc := storagev1.StorageClass{}
+			json.Unmarshal(data, &sc)
+			scList.Items = append(scList.Items, sc)
+		}
+		json.NewEncoder(w).Encode(scList)
+		return
+	}
+	name := path.Base(r.URL.Path)
+	if r.Method == http.MethodPatch {
+		patchData, _ := io.ReadAll(r.Body)
+		data, err := jsonpatch.MergePatch(s.classes[name], patchData)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		s.classes[name] = data
+	}
+	w.Write(s.classes[name])
+}
+
+// defaults returns the names of the storageclasses annotated as default.
+func (s *serveStorageClasses) defaults() []string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	var names []string
+	for name, data := range s.classes {
+		sc := &storagev1.StorageClass{}
+		json.Unmarshal(data, sc)
+		if isDefault(sc) {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+	return names
+}
+
+func TestDefault(t *testing.T) {
+	s := &serveStorageClasses{classes: make(map[string][]byte)}
+	for i, name := range []string{"local-path", "nfs", "ceph-rbd"} {
+		sc := &storagev1.StorageClass{
+			TypeMeta: metav1.TypeMeta{Kind: "StorageClass", APIVersion: "storage.k8s.io/v1"},
+			ObjectMeta: metav1.ObjectMeta{
+				Name:              name,
+				CreationTimestamp: metav1.NewTime(time.Date(2022, 1, i+1, 0, 0, 0, 0, time.UTC)),
+			},
+			Provisioner: name,
+		}
+		s.classes[name], _ = json.Marshal(sc)
+	}
+	srv := httptest.NewServer(s)
+	defer srv.Close()
+	clientset, err := kubernetes.NewForConfig(&rest.Config{Host: srv.URL})
+	if err != nil {
+		t.Fatal(err)
+	}
+	h := &Handler{ctx: context.Background(), clientset: clientset, Options: &types.HandlerOptions{}}
+
+	if _, err := h.GetDefault(); !errors.Is(err, ErrNoDefault) {
+		t.Errorf("GetDefault() = %v, want ErrNoDefault", err)
+	}
+	for _, name := range []string{"nfs", "ceph-rbd", "local-path"} {
+		if err := h.SetDefault(name); err != nil {
+			t.Fatal(err)
+		}
+		if defaults := s.defaults(); len(defaults) != 1 || defaults[0] != name {
+			t.Errorf("SetDefault(%s): default storageclasses = %v, want only %s", name, defaults, name)
+		}
+		sc, err := h.GetDefault()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if sc.Name != name {
+			t.Errorf("GetDefault() = %s, want %s", sc.Name, name)
+		}
+	}
+}
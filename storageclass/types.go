@@ -10,4 +10,6 @@ var (
 	ErrInvalidDeleteType = ErrInvalidCreateType
 	ErrInvalidGetType    = ErrInvalidCreateType
 	ErrInvalidPatchType  = errors.New("patch data type must be string, []byte, *storagev1.StorageClass, storagev1.StorageClass, metav1.Object, runtime.Object, *unstructured.Unstructured, unstructured.Unstructured or map[string]interface{}")
+	ErrInvalidObjectType = errors.New("object type is not *storagev1.StorageClass")
+	ErrNoDefault         = errors.New("no default storageclass")
 )
@@ -2,7 +2,6 @@ package storageclass
 
 import (
 	"encoding/json"
-	"fmt"
 	"io/ioutil"
 
 	storagev1 "k8s.io/api/storage/v1"
@@ -65,7 +64,7 @@ func (h *Handler) UpdateFromBytes(data []byte) (*storagev1.StorageClass, error)
 func (h *Handler) UpdateFromObject(obj interface{}) (*storagev1.StorageClass, error) {
 	sc, ok := obj.(*storagev1.StorageClass)
 	if !ok {
-		return nil, fmt.Errorf("object type is not *storagev1.StorageClass")
+		return nil, ErrInvalidObjectType
 	}
 	return h.updateSC(sc)
 }
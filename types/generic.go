@@ -0,0 +1,69 @@
+package types
+
+import (
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// TypedHandler is the resource handler working with the typed object T, such
+// as *corev1.ConfigMap, the handler of every resource package implements it.
+type TypedHandler[T runtime.Object] interface {
+	CreateFromBytes(data []byte) (T, error)
+	UpdateFromBytes(data []byte) (T, error)
+	ApplyFromBytes(data []byte) (T, error)
+	GetByName(name string) (T, error)
+	ListByLabel(labels string) ([]T, error)
+	DeleteByName(name string) error
+	DeleteFromBytes(data []byte) error
+}
+
+// NewGeneric adapts the typed handler of the resource gvk to Handler, eg:
+//
+//	func (h *Handler) Generic() types.Handler {
+//		return types.NewGeneric[*corev1.ConfigMap](h, GVK)
+//	}
+func NewGeneric[T runtime.Object](h TypedHandler[T], gvk schema.GroupVersionKind) Handler {
+	return generic[T]{h: h, gvk: gvk}
+}
+
+// generic adapts TypedHandler to Handler, it never returns a typed nil object.
+type generic[T runtime.Object] struct {
+	h   TypedHandler[T]
+	gvk schema.GroupVersionKind
+}
+
+func (g generic[T]) CreateFromBytes(data []byte) (runtime.Object, error) {
+	return object(g.h.CreateFromBytes(data))
+}
+func (g generic[T]) UpdateFromBytes(data []byte) (runtime.Object, error) {
+	return object(g.h.UpdateFromBytes(data))
+}
+func (g generic[T]) ApplyFromBytes(data []byte) (runtime.Object, error) {
+	return object(g.h.ApplyFromBytes(data))
+}
+func (g generic[T]) GetByName(name string) (runtime.Object, error) {
+	return object(g.h.GetByName(name))
+}
+func (g generic[T]) ListByLabel(labels string) ([]runtime.Object, error) {
+	objs, err := g.h.ListByLabel(labels)
+	if err != nil {
+		return nil, err
+	}
+	list := make([]runtime.Object, 0, len(objs))
+	for _, obj := range objs {
+		list = append(list, obj)
+	}
+	return list, nil
+}
+func (g generic[T]) DeleteByName(name string) error    { return g.h.DeleteByName(name) }
+func (g generic[T]) DeleteFromBytes(data []byte) error { return g.h.DeleteFromBytes(data) }
+func (g generic[T]) GVK() schema.GroupVersionKind      { return g.gvk }
+
+// object converts the typed object to runtime.Object, the object is dropped
+// if err is not nil, so the returned runtime.Object is never a typed nil.
+func object[T runtime.Object](obj T, err error) (runtime.Object, error) {
+	if err != nil {
+		return nil, err
+	}
+	return obj, nil
+}
@@ -3,8 +3,30 @@ package types
 import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 )
 
+// Handler is a resource handler working with runtime.Object instead of the
+// typed objects, so the handlers of different resources can be used
+// polymorphically, such as stored in a registry keyed by GVK.
+// The handler of every resource package returns it by Generic(), eg:
+//
+//	handlers := map[schema.GroupVersionKind]types.Handler{
+//		deployment.GVK: deployHandler.Generic(),
+//		service.GVK:    svcHandler.Generic(),
+//	}
+type Handler interface {
+	CreateFromBytes(data []byte) (runtime.Object, error)
+	UpdateFromBytes(data []byte) (runtime.Object, error)
+	ApplyFromBytes(data []byte) (runtime.Object, error)
+	GetByName(name string) (runtime.Object, error)
+	ListByLabel(labels string) ([]runtime.Object, error)
+	DeleteByName(name string) error
+	DeleteFromBytes(data []byte) error
+	// GVK returns the group, version and kind of the resource.
+	GVK() schema.GroupVersionKind
+}
+
 type HandlerInterface interface {
 	Creater
 	Updater
@@ -0,0 +1,26 @@
+package types
+
+import "github.com/sirupsen/logrus"
+
+// Logger is the logger used by the handlers to report the watch and informer
+// events. Both *logrus.Logger and *logrus.Entry implement it.
+type Logger interface {
+	Debug(args ...interface{})
+	Info(args ...interface{})
+	Error(args ...interface{})
+}
+
+// DefaultLogger is the logger used by the handlers if no logger is set,
+// it's the logrus standard logger.
+func DefaultLogger() Logger {
+	return logrus.StandardLogger()
+}
+
+// DiscardLogger is a logger discarding all messages, it silences the handlers.
+var DiscardLogger Logger = discardLogger{}
+
+type discardLogger struct{}
+
+func (discardLogger) Debug(args ...interface{}) {}
+func (discardLogger) Info(args ...interface{})  {}
+func (discardLogger) Error(args ...interface{}) {}
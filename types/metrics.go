@@ -0,0 +1,13 @@
+package types
+
+import "time"
+
+// MetricsRecorder records the latency and the result of the kubernetes API
+// operations made by the handlers. verb is one of "create", "get", "update",
+// "delete" and "list", resource is the plural resource name, such as
+// "deployments", err is the error returned by the operation, nil if succeeded.
+// The package util/promrecorder provides a MetricsRecorder exporting the
+// Prometheus metrics.
+type MetricsRecorder interface {
+	Observe(verb, resource string, dur time.Duration, err error)
+}
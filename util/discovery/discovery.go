@@ -0,0 +1,115 @@
+package discovery
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/discovery"
+)
+
+// CacheTTL is the duration the discovered api resources are cached.
+// The cached api resources of a GroupVersion are refreshed once they are
+// expired, or the requested resource is not found in them(eg: a new CRD).
+var CacheTTL = 10 * time.Minute
+
+var cache = &resourcesCache{entries: make(map[string]resourcesEntry)}
+
+type resourcesEntry struct {
+	resources []metav1.APIResource
+	expiredAt time.Time
+}
+
+type resourcesCache struct {
+	entries map[string]resourcesEntry
+	l       sync.Mutex
+}
+
+// APIVerbs returns the verbs supported by the kubernetes apiserver for the
+// GroupVersionResource, such as "get", "list", "watch", "create", "update",
+// "patch", "delete" and "deletecollection".
+func APIVerbs(client discovery.DiscoveryInterface, gvr schema.GroupVersionResource) ([]string, error) {
+	resource, err := APIResource(client, gvr)
+	if err != nil {
+		return nil, err
+	}
+	return append([]string{}, resource.Verbs...), nil
+}
+
+// SupportsVerb returns true if the kubernetes apiserver supports the verb
+// for the GroupVersionResource.
+func SupportsVerb(client discovery.DiscoveryInterface, gvr schema.GroupVersionResource, verb string) (bool, error) {
+	verbs, err := APIVerbs(client, gvr)
+	if err != nil {
+		return false, err
+	}
+	for _, v := range verbs {
+		if v == verb {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// APIResource returns the api resource discovered from kubernetes apiserver
+// for the GroupVersionResource.
+func APIResource(client discovery.DiscoveryInterface, gvr schema.GroupVersionResource) (*metav1.APIResource, error) {
+	key := cacheKey(client, gvr.GroupVersion())
+
+	cache.l.Lock()
+	entry, ok := cache.entries[key]
+	cache.l.Unlock()
+	if ok && time.Now().Before(entry.expiredAt) {
+		if resource := findResource(entry.resources, gvr.Resource); resource != nil {
+			return resource, nil
+		}
+	}
+
+	// the cache is missing, expired, or the resource is not found in the cache.
+	resourceList, err := client.ServerResourcesForGroupVersion(gvr.GroupVersion().String())
+	if err != nil {
+		return nil, err
+	}
+	cache.l.Lock()
+	cache.entries[key] = resourcesEntry{resources: resourceList.APIResources, expiredAt: time.Now().Add(CacheTTL)}
+	cache.l.Unlock()
+
+	if resource := findResource(resourceList.APIResources, gvr.Resource); resource != nil {
+		return resource, nil
+	}
+	return nil, fmt.Errorf("the server doesn't have a resource type %q in %s", gvr.Resource, gvr.GroupVersion())
+}
+
+// Invalidate drops all the cached api resources, the next call will
+// discover api resources from kubernetes apiserver again.
+func Invalidate() {
+	cache.l.Lock()
+	defer cache.l.Unlock()
+	cache.entries = make(map[string]resourcesEntry)
+}
+
+// findResource finds the resource from the api resources, subresources such
+// as "deployments/scale" are also supported.
+func findResource(resources []metav1.APIResource, name string) *metav1.APIResource {
+	for i := range resources {
+		if resources[i].Name == name {
+			resource := resources[i]
+			return &resource
+		}
+	}
+	return nil
+}
+
+// cacheKey identifies the kubernetes apiserver by its host, so that handlers
+// connecting to the same cluster share the cached api resources.
+func cacheKey(client discovery.DiscoveryInterface, gv schema.GroupVersion) string {
+	var host string
+	if restClient := client.RESTClient(); restClient != nil {
+		if url := restClient.Get().URL(); url != nil {
+			host = url.Host
+		}
+	}
+	return host + "/" + gv.String()
+}
@@ -0,0 +1,80 @@
+package discovery
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"sync/atomic"
+	"testing"
+
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/discovery"
+	"k8s.io/client-go/rest"
+)
+
+func TestAPIVerbs(t *testing.T) {
+	var requests int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/apis/apps/v1" {
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+		atomic.AddInt32(&requests, 1)
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprintln(w, `{"kind":"APIResourceList","groupVersion":"apps/v1","resources":[`+
+			`{"name":"deployments","namespaced":true,"kind":"Deployment","verbs":["get","list","watch"]}]}`)
+	}))
+	defer srv.Close()
+	client, err := discovery.NewDiscoveryClientForConfig(&rest.Config{Host: srv.URL})
+	if err != nil {
+		t.Fatal(err)
+	}
+	Invalidate()
+	defer Invalidate()
+	deployments := schema.GroupVersionResource{Group: "apps", Version: "v1", Resource: "deployments"}
+
+	steps := []struct {
+		name         string
+		do           func() error
+		wantRequests int32
+	}{
+		{"discover", func() error {
+			verbs, err := APIVerbs(client, deployments)
+			if want := []string{"get", "list", "watch"}; !reflect.DeepEqual(verbs, want) {
+				t.Errorf("APIVerbs() = %v, want %v", verbs, want)
+			}
+			return err
+		}, 1},
+		{"cache hit", func() error {
+			_, err := APIVerbs(client, deployments)
+			return err
+		}, 1},
+		{"cache hit by SupportsVerb", func() error {
+			supported, err := SupportsVerb(client, deployments, "delete")
+			if supported {
+				t.Error("SupportsVerb(delete) = true, want false")
+			}
+			return err
+		}, 1},
+		{"invalidate", func() error {
+			Invalidate()
+			_, err := APIVerbs(client, deployments)
+			return err
+		}, 2},
+		{"resource not in the cache", func() error {
+			_, err := APIVerbs(client, schema.GroupVersionResource{Group: "apps", Version: "v1", Resource: "statefulsets"})
+			if err == nil {
+				t.Error("APIVerbs(statefulsets) error = nil, want an error")
+			}
+			return nil
+		}, 3},
+	}
+	for _, step := range steps {
+		if err := step.do(); err != nil {
+			t.Fatalf("%s: %v", step.name, err)
+		}
+		if n := atomic.LoadInt32(&requests); n != step.wantRequests {
+			t.Errorf("%s: %d discovery requests, want %d", step.name, n, step.wantRequests)
+		}
+	}
+}
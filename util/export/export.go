@@ -0,0 +1,87 @@
+package export
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/yaml"
+)
+
+// DefaultStripFields is the list of server-managed fields removed from an
+// object before it's exported, every item is the path of a field.
+var DefaultStripFields = [][]string{
+	{"metadata", "managedFields"},
+	{"metadata", "resourceVersion"},
+	{"metadata", "uid"},
+	{"metadata", "selfLink"},
+	{"metadata", "creationTimestamp"},
+	{"metadata", "generation"},
+	{"metadata", "ownerReferences"},
+	{"metadata", "annotations", "kubectl.kubernetes.io/last-applied-configuration"},
+	{"metadata", "annotations", "deployment.kubernetes.io/revision"},
+	{"spec", "template", "metadata", "creationTimestamp"},
+	{"status"},
+}
+
+// KindStripFuncs is the per-kind strip functions, they remove the fields
+// allocated by the server for the kind of object, which can't be set on
+// another cluster. The key is the kind of the object.
+var KindStripFuncs = map[string]func(obj map[string]interface{}){
+	"Service": stripService,
+}
+
+// Strip removes the provided fields from the unstructured object.
+// If no fields provided, DefaultStripFields and the fields removed by the
+// KindStripFuncs of the object kind will be removed.
+// metadata.annotations and metadata.labels will be removed too if they
+// become empty after stripping.
+func Strip(obj map[string]interface{}, fields ...[]string) {
+	if len(fields) == 0 {
+		fields = DefaultStripFields
+		if kind, ok := obj["kind"].(string); ok && KindStripFuncs[kind] != nil {
+			KindStripFuncs[kind](obj)
+		}
+	}
+	for _, field := range fields {
+		unstructured.RemoveNestedField(obj, field...)
+	}
+	for _, field := range []string{"annotations", "labels"} {
+		if m, found, _ := unstructured.NestedMap(obj, "metadata", field); found && len(m) == 0 {
+			unstructured.RemoveNestedField(obj, "metadata", field)
+		}
+	}
+}
+
+// ToYAML stamps the TypeMeta on the object with the given gvk, strips the
+// provided fields(default to DefaultStripFields) and serializes it to yaml.
+func ToYAML(obj runtime.Object, gvk schema.GroupVersionKind, fields ...[]string) ([]byte, error) {
+	obj = obj.DeepCopyObject()
+	obj.GetObjectKind().SetGroupVersionKind(gvk)
+	unstructMap, err := runtime.DefaultUnstructuredConverter.ToUnstructured(obj)
+	if err != nil {
+		return nil, err
+	}
+	Strip(unstructMap, fields...)
+	return yaml.Marshal(unstructMap)
+}
+
+// stripService removes the cluster IPs and the node ports allocated to the
+// Service. The clusterIP "None" of the headless Service is kept.
+func stripService(obj map[string]interface{}) {
+	if clusterIP, _, _ := unstructured.NestedString(obj, "spec", "clusterIP"); clusterIP != corev1.ClusterIPNone {
+		unstructured.RemoveNestedField(obj, "spec", "clusterIP")
+		unstructured.RemoveNestedField(obj, "spec", "clusterIPs")
+	}
+	unstructured.RemoveNestedField(obj, "spec", "healthCheckNodePort")
+	ports, found, _ := unstructured.NestedSlice(obj, "spec", "ports")
+	if !found {
+		return
+	}
+	for _, port := range ports {
+		if port, ok := port.(map[string]interface{}); ok {
+			delete(port, "nodePort")
+		}
+	}
+	unstructured.SetNestedSlice(obj, ports, "spec", "ports")
+}
@@ -0,0 +1,109 @@
+package export
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"sigs.k8s.io/yaml"
+)
+
+func TestToYAML(t *testing.T) {
+	deploy := &appsv1.Deployment{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:              "mydep",
+			Namespace:         "test",
+			UID:               "8a2c5f1e-0000-0000-0000-000000000000",
+			ResourceVersion:   "12345",
+			Generation:        3,
+			CreationTimestamp: metav1.Now(),
+			Annotations:       map[string]string{"deployment.kubernetes.io/revision": "3"},
+			ManagedFields:     []metav1.ManagedFieldsEntry{{Manager: "kubectl"}},
+		},
+		Status: appsv1.DeploymentStatus{Replicas: 1},
+	}
+	gvk := schema.GroupVersionKind{Group: "apps", Version: "v1", Kind: "Deployment"}
+
+	data, err := ToYAML(deploy, gvk)
+	if err != nil {
+		t.Fatal(err)
+	}
+	out := string(data)
+	for _, want := range []string{"apiVersion: apps/v1", "kind: Deployment", "name: mydep", "namespace: test"} {
+		if !strings.Contains(out, want) {
+			t.Errorf("exported yaml missing %q:\n%s", want, out)
+		}
+	}
+	for _, unwanted := range []string{"uid:", "resourceVersion:", "generation:", "creationTimestamp:", "managedFields:", "annotations:", "status:"} {
+		if strings.Contains(out, unwanted) {
+			t.Errorf("exported yaml should not contain %q:\n%s", unwanted, out)
+		}
+	}
+	if len(deploy.ManagedFields) == 0 || deploy.Kind != "" {
+		t.Error("ToYAML should not modify the provided object")
+	}
+}
+
+func TestServiceRoundTrip(t *testing.T) {
+	// newService returns the service declared by the user, the live service
+	// has the fields allocated by the apiserver.
+	newService := func(clusterIP string) *corev1.Service {
+		return &corev1.Service{
+			TypeMeta:   metav1.TypeMeta{Kind: "Service", APIVersion: "v1"},
+			ObjectMeta: metav1.ObjectMeta{Name: "nginx", Namespace: "test", Labels: map[string]string{"app": "nginx"}},
+			Spec: corev1.ServiceSpec{
+				Type:      corev1.ServiceTypeNodePort,
+				ClusterIP: clusterIP,
+				Selector:  map[string]string{"app": "nginx"},
+				Ports:     []corev1.ServicePort{{Name: "http", Port: 80, TargetPort: intstr.FromInt(8080), Protocol: corev1.ProtocolTCP}},
+			},
+		}
+	}
+	liveService := func(clusterIP string) *corev1.Service {
+		svc := newService(clusterIP)
+		svc.UID = "8a2c5f1e-0000-0000-0000-000000000000"
+		svc.ResourceVersion = "12345"
+		svc.OwnerReferences = []metav1.OwnerReference{{APIVersion: "v1", Kind: "Endpoints", Name: "nginx", UID: "1234"}}
+		if len(clusterIP) == 0 {
+			svc.Spec.ClusterIP = "10.96.0.10"
+		}
+		svc.Spec.ClusterIPs = []string{svc.Spec.ClusterIP}
+		svc.Spec.Ports[0].NodePort = 30080
+		svc.Status.LoadBalancer.Ingress = []corev1.LoadBalancerIngress{{IP: "1.2.3.4"}}
+		return svc
+	}
+	gvk := schema.GroupVersionKind{Version: "v1", Kind: "Service"}
+
+	tests := []struct {
+		name string
+		live *corev1.Service
+		want *corev1.Service
+	}{
+		{"allocated fields stripped", liveService(""), newService("")},
+		{"headless cluster ip kept", liveService(corev1.ClusterIPNone), func() *corev1.Service {
+			svc := newService(corev1.ClusterIPNone)
+			svc.Spec.ClusterIPs = []string{corev1.ClusterIPNone}
+			return svc
+		}()},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			data, err := ToYAML(test.live, gvk)
+			if err != nil {
+				t.Fatal(err)
+			}
+			restored := &corev1.Service{}
+			if err := yaml.Unmarshal(data, restored); err != nil {
+				t.Fatal(err)
+			}
+			if !reflect.DeepEqual(restored, test.want) {
+				t.Errorf("restored service:\n%s\nwant %+v", data, test.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,54 @@
+// Package promrecorder provides a types.MetricsRecorder exporting the latency
+// and the errors of the kubernetes API operations as Prometheus metrics.
+package promrecorder
+
+import (
+	"time"
+
+	"github.com/forbearing/k8s/types"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+var _ types.MetricsRecorder = &Recorder{}
+
+// Recorder records the API operations to the metrics:
+//  * k8s_client_request_duration_seconds: histogram of the operation latency.
+//  * k8s_client_request_errors_total: counter of the failed operations.
+// Both are labeled by "verb" and "resource".
+type Recorder struct {
+	latency *prometheus.HistogramVec
+	errors  *prometheus.CounterVec
+}
+
+// New creates a Recorder and registers its metrics to the registerer,
+// prometheus.DefaultRegisterer is used if registerer is nil.
+func New(registerer prometheus.Registerer) (*Recorder, error) {
+	if registerer == nil {
+		registerer = prometheus.DefaultRegisterer
+	}
+	r := &Recorder{
+		latency: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Name:    "k8s_client_request_duration_seconds",
+			Help:    "Latency of the kubernetes API operations in seconds, by verb and resource.",
+			Buckets: prometheus.DefBuckets,
+		}, []string{"verb", "resource"}),
+		errors: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Name: "k8s_client_request_errors_total",
+			Help: "Number of the failed kubernetes API operations, by verb and resource.",
+		}, []string{"verb", "resource"}),
+	}
+	for _, collector := range []prometheus.Collector{r.latency, r.errors} {
+		if err := registerer.Register(collector); err != nil {
+			return nil, err
+		}
+	}
+	return r, nil
+}
+
+// Observe implements types.MetricsRecorder.
+func (r *Recorder) Observe(verb, resource string, dur time.Duration, err error) {
+	r.latency.WithLabelValues(verb, resource).Observe(dur.Seconds())
+	if err != nil {
+		r.errors.WithLabelValues(verb, resource).Inc()
+	}
+}
@@ -0,0 +1,36 @@
+package promrecorder
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+)
+
+func TestRecorder(t *testing.T) {
+	registry := prometheus.NewRegistry()
+	r, err := New(registry)
+	if err != nil {
+		t.Fatal(err)
+	}
+	r.Observe("create", "deployments", time.Second, nil)
+	r.Observe("get", "deployments", time.Millisecond, errors.New("not found"))
+	r.Observe("get", "deployments", time.Millisecond, nil)
+
+	if n := testutil.CollectAndCount(r.latency); n != 2 {
+		t.Errorf("latency has %d series, want 2", n)
+	}
+	if n := testutil.ToFloat64(r.errors.WithLabelValues("get", "deployments")); n != 1 {
+		t.Errorf("get errors = %v, want 1", n)
+	}
+	if n := testutil.ToFloat64(r.errors.WithLabelValues("create", "deployments")); n != 0 {
+		t.Errorf("create errors = %v, want 0", n)
+	}
+
+	// the metrics are already registered.
+	if _, err := New(registry); err == nil {
+		t.Error("expected New to fail registering the metrics twice")
+	}
+}
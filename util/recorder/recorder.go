@@ -1,17 +1,48 @@
 package recorder
 
 import (
+	"sync"
+
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/client-go/kubernetes"
 	"k8s.io/client-go/kubernetes/scheme"
 	typedcorev1 "k8s.io/client-go/kubernetes/typed/core/v1"
 	"k8s.io/client-go/tools/record"
 )
 
-func New(clientset *kubernetes.Clientset, agent string) record.EventRecorder {
+func New(clientset kubernetes.Interface, agent string) record.EventRecorder {
 	//logrus.Debug("Creating event broadcaster")
 	eventBroadcaster := record.NewBroadcaster()
 	eventBroadcaster.StartStructuredLogging(0)
 	eventBroadcaster.StartRecordingToSink(&typedcorev1.EventSinkImpl{Interface: clientset.CoreV1().Events("")})
 	return eventBroadcaster.NewRecorder(scheme.Scheme, corev1.EventSource{Component: agent})
 }
+
+// Lazy is an event recorder whose event broadcaster is not started until
+// the first event is recorded, so the handlers that never record events don't
+// pay for the broadcaster goroutines.
+type Lazy struct {
+	clientset kubernetes.Interface
+	agent     string
+
+	once     sync.Once
+	recorder record.EventRecorder
+}
+
+// NewLazy creates a Lazy event recorder, the events are recorded with the
+// agent as the event source component.
+func NewLazy(clientset kubernetes.Interface, agent string) *Lazy {
+	return &Lazy{clientset: clientset, agent: agent}
+}
+
+// Event records an event against the object, the eventType should be
+// corev1.EventTypeNormal("Normal") or corev1.EventTypeWarning("Warning").
+// The event is sent to kubernetes asynchronously, it can be seen by
+// `kubectl describe` the object.
+func (r *Lazy) Event(obj runtime.Object, eventType, reason, message string) {
+	r.once.Do(func() {
+		r.recorder = New(r.clientset, r.agent)
+	})
+	r.recorder.Event(obj, eventType, reason, message)
+}
@@ -0,0 +1,49 @@
+package recorder
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/kubernetes/fake"
+	k8stesting "k8s.io/client-go/testing"
+)
+
+func TestLazyEvent(t *testing.T) {
+	clientset := fake.NewSimpleClientset()
+	// The event sink creates events with the namespace of the event, but the
+	// fake clientset requires the request namespace to match the object namespace.
+	clientset.PrependReactor("create", "events", func(action k8stesting.Action) (bool, runtime.Object, error) {
+		event := action.(k8stesting.CreateAction).GetObject().(*corev1.Event)
+		return true, event, clientset.Tracker().Create(corev1.SchemeGroupVersion.WithResource("events"), event, event.Namespace)
+	})
+	cm := &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: "mycm", Namespace: "test", UID: "uid"}}
+
+	NewLazy(clientset, "test-agent").Event(cm, corev1.EventTypeNormal, "Rotated", "rotated configmap")
+
+	var event *corev1.Event
+	err := wait.PollImmediate(50*time.Millisecond, 5*time.Second, func() (bool, error) {
+		eventList, err := clientset.CoreV1().Events("test").List(context.TODO(), metav1.ListOptions{})
+		if err != nil || len(eventList.Items) == 0 {
+			return false, err
+		}
+		event = &eventList.Items[0]
+		return true, nil
+	})
+	if err != nil {
+		t.Fatalf("event not recorded: %v", err)
+	}
+	if event.InvolvedObject.Kind != "ConfigMap" || event.InvolvedObject.Name != "mycm" {
+		t.Errorf("involvedObject = %+v, want ConfigMap mycm", event.InvolvedObject)
+	}
+	if event.Type != corev1.EventTypeNormal || event.Reason != "Rotated" || event.Message != "rotated configmap" {
+		t.Errorf("event = %s/%s/%s", event.Type, event.Reason, event.Message)
+	}
+	if event.Source.Component != "test-agent" {
+		t.Errorf("event source component = %q, want test-agent", event.Source.Component)
+	}
+}
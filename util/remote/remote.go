@@ -0,0 +1,62 @@
+package remote
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+)
+
+const (
+	// DefaultTimeout is the default max duration of fetching a manifest,
+	// including reading the body.
+	DefaultTimeout = 30 * time.Second
+	// MaxSize is the max size of a manifest, the larger response body is
+	// rejected instead of being read into memory entirely.
+	MaxSize = 10 * 1024 * 1024
+)
+
+// Fetch gets the manifest from the url with the optional http headers, such
+// as the "Authorization" header. An error including the status code is
+// returned if the response status code is not 2xx.
+//
+// The timeout is the max duration of fetching the manifest, including reading
+// the body, DefaultTimeout is used if it's not positive. An error is returned
+// if the manifest is larger than MaxSize.
+func Fetch(ctx context.Context, url string, timeout time.Duration, header ...http.Header) ([]byte, error) {
+	if timeout <= 0 {
+		timeout = DefaultTimeout
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return nil, err
+	}
+	for _, h := range header {
+		for key, values := range h {
+			for _, value := range values {
+				req.Header.Add(key, value)
+			}
+		}
+	}
+	// the http client never carries the kubernetes credentials, so the
+	// credentials won't be leaked to the remote host.
+	resp, err := (&http.Client{Timeout: timeout}).Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return nil, fmt.Errorf("fetch %s: unexpected status code %d(%s)", url, resp.StatusCode, http.StatusText(resp.StatusCode))
+	}
+	// read one more byte to tell the manifest of MaxSize from the larger one.
+	data, err := io.ReadAll(io.LimitReader(resp.Body, MaxSize+1))
+	if err != nil {
+		return nil, fmt.Errorf("fetch %s: %w", url, err)
+	}
+	if len(data) > MaxSize {
+		return nil, fmt.Errorf("fetch %s: the manifest is larger than %d bytes", url, MaxSize)
+	}
+	return data, nil
+}
@@ -0,0 +1,56 @@
+package remote
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestFetch(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/max.yaml":
+			w.Write(make([]byte, MaxSize))
+		case "/large.yaml":
+			w.Write(make([]byte, MaxSize+1))
+		case "/slow.yaml":
+			select {
+			case <-time.After(time.Second):
+			case <-r.Context().Done():
+			}
+		}
+	}))
+	defer srv.Close()
+
+	tests := []struct {
+		name     string
+		path     string
+		timeout  time.Duration
+		wantSize int
+		wantErr  string
+	}{
+		{"max size", "/max.yaml", 0, MaxSize, ""},
+		{"larger than max size", "/large.yaml", 0, 0, "larger than"},
+		{"timeout", "/slow.yaml", 50 * time.Millisecond, 0, "Timeout"},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			data, err := Fetch(context.Background(), srv.URL+test.path, test.timeout)
+			if test.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), test.wantErr) {
+					t.Fatalf("Fetch() = %d bytes, %v, want error %q", len(data), err, test.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(data) != test.wantSize {
+				t.Errorf("Fetch() = %d bytes, want %d", len(data), test.wantSize)
+			}
+		})
+	}
+}
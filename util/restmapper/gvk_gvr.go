@@ -6,6 +6,7 @@ import (
 	"errors"
 	"io"
 	"io/ioutil"
+	"strings"
 
 	"k8s.io/apimachinery/pkg/api/meta"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
@@ -99,6 +100,18 @@ func GVKToGVR(restMapper meta.RESTMapper, gvk schema.GroupVersionKind) (schema.G
 	return restMapping.Resource, nil
 }
 
+// KindToGVK find the GroupVersionKind from the kind or resource name, such as
+// "Deployment", "deployment", "deployments", "deployments.apps" or "deployments.v1.apps".
+func KindToGVK(restMapper meta.RESTMapper, kind string) (schema.GroupVersionKind, error) {
+	gvr, gr := schema.ParseResourceArg(strings.ToLower(kind))
+	if gvr != nil {
+		if gvk, err := restMapper.KindFor(*gvr); err == nil {
+			return gvk, nil
+		}
+	}
+	return restMapper.KindFor(gr.WithVersion(""))
+}
+
 // findGVK find the GroupVersionKind from signal yaml document or json document.
 func findGVK(restMapper meta.RESTMapper, data []byte) (schema.GroupVersionKind, error) {
 	var (
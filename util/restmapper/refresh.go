@@ -0,0 +1,92 @@
+package restmapper
+
+import (
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// refreshRESTMapper is a RESTMapper that resets the cached discovery information
+// of the delegated RESTMapper and retries the mapping once if the mapping is not
+// found, so the CRDs registered after the RESTMapper cached the discovery
+// information can be mapped.
+type refreshRESTMapper struct {
+	delegate meta.ResettableRESTMapper
+}
+
+// NewRefreshRESTMapper returns a RESTMapper that reloads the discovery information
+// of the delegated RESTMapper once on NoMatchError.
+//
+// The DeferredDiscoveryRESTMapper only reloads the discovery information when
+// its cache is not fresh, but the in-memory cache is always fresh after the
+// first load, so the k8s resources registered later would never be found.
+func NewRefreshRESTMapper(delegate meta.ResettableRESTMapper) meta.ResettableRESTMapper {
+	return &refreshRESTMapper{delegate: delegate}
+}
+
+// retry calls fn, and calls it again after resetting the delegated RESTMapper
+// if it fails with NoMatchError.
+func (m *refreshRESTMapper) retry(fn func() error) error {
+	err := fn()
+	if meta.IsNoMatchError(err) {
+		m.delegate.Reset()
+		err = fn()
+	}
+	return err
+}
+
+func (m *refreshRESTMapper) KindFor(resource schema.GroupVersionResource) (gvk schema.GroupVersionKind, err error) {
+	err = m.retry(func() error {
+		gvk, err = m.delegate.KindFor(resource)
+		return err
+	})
+	return
+}
+
+func (m *refreshRESTMapper) KindsFor(resource schema.GroupVersionResource) (gvks []schema.GroupVersionKind, err error) {
+	err = m.retry(func() error {
+		gvks, err = m.delegate.KindsFor(resource)
+		return err
+	})
+	return
+}
+
+func (m *refreshRESTMapper) ResourceFor(input schema.GroupVersionResource) (gvr schema.GroupVersionResource, err error) {
+	err = m.retry(func() error {
+		gvr, err = m.delegate.ResourceFor(input)
+		return err
+	})
+	return
+}
+
+func (m *refreshRESTMapper) ResourcesFor(input schema.GroupVersionResource) (gvrs []schema.GroupVersionResource, err error) {
+	err = m.retry(func() error {
+		gvrs, err = m.delegate.ResourcesFor(input)
+		return err
+	})
+	return
+}
+
+func (m *refreshRESTMapper) RESTMapping(gk schema.GroupKind, versions ...string) (mapping *meta.RESTMapping, err error) {
+	err = m.retry(func() error {
+		mapping, err = m.delegate.RESTMapping(gk, versions...)
+		return err
+	})
+	return
+}
+
+func (m *refreshRESTMapper) RESTMappings(gk schema.GroupKind, versions ...string) (mappings []*meta.RESTMapping, err error) {
+	err = m.retry(func() error {
+		mappings, err = m.delegate.RESTMappings(gk, versions...)
+		return err
+	})
+	return
+}
+
+func (m *refreshRESTMapper) ResourceSingularizer(resource string) (string, error) {
+	return m.delegate.ResourceSingularizer(resource)
+}
+
+// Reset resets the delegated RESTMapper.
+func (m *refreshRESTMapper) Reset() {
+	m.delegate.Reset()
+}
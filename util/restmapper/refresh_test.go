@@ -0,0 +1,46 @@
+package restmapper
+
+import (
+	"testing"
+
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	memory "k8s.io/client-go/discovery/cached"
+	fakediscovery "k8s.io/client-go/discovery/fake"
+	"k8s.io/client-go/restmapper"
+	clienttesting "k8s.io/client-go/testing"
+)
+
+func TestRefreshRESTMapper(t *testing.T) {
+	discoveryClient := &fakediscovery.FakeDiscovery{Fake: &clienttesting.Fake{
+		Resources: []*metav1.APIResourceList{{
+			GroupVersion: "v1",
+			APIResources: []metav1.APIResource{{Name: "pods", Namespaced: true, Kind: "Pod"}},
+		}},
+	}}
+	deferred := restmapper.NewDeferredDiscoveryRESTMapper(memory.NewMemCacheClient(discoveryClient))
+	crontab := schema.GroupKind{Group: "stable.example.com", Kind: "CronTab"}
+
+	// the discovery information is cached without the CRD.
+	if _, err := deferred.RESTMapping(schema.GroupKind{Kind: "Pod"}, "v1"); err != nil {
+		t.Fatal(err)
+	}
+	// the CRD is registered after the discovery information is cached.
+	discoveryClient.Resources = append(discoveryClient.Resources, &metav1.APIResourceList{
+		GroupVersion: "stable.example.com/v1",
+		APIResources: []metav1.APIResource{{Name: "crontabs", Namespaced: true, Kind: "CronTab"}},
+	})
+	if _, err := deferred.RESTMapping(crontab, "v1"); !meta.IsNoMatchError(err) {
+		t.Fatalf("the cached RESTMapper: got %v, want NoMatchError", err)
+	}
+
+	mapping, err := NewRefreshRESTMapper(deferred).RESTMapping(crontab, "v1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := schema.GroupVersionResource{Group: "stable.example.com", Version: "v1", Resource: "crontabs"}
+	if mapping.Resource != want {
+		t.Errorf("resource = %v, want %v", mapping.Resource, want)
+	}
+}
@@ -24,7 +24,10 @@ func NewDeferredRESTMapper(kubeconfig string) (meta.RESTMapper, error) {
 	// NewDeferredDiscoveryRESTMapper returns a
 	// DeferredDiscoveryRESTMapper that will lazily query the provided
 	// client for discovery information to do REST mappings.
-	return restmapper.NewDeferredDiscoveryRESTMapper(memory.NewMemCacheClient(discoveryClient)), nil
+	//
+	// NewRefreshRESTMapper reloads the cached discovery information if the
+	// mapping is not found, eg: the CRD is registered after the cache is loaded.
+	return NewRefreshRESTMapper(restmapper.NewDeferredDiscoveryRESTMapper(memory.NewMemCacheClient(discoveryClient))), nil
 }
 
 // NewPriorityRESTMapper